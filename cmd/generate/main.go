@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/grafana/terraform-provider-grafana/v4/pkg/generate"
@@ -53,6 +54,14 @@ func run() error {
 				Value:   string(generate.OutputFormatHCL),
 				EnvVars: []string{"TFGEN_OUTPUT_FORMAT"},
 			},
+			&cli.StringFlag{
+				Name: "output-layout",
+				Usage: fmt.Sprintf("Layout of the generated resources. With %q, all resources are written to the root module. "+
+					"With %q or %q, resources are split into one module per resource category or per Grafana folder, in the modules/ directory. "+
+					"Supported layouts are: %v", generate.OutputLayoutFlat, generate.OutputLayoutCategory, generate.OutputLayoutFolder, generate.OutputLayouts),
+				Value:   string(generate.OutputLayoutFlat),
+				EnvVars: []string{"TFGEN_OUTPUT_LAYOUT"},
+			},
 			&cli.StringFlag{
 				Name:    "terraform-provider-version",
 				Usage:   "Version of the Grafana provider to generate resources for. Defaults to the release version (same as the generator version).",
@@ -76,6 +85,20 @@ This supports a glob format. Examples:
 				EnvVars: []string{"TFGEN_OUTPUT_CREDENTIALS"},
 				Value:   false,
 			},
+			&cli.BoolFlag{
+				Name:    "include-app-platform-resources",
+				Usage:   "Generate App Platform resources (grafana_apps_*). Kinds without a dedicated resource are generated as grafana_apps_generic_resource. Some of these overlap with other resources, for example dashboards.",
+				EnvVars: []string{"TFGEN_INCLUDE_APP_PLATFORM_RESOURCES"},
+				Value:   false,
+			},
+			&cli.BoolFlag{
+				Name: "drift-sync",
+				Usage: "Sync an output directory which was generated before and edited since. Existing files are left untouched: " +
+					"objects which are not managed yet are written to drift-imports.tf and drift-resources.tf, " +
+					"and managed objects which no longer exist upstream are listed in drift-report.txt",
+				EnvVars: []string{"TFGEN_DRIFT_SYNC"},
+				Value:   false,
+			},
 			&cli.StringFlag{
 				Name:     "terraform-install-dir",
 				Usage:    `Directory to install Terraform to. If not set, a temporary directory will be created.`,
@@ -88,6 +111,19 @@ This supports a glob format. Examples:
 				EnvVars:  []string{"TFGEN_TERRAFORM_INSTALL_VERSION"},
 				Required: false,
 			},
+			&cli.StringFlag{
+				Name: "engine",
+				Usage: fmt.Sprintf("Binary which runs the generation. Terraform is installed if needed, OpenTofu (%q) must be installed in the install dir or in the PATH. "+
+					"Supported engines are: %v", generate.EngineOpenTofu, generate.Engines),
+				Value:   string(generate.EngineTerraform),
+				EnvVars: []string{"TFGEN_ENGINE"},
+			},
+			&cli.StringFlag{
+				Name:     "tf-binary",
+				Usage:    `Path to an existing Terraform or OpenTofu binary (matching the engine). If set, nothing is installed.`,
+				EnvVars:  []string{"TFGEN_TF_BINARY"},
+				Required: false,
+			},
 
 			// Grafana OSS flags
 			&cli.StringFlag{
@@ -132,6 +168,42 @@ This supports a glob format. Examples:
 				Category: "Grafana",
 				EnvVars:  []string{"TFGEN_ONCALL_ACCESS_TOKEN"},
 			},
+			&cli.StringFlag{
+				Name:     "fleet-management-url",
+				Usage:    "URL of the Fleet Management instance to generate resources from",
+				Category: "Grafana",
+				EnvVars:  []string{"TFGEN_FLEET_MANAGEMENT_URL"},
+			},
+			&cli.StringFlag{
+				Name:     "fleet-management-auth",
+				Usage:    "Basic auth (username:password) for the Fleet Management instance",
+				Category: "Grafana",
+				EnvVars:  []string{"TFGEN_FLEET_MANAGEMENT_AUTH"},
+			},
+			&cli.StringFlag{
+				Name:     "cloud-provider-url",
+				Usage:    "URL of the Cloud Provider API of the Grafana Cloud stack to generate resources from",
+				Category: "Grafana",
+				EnvVars:  []string{"TFGEN_CLOUD_PROVIDER_URL"},
+			},
+			&cli.StringFlag{
+				Name:     "cloud-provider-access-token",
+				Usage:    "Access token for the Cloud Provider API",
+				Category: "Grafana",
+				EnvVars:  []string{"TFGEN_CLOUD_PROVIDER_ACCESS_TOKEN"},
+			},
+			&cli.StringFlag{
+				Name:     "connections-api-url",
+				Usage:    "URL of the Connections API of the Grafana Cloud stack to generate resources from. Defaults to https://connections-api.grafana.net",
+				Category: "Grafana",
+				EnvVars:  []string{"TFGEN_CONNECTIONS_API_URL"},
+			},
+			&cli.StringFlag{
+				Name:     "connections-api-access-token",
+				Usage:    "Access token for the Connections API",
+				Category: "Grafana",
+				EnvVars:  []string{"TFGEN_CONNECTIONS_API_ACCESS_TOKEN"},
+			},
 
 			// Grafana Cloud flags
 			&cli.StringFlag{
@@ -159,6 +231,15 @@ This supports a glob format. Examples:
 				EnvVars:  []string{"TFGEN_CLOUD_STACK_SERVICE_ACCOUNT_NAME"},
 				Value:    "tfgen-management",
 			},
+
+			// Alertmanager flags
+			&cli.StringFlag{
+				Name: "alertmanager-config",
+				Usage: "Path to an Alertmanager configuration file (alertmanager.yml) to translate to contact points, a notification policy, mute timings and inhibition rules, " +
+					"instead of generating the resources of a Grafana instance. The constructs which can't be translated are listed in alertmanager-report.txt",
+				Category: "Alertmanager",
+				EnvVars:  []string{"TFGEN_ALERTMANAGER_CONFIG"},
+			},
 		},
 		InvalidFlagAccessHandler: func(ctx *cli.Context, s string) {
 			panic(fmt.Errorf("invalid flag access: %s", s))
@@ -169,6 +250,9 @@ This supports a glob format. Examples:
 				return fmt.Errorf("failed to parse flags: %w", err)
 			}
 			result := generate.Generate(ctx.Context, cfg)
+			printSkippedResources(result)
+			printDriftReport(cfg, result)
+			printAlertmanagerReport(cfg, result)
 			return errors.Join(result.Errors...)
 		},
 	}
@@ -176,13 +260,64 @@ This supports a glob format. Examples:
 	return app.Run(os.Args)
 }
 
+// printSkippedResources reports the resource types which were not generated, so that gaps in the output are not silent.
+func printSkippedResources(result generate.GenerationResult) {
+	skipped := result.SkippedResources()
+	if len(skipped) == 0 {
+		return
+	}
+
+	color.New(color.FgYellow, color.Bold).Fprintf(os.Stderr, "The following %d resource types were skipped:\n", len(skipped))
+	for _, s := range skipped {
+		fmt.Fprintf(os.Stderr, "  - %s: %s\n", s.Resource.Name, s.Reason)
+	}
+}
+
+// printDriftReport summarizes the managed resources which no longer exist upstream, after a drift sync.
+func printDriftReport(cfg *generate.Config, result generate.GenerationResult) {
+	if !cfg.DriftSync || len(result.Errors) > 0 {
+		return
+	}
+	if len(result.Stale) == 0 {
+		fmt.Fprintln(os.Stderr, "All managed resources still exist upstream")
+		return
+	}
+
+	color.New(color.FgYellow, color.Bold).Fprintf(os.Stderr, "%d managed resources no longer exist upstream:\n", len(result.Stale))
+	for _, r := range result.Stale {
+		fmt.Fprintf(os.Stderr, "  - %s (id: %s)\n", r.Address, r.ID)
+	}
+	fmt.Fprintf(os.Stderr, "See %s\n", filepath.Join(cfg.OutputDir, "drift-report.txt"))
+}
+
+// printAlertmanagerReport lists the constructs of the Alertmanager configuration which couldn't be translated.
+func printAlertmanagerReport(cfg *generate.Config, result generate.GenerationResult) {
+	if cfg.AlertmanagerConfigFile == "" || len(result.Errors) > 0 {
+		return
+	}
+	if len(result.Untranslated) == 0 {
+		fmt.Fprintln(os.Stderr, "The Alertmanager configuration was fully translated")
+		return
+	}
+
+	color.New(color.FgYellow, color.Bold).Fprintf(os.Stderr, "%d constructs of the Alertmanager configuration couldn't be translated:\n", len(result.Untranslated))
+	for _, u := range result.Untranslated {
+		fmt.Fprintf(os.Stderr, "  - %s\n", u)
+	}
+	fmt.Fprintf(os.Stderr, "See %s\n", filepath.Join(cfg.OutputDir, "alertmanager-report.txt"))
+}
+
 func parseFlags(ctx *cli.Context) (*generate.Config, error) {
 	config := &generate.Config{
-		OutputDir:         ctx.String("output-dir"),
-		Clobber:           ctx.Bool("clobber"),
-		Format:            generate.OutputFormat(ctx.String("output-format")),
-		ProviderVersion:   ctx.String("terraform-provider-version"),
-		OutputCredentials: ctx.Bool("output-credentials"),
+		OutputDir:              ctx.String("output-dir"),
+		Clobber:                ctx.Bool("clobber"),
+		Format:                 generate.OutputFormat(ctx.String("output-format")),
+		Layout:                 generate.OutputLayout(ctx.String("output-layout")),
+		ProviderVersion:        ctx.String("terraform-provider-version"),
+		OutputCredentials:      ctx.Bool("output-credentials"),
+		AppPlatform:            ctx.Bool("include-app-platform-resources"),
+		DriftSync:              ctx.Bool("drift-sync"),
+		AlertmanagerConfigFile: ctx.String("alertmanager-config"),
 		Grafana: &generate.GrafanaConfig{
 			URL:                 ctx.String("grafana-url"),
 			Auth:                ctx.String("grafana-auth"),
@@ -191,6 +326,13 @@ func parseFlags(ctx *cli.Context) (*generate.Config, error) {
 			SMAccessToken:       ctx.String("synthetic-monitoring-access-token"),
 			OnCallURL:           ctx.String("oncall-url"),
 			OnCallAccessToken:   ctx.String("oncall-access-token"),
+
+			FleetManagementURL:        ctx.String("fleet-management-url"),
+			FleetManagementAuth:       ctx.String("fleet-management-auth"),
+			CloudProviderURL:          ctx.String("cloud-provider-url"),
+			CloudProviderAccessToken:  ctx.String("cloud-provider-access-token"),
+			ConnectionsAPIURL:         ctx.String("connections-api-url"),
+			ConnectionsAPIAccessToken: ctx.String("connections-api-access-token"),
 		},
 		Cloud: &generate.CloudConfig{
 			AccessPolicyToken:         ctx.String("cloud-access-policy-token"),
@@ -200,6 +342,8 @@ func parseFlags(ctx *cli.Context) (*generate.Config, error) {
 		},
 		IncludeResources: ctx.StringSlice("include-resources"),
 		TerraformInstallConfig: generate.TerraformInstallConfig{
+			Engine:     generate.Engine(ctx.String("engine")),
+			BinaryPath: ctx.String("tf-binary"),
 			InstallDir: ctx.String("terraform-install-dir"),
 		},
 	}
@@ -217,12 +361,22 @@ func parseFlags(ctx *cli.Context) (*generate.Config, error) {
 
 	// Validate flags
 	err = newFlagValidations().
-		atLeastOne("grafana-url", "cloud-access-policy-token").
+		atLeastOne("grafana-url", "cloud-access-policy-token", "alertmanager-config").
 		conflicting(
-			[]string{"grafana-url", "grafana-auth", "synthetic-monitoring-url", "synthetic-monitoring-access-token", "oncall-url", "oncall-access-token"},
+			[]string{
+				"grafana-url", "grafana-auth", "synthetic-monitoring-url", "synthetic-monitoring-access-token", "oncall-url", "oncall-access-token",
+				"fleet-management-url", "fleet-management-auth", "cloud-provider-url", "cloud-provider-access-token", "connections-api-url", "connections-api-access-token",
+			},
 			[]string{"cloud-access-policy-token", "cloud-org", "cloud-create-stack-service-account", "cloud-stack-service-account-name"},
 		).
+		conflicting([]string{"drift-sync"}, []string{"clobber", "cloud-access-policy-token", "output-layout"}).
+		conflicting([]string{"alertmanager-config"}, []string{"grafana-url", "grafana-auth", "cloud-access-policy-token", "drift-sync", "output-layout"}).
 		requiredWhenSet("grafana-url", "grafana-auth").
+		requiredWhenSet("fleet-management-url", "fleet-management-auth").
+		requiredWhenSet("fleet-management-auth", "fleet-management-url").
+		requiredWhenSet("cloud-provider-url", "cloud-provider-access-token").
+		requiredWhenSet("cloud-provider-access-token", "cloud-provider-url").
+		requiredWhenSet("connections-api-url", "connections-api-access-token").
 		requiredWhenSet("cloud-access-policy-token", "cloud-org").
 		requiredWhenSet("cloud-stack-service-account-name", "cloud-create-stack-service-account").
 		validate(ctx)
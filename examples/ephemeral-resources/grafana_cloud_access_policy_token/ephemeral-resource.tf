@@ -0,0 +1,30 @@
+data "grafana_cloud_organization" "current" {
+  slug = "<your org slug>"
+}
+
+resource "grafana_cloud_access_policy" "metrics_read" {
+  region = "prod-us-east-0"
+  name   = "metrics-read"
+
+  scopes = ["metrics:read"]
+
+  realm {
+    type       = "org"
+    identifier = data.grafana_cloud_organization.current.id
+  }
+}
+
+ephemeral "grafana_cloud_access_policy_token" "metrics_read" {
+  region           = "prod-us-east-0"
+  access_policy_id = grafana_cloud_access_policy.metrics_read.policy_id
+  expires_at       = "2030-01-01T00:00:00Z"
+}
+
+resource "terraform_data" "smoke_test" {
+  provisioner "local-exec" {
+    command = "./smoke-test.sh"
+    environment = {
+      GRAFANA_CLOUD_TOKEN = ephemeral.grafana_cloud_access_policy_token.metrics_read.token
+    }
+  }
+}
@@ -0,0 +1,15 @@
+resource "grafana_service_account" "deployer" {
+  name = "deployer"
+  role = "Editor"
+}
+
+ephemeral "grafana_service_account_token" "deployer" {
+  service_account_id = grafana_service_account.deployer.id
+  seconds_to_live    = 600
+}
+
+provider "grafana" {
+  alias = "deployer"
+  url   = "http://localhost:3000"
+  auth  = ephemeral.grafana_service_account_token.deployer.key
+}
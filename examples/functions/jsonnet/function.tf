@@ -0,0 +1,10 @@
+# Render a Grafonnet dashboard, with the libraries installed by jsonnet-bundler in the vendor directory.
+# `env` is read with std.extVar('env') and `uid` is an argument of the top-level function of the file.
+resource "grafana_dashboard" "overview" {
+  config_json = provider::grafana::jsonnet(
+    "${path.module}/dashboards/overview.jsonnet",
+    { env = "prod" },
+    { uid = "prod-overview" },
+    "${path.module}/vendor",
+  )
+}
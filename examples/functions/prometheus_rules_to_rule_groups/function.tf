@@ -0,0 +1,52 @@
+# Convert the rules of a Prometheus rule file to Grafana-managed rules, evaluated by a Prometheus datasource.
+locals {
+  rule_groups = provider::grafana::prometheus_rules_to_rule_groups(
+    file("${path.module}/rules/api.yaml"),
+    grafana_data_source.prometheus.uid,
+  )
+}
+
+resource "grafana_rule_group" "api" {
+  for_each = { for group in local.rule_groups : group.name => group }
+
+  name             = each.value.name
+  folder_uid       = grafana_folder.api.uid
+  interval_seconds = each.value.interval_seconds
+
+  dynamic "rule" {
+    for_each = each.value.rules
+    content {
+      name            = rule.value.name
+      condition       = rule.value.condition
+      for             = rule.value.for
+      keep_firing_for = rule.value.keep_firing_for
+      no_data_state   = rule.value.no_data_state
+      exec_err_state  = rule.value.exec_err_state
+      labels          = rule.value.labels
+      annotations     = rule.value.annotations
+
+      dynamic "data" {
+        for_each = rule.value.data
+        content {
+          ref_id         = data.value.ref_id
+          datasource_uid = data.value.datasource_uid
+          query_type     = data.value.query_type
+          model          = data.value.model
+          relative_time_range {
+            from = data.value.relative_time_range.from
+            to   = data.value.relative_time_range.to
+          }
+        }
+      }
+
+      dynamic "record" {
+        for_each = rule.value.record
+        content {
+          metric                = record.value.metric
+          from                  = record.value.from
+          target_datasource_uid = record.value.target_datasource_uid
+        }
+      }
+    }
+  }
+}
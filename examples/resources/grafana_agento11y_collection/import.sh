@@ -1 +1,9 @@
 terraform import grafana_agento11y_collection.name "{{ collection_id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_agento11y_collection.name
+#   identity = {
+#     collection_id = "{{ collection_id }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_agento11y_evaluation_rule.name "{{ rule_id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_agento11y_evaluation_rule.name
+#   identity = {
+#     rule_id = "{{ rule_id }}"
+#   }
+# }
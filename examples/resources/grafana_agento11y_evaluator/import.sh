@@ -1 +1,9 @@
 terraform import grafana_agento11y_evaluator.name "{{ evaluator_id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_agento11y_evaluator.name
+#   identity = {
+#     evaluator_id = "{{ evaluator_id }}"
+#   }
+# }
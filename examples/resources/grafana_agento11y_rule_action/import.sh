@@ -1 +1,10 @@
 terraform import grafana_agento11y_rule_action.name "{{ rule_id }}:{{ action_id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_agento11y_rule_action.name
+#   identity = {
+#     rule_id   = "{{ rule_id }}"
+#     action_id = "{{ action_id }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_asserts_custom_model_rules.name "{{ name }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_asserts_custom_model_rules.name
+#   identity = {
+#     name = "{{ name }}"
+#   }
+# }
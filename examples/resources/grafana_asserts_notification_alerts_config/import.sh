@@ -1 +1,9 @@
 terraform import grafana_asserts_notification_alerts_config.name "{{ name }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_asserts_notification_alerts_config.name
+#   identity = {
+#     name = "{{ name }}"
+#   }
+# }
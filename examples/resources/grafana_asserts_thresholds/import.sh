@@ -1 +1,9 @@
 terraform import grafana_asserts_thresholds.name "{{ id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_asserts_thresholds.name
+#   identity = {
+#     id = "{{ id }}"
+#   }
+# }
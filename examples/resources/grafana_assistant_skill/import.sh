@@ -1 +1,9 @@
 terraform import grafana_assistant_skill.name "{{ id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_assistant_skill.name
+#   identity = {
+#     id = "{{ id }}"
+#   }
+# }
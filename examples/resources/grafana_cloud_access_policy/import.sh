@@ -1 +1,10 @@
 terraform import grafana_cloud_access_policy.name "{{ region }}:{{ policyId }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_cloud_access_policy.name
+#   identity = {
+#     region    = "{{ region }}"
+#     policy_id = "{{ policyId }}"
+#   }
+# }
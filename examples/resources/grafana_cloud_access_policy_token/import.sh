@@ -1 +1,10 @@
 terraform import grafana_cloud_access_policy_token.name "{{ region }}:{{ tokenId }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_cloud_access_policy_token.name
+#   identity = {
+#     region   = "{{ region }}"
+#     token_id = "{{ tokenId }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_cloud_integration.name "{{ slug }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_cloud_integration.name
+#   identity = {
+#     slug = "{{ slug }}"
+#   }
+# }
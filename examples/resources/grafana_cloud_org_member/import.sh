@@ -1 +1,10 @@
 terraform import grafana_cloud_org_member.name "{{ orgSlugOrID }}:{{ usernameOrID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_cloud_org_member.name
+#   identity = {
+#     org_slug_or_id = "{{ orgSlugOrID }}"
+#     username_or_id = "{{ usernameOrID }}"
+#   }
+# }
@@ -1 +1,10 @@
 terraform import grafana_cloud_plugin_installation.name "{{ stackSlug }}:{{ pluginSlug }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_cloud_plugin_installation.name
+#   identity = {
+#     stack_slug  = "{{ stackSlug }}"
+#     plugin_slug = "{{ pluginSlug }}"
+#   }
+# }
@@ -1 +1,10 @@
 terraform import grafana_cloud_private_data_source_connect_network.name "{{ region }}:{{ policyId }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_cloud_private_data_source_connect_network.name
+#   identity = {
+#     region    = "{{ region }}"
+#     policy_id = "{{ policyId }}"
+#   }
+# }
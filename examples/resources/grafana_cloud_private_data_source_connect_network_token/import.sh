@@ -1 +1,10 @@
 terraform import grafana_cloud_private_data_source_connect_network_token.name "{{ region }}:{{ tokenId }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_cloud_private_data_source_connect_network_token.name
+#   identity = {
+#     region   = "{{ region }}"
+#     token_id = "{{ tokenId }}"
+#   }
+# }
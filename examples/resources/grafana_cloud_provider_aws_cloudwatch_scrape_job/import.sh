@@ -1 +1,10 @@
 terraform import grafana_cloud_provider_aws_cloudwatch_scrape_job.name "{{ stack_id }}:{{ name }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_cloud_provider_aws_cloudwatch_scrape_job.name
+#   identity = {
+#     stack_id = "{{ stack_id }}"
+#     name     = "{{ name }}"
+#   }
+# }
@@ -1 +1,10 @@
 terraform import grafana_cloud_provider_azure_credential.name "{{ stack_id }}:{{ resource_id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_cloud_provider_azure_credential.name
+#   identity = {
+#     stack_id    = "{{ stack_id }}"
+#     resource_id = "{{ resource_id }}"
+#   }
+# }
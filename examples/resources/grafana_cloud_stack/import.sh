@@ -1 +1,9 @@
 terraform import grafana_cloud_stack.name "{{ stackSlugOrID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_cloud_stack.name
+#   identity = {
+#     stack_slug_or_id = "{{ stackSlugOrID }}"
+#   }
+# }
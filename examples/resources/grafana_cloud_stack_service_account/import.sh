@@ -1 +1,10 @@
 terraform import grafana_cloud_stack_service_account.name "{{ stackSlug }}:{{ serviceAccountID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_cloud_stack_service_account.name
+#   identity = {
+#     stack_slug         = "{{ stackSlug }}"
+#     service_account_id = "{{ serviceAccountID }}"
+#   }
+# }
@@ -1,2 +1,11 @@
 terraform import grafana_contact_point.name "{{ name }}"
 terraform import grafana_contact_point.name "{{ orgID }}:{{ name }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_contact_point.name
+#   identity = {
+#     org_id = "{{ orgID }}" # Optional
+#     name   = "{{ name }}"
+#   }
+# }
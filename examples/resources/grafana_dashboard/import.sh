@@ -1,2 +1,11 @@
 terraform import grafana_dashboard.name "{{ uid }}"
 terraform import grafana_dashboard.name "{{ orgID }}:{{ uid }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_dashboard.name
+#   identity = {
+#     org_id = "{{ orgID }}" # Optional
+#     uid    = "{{ uid }}"
+#   }
+# }
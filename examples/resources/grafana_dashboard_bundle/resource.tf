@@ -0,0 +1,14 @@
+resource "grafana_folder" "bundle" {
+  title = "Dashboard Bundle"
+}
+
+# dashboards/
+# ├── overview.json          -> "Dashboard Bundle" folder
+# └── team-a/
+#     ├── team-a.json        -> "Dashboard Bundle/team-a" folder
+#     └── services/
+#         └── api.json       -> "Dashboard Bundle/team-a/services" folder
+resource "grafana_dashboard_bundle" "dashboards" {
+  path              = "${path.module}/dashboards"
+  parent_folder_uid = grafana_folder.bundle.uid
+}
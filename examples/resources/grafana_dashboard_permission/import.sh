@@ -1,2 +1,11 @@
 terraform import grafana_dashboard_permission.name "{{ dashboardUID }}"
 terraform import grafana_dashboard_permission.name "{{ orgID }}:{{ dashboardUID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_dashboard_permission.name
+#   identity = {
+#     org_id        = "{{ orgID }}" # Optional
+#     dashboard_uid = "{{ dashboardUID }}"
+#   }
+# }
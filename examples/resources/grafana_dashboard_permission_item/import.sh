@@ -1,2 +1,13 @@
 terraform import grafana_dashboard_permission_item.name "{{ dashboardUID }}:{{ type (role, team, or user) }}:{{ identifier }}"
 terraform import grafana_dashboard_permission_item.name "{{ orgID }}:{{ dashboardUID }}:{{ type (role, team, or user) }}:{{ identifier }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_dashboard_permission_item.name
+#   identity = {
+#     org_id        = "{{ orgID }}" # Optional
+#     dashboard_uid = "{{ dashboardUID }}"
+#     type          = "{{ type (role, team, or user) }}"
+#     identifier    = "{{ identifier }}"
+#   }
+# }
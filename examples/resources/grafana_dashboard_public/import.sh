@@ -1,2 +1,12 @@
 terraform import grafana_dashboard_public.name "{{ dashboardUID }}:{{ publicDashboardUID }}"
 terraform import grafana_dashboard_public.name "{{ orgID }}:{{ dashboardUID }}:{{ publicDashboardUID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_dashboard_public.name
+#   identity = {
+#     org_id               = "{{ orgID }}" # Optional
+#     dashboard_uid        = "{{ dashboardUID }}"
+#     public_dashboard_uid = "{{ publicDashboardUID }}"
+#   }
+# }
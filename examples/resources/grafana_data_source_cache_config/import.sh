@@ -1,2 +1,11 @@
 terraform import grafana_data_source_cache_config.name "{{ datasource_uid }}"
 terraform import grafana_data_source_cache_config.name "{{ orgID }}:{{ datasource_uid }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_data_source_cache_config.name
+#   identity = {
+#     org_id         = "{{ orgID }}" # Optional
+#     datasource_uid = "{{ datasource_uid }}"
+#   }
+# }
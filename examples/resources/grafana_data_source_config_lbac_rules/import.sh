@@ -1 +1,9 @@
 terraform import grafana_data_source_config_lbac_rules.name "{{ datasource_uid }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_data_source_config_lbac_rules.name
+#   identity = {
+#     datasource_uid = "{{ datasource_uid }}"
+#   }
+# }
@@ -1,2 +1,11 @@
 terraform import grafana_data_source_permission.name "{{ datasourceID }}"
 terraform import grafana_data_source_permission.name "{{ orgID }}:{{ datasourceID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_data_source_permission.name
+#   identity = {
+#     org_id        = "{{ orgID }}" # Optional
+#     datasource_id = "{{ datasourceID }}"
+#   }
+# }
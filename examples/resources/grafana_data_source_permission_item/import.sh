@@ -1,2 +1,13 @@
 terraform import grafana_data_source_permission_item.name "{{ datasourceUID }}:{{ type (role, team, or user) }}:{{ identifier }}"
 terraform import grafana_data_source_permission_item.name "{{ orgID }}:{{ datasourceUID }}:{{ type (role, team, or user) }}:{{ identifier }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_data_source_permission_item.name
+#   identity = {
+#     org_id         = "{{ orgID }}" # Optional
+#     datasource_uid = "{{ datasourceUID }}"
+#     type           = "{{ type (role, team, or user) }}"
+#     identifier     = "{{ identifier }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_fleet_management_collector.name "{{ id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_fleet_management_collector.name
+#   identity = {
+#     id = "{{ id }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_fleet_management_pipeline.name "{{ name }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_fleet_management_pipeline.name
+#   identity = {
+#     name = "{{ name }}"
+#   }
+# }
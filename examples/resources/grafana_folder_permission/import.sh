@@ -1,2 +1,11 @@
 terraform import grafana_folder_permission.name "{{ folderUID }}"
 terraform import grafana_folder_permission.name "{{ orgID }}:{{ folderUID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_folder_permission.name
+#   identity = {
+#     org_id     = "{{ orgID }}" # Optional
+#     folder_uid = "{{ folderUID }}"
+#   }
+# }
@@ -1,2 +1,13 @@
 terraform import grafana_folder_permission_item.name "{{ folderUID }}:{{ type (role, team, or user) }}:{{ identifier }}"
 terraform import grafana_folder_permission_item.name "{{ orgID }}:{{ folderUID }}:{{ type (role, team, or user) }}:{{ identifier }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_folder_permission_item.name
+#   identity = {
+#     org_id     = "{{ orgID }}" # Optional
+#     folder_uid = "{{ folderUID }}"
+#     type       = "{{ type (role, team, or user) }}"
+#     identifier = "{{ identifier }}"
+#   }
+# }
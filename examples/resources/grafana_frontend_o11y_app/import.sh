@@ -1 +1,10 @@
-terraform import grafana_frontend_o11y_app.name "{{ stack_id }}:{{ name }}"
+terraform import grafana_frontend_o11y_app.name "{{ stack_id }}:{{ id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_frontend_o11y_app.name
+#   identity = {
+#     stack_id = "{{ stack_id }}"
+#     id       = "{{ id }}"
+#   }
+# }
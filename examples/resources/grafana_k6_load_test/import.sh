@@ -1 +1,9 @@
 terraform import grafana_k6_load_test.name "{{ id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_k6_load_test.name
+#   identity = {
+#     id = "{{ id }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_k6_project.name "{{ id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_k6_project.name
+#   identity = {
+#     id = "{{ id }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_k6_project_allowed_load_zones.name "{{ project_id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_k6_project_allowed_load_zones.name
+#   identity = {
+#     project_id = "{{ project_id }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_k6_schedule.name "{{ load_test_id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_k6_schedule.name
+#   identity = {
+#     load_test_id = "{{ load_test_id }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_machine_learning_job.name "{{ id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_machine_learning_job.name
+#   identity = {
+#     id = "{{ id }}"
+#   }
+# }
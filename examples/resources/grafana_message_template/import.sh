@@ -1,2 +1,11 @@
 terraform import grafana_message_template.name "{{ name }}"
 terraform import grafana_message_template.name "{{ orgID }}:{{ name }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_message_template.name
+#   identity = {
+#     org_id = "{{ orgID }}" # Optional
+#     name   = "{{ name }}"
+#   }
+# }
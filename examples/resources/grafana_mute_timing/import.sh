@@ -1,2 +1,11 @@
 terraform import grafana_mute_timing.name "{{ name }}"
 terraform import grafana_mute_timing.name "{{ orgID }}:{{ name }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_mute_timing.name
+#   identity = {
+#     org_id = "{{ orgID }}" # Optional
+#     name   = "{{ name }}"
+#   }
+# }
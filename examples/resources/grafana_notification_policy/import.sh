@@ -1,2 +1,11 @@
 terraform import grafana_notification_policy.name "{{ anyString }}"
 terraform import grafana_notification_policy.name "{{ orgID }}:{{ anyString }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_notification_policy.name
+#   identity = {
+#     org_id     = "{{ orgID }}" # Optional
+#     any_string = "{{ anyString }}"
+#   }
+# }
@@ -0,0 +1,11 @@
+terraform import grafana_notification_policy_route.name "{{ matchers }}"
+terraform import grafana_notification_policy_route.name "{{ orgID }}:{{ matchers }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_notification_policy_route.name
+#   identity = {
+#     org_id   = "{{ orgID }}" # Optional
+#     matchers = "{{ matchers }}"
+#   }
+# }
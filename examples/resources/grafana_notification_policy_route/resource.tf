@@ -0,0 +1,36 @@
+resource "grafana_contact_point" "team_contact_point" {
+  name = "Team Contact Point"
+
+  email {
+    addresses = ["team@company.org"]
+  }
+}
+
+resource "grafana_mute_timing" "team_mute_timing" {
+  name = "Team Mute Timing"
+
+  intervals {
+    weekdays = ["saturday", "sunday"]
+  }
+}
+
+resource "grafana_notification_policy_route" "team_route" {
+  matcher {
+    label = "team"
+    match = "="
+    value = "my-team"
+  }
+  contact_point = grafana_contact_point.team_contact_point.name
+  group_by      = ["alertname"]
+  mute_timings  = [grafana_mute_timing.team_mute_timing.name]
+  position      = 0
+
+  policy {
+    matcher {
+      label = "severity"
+      match = "="
+      value = "critical"
+    }
+    group_wait = "10s"
+  }
+}
@@ -1 +1,9 @@
 terraform import grafana_oncall_outgoing_webhook.name "{{ id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_oncall_outgoing_webhook.name
+#   identity = {
+#     id = "{{ id }}"
+#   }
+# }
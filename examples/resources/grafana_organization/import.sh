@@ -1 +1,9 @@
 terraform import grafana_organization.name "{{ id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_organization.name
+#   identity = {
+#     id = "{{ id }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_organization_preferences.name "{{ orgID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_organization_preferences.name
+#   identity = {
+#     org_id = "{{ orgID }}"
+#   }
+# }
@@ -1,2 +1,11 @@
 terraform import grafana_report.name "{{ id }}"
 terraform import grafana_report.name "{{ orgID }}:{{ id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_report.name
+#   identity = {
+#     org_id = "{{ orgID }}" # Optional
+#     id     = "{{ id }}"
+#   }
+# }
@@ -1,2 +1,11 @@
 terraform import grafana_role_assignment.name "{{ roleUID }}"
 terraform import grafana_role_assignment.name "{{ orgID }}:{{ roleUID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_role_assignment.name
+#   identity = {
+#     org_id   = "{{ orgID }}" # Optional
+#     role_uid = "{{ roleUID }}"
+#   }
+# }
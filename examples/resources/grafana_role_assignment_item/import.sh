@@ -1,2 +1,13 @@
 terraform import grafana_role_assignment_item.name "{{ roleUID }}:{{ type (user, team or service_account) }}:{{ identifier }}"
 terraform import grafana_role_assignment_item.name "{{ orgID }}:{{ roleUID }}:{{ type (user, team or service_account) }}:{{ identifier }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_role_assignment_item.name
+#   identity = {
+#     org_id     = "{{ orgID }}" # Optional
+#     role_uid   = "{{ roleUID }}"
+#     type       = "{{ type (user, team or service_account) }}"
+#     identifier = "{{ identifier }}"
+#   }
+# }
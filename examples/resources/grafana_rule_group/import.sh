@@ -1,2 +1,12 @@
 terraform import grafana_rule_group.name "{{ folderUID }}:{{ title }}"
 terraform import grafana_rule_group.name "{{ orgID }}:{{ folderUID }}:{{ title }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_rule_group.name
+#   identity = {
+#     org_id     = "{{ orgID }}" # Optional
+#     folder_uid = "{{ folderUID }}"
+#     title      = "{{ title }}"
+#   }
+# }
@@ -1,2 +1,10 @@
 terraform import grafana_scim_config.name ""
 terraform import grafana_scim_config.name "{{ orgID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_scim_config.name
+#   identity = {
+#     org_id = "{{ orgID }}" # Optional
+#   }
+# }
@@ -1,2 +1,11 @@
 terraform import grafana_service_account_permission.name "{{ serviceAccountID }}"
 terraform import grafana_service_account_permission.name "{{ orgID }}:{{ serviceAccountID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_service_account_permission.name
+#   identity = {
+#     org_id             = "{{ orgID }}" # Optional
+#     service_account_id = "{{ serviceAccountID }}"
+#   }
+# }
@@ -1,2 +1,13 @@
 terraform import grafana_service_account_permission_item.name "{{ serviceAccountID }}:{{ type (role, team, or user) }}:{{ identifier }}"
 terraform import grafana_service_account_permission_item.name "{{ orgID }}:{{ serviceAccountID }}:{{ type (role, team, or user) }}:{{ identifier }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_service_account_permission_item.name
+#   identity = {
+#     org_id             = "{{ orgID }}" # Optional
+#     service_account_id = "{{ serviceAccountID }}"
+#     type               = "{{ type (role, team, or user) }}"
+#     identifier         = "{{ identifier }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_slo.name "{{ uuid }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_slo.name
+#   identity = {
+#     uuid = "{{ uuid }}"
+#   }
+# }
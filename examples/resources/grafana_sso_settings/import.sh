@@ -1,2 +1,11 @@
 terraform import grafana_sso_settings.name "{{ provider }}"
 terraform import grafana_sso_settings.name "{{ orgID }}:{{ provider }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_sso_settings.name
+#   identity = {
+#     org_id   = "{{ orgID }}" # Optional
+#     provider = "{{ provider }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_synthetic_monitoring_check_alerts.name "{{ check_id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_synthetic_monitoring_check_alerts.name
+#   identity = {
+#     check_id = "{{ check_id }}"
+#   }
+# }
@@ -1,2 +1,11 @@
 terraform import grafana_synthetic_monitoring_probe.name "{{ id }}"
 terraform import grafana_synthetic_monitoring_probe.name "{{ id }}:{{ authToken }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_synthetic_monitoring_probe.name
+#   identity = {
+#     id         = "{{ id }}"
+#     auth_token = "{{ authToken }}" # Optional
+#   }
+# }
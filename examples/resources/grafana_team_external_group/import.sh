@@ -1,2 +1,11 @@
 terraform import grafana_team_external_group.name "{{ teamID }}"
 terraform import grafana_team_external_group.name "{{ orgID }}:{{ teamID }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_team_external_group.name
+#   identity = {
+#     org_id  = "{{ orgID }}" # Optional
+#     team_id = "{{ teamID }}"
+#   }
+# }
@@ -1 +1,9 @@
 terraform import grafana_user.name "{{ id }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_user.name
+#   identity = {
+#     id = "{{ id }}"
+#   }
+# }
@@ -0,0 +1,236 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/prometheus/common/model"
+)
+
+// AlertQuery is a query or a server-side expression of an alert or recording rule.
+type AlertQuery struct {
+	RefID         string
+	DatasourceUID string
+	Model         string // JSON
+}
+
+var (
+	// Datasource UIDs of server-side expressions, "-100" is the legacy one
+	expressionDatasourceUIDs = []string{"__expr__", "-100"}
+
+	// $A or ${A}, braces are required when the refId contains spaces
+	mathReferenceRegexp = regexp.MustCompile(`\$\{([^}]+)\}|\$([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+	expressionReducers        = []string{"sum", "mean", "min", "max", "count", "last", "median"}
+	expressionReducerModes    = []string{"", "dropNN", "replaceNN"}
+	expressionUpsamplers      = []string{"pad", "backfilling", "fillna"}
+	expressionThresholdTypes  = []string{"gt", "lt", "within_range", "outside_range", "within_range_included", "outside_range_included"}
+	classicConditionReducers  = []string{"avg", "min", "max", "sum", "count", "last", "median", "diff", "diff_abs", "percent_diff", "percent_diff_abs", "count_non_null"}
+	classicConditionEvaluator = append([]string{"no_value"}, expressionThresholdTypes...)
+)
+
+// ValidateAlertQueries checks the queries and server-side expressions of a rule without contacting Grafana:
+// the expression models (math, reduce, resample, classic_conditions and threshold) must be valid,
+// the refIds they reference must exist and must not form a cycle, and the condition must be one of the refIds.
+// The condition is not checked when it is empty. Datasource queries and other expression types (SQL) are opaque.
+func ValidateAlertQueries(queries []AlertQuery, condition string) []error {
+	var errs []error
+	references := map[string][]string{}
+	for _, query := range queries {
+		if _, ok := references[query.RefID]; ok {
+			errs = append(errs, fmt.Errorf("the refId %q is used by several queries", query.RefID))
+			continue
+		}
+		refs, err := alertQueryReferences(query)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", query.RefID, err))
+		}
+		references[query.RefID] = refs
+	}
+
+	if condition != "" {
+		if _, ok := references[condition]; !ok {
+			errs = append(errs, fmt.Errorf("the condition %q is not the refId of a query or expression of the rule", condition))
+		}
+	}
+
+	for _, refID := range slices.Sorted(maps.Keys(references)) {
+		for _, ref := range references[refID] {
+			if _, ok := references[ref]; !ok {
+				errs = append(errs, fmt.Errorf("%s: the expression references %q, which is not the refId of a query or expression of the rule", refID, ref))
+			}
+		}
+	}
+
+	if cycle := alertQueryCycle(references); cycle != nil {
+		errs = append(errs, fmt.Errorf("the expressions reference each other in a cycle: %s", strings.Join(cycle, " -> ")))
+	}
+	return errs
+}
+
+// alertQueryReferences returns the refIds referenced by a server-side expression, or nil for a datasource query.
+func alertQueryReferences(query AlertQuery) ([]string, error) {
+	var m map[string]any
+	if err := json.Unmarshal([]byte(query.Model), &m); err != nil {
+		return nil, fmt.Errorf("the model is not valid JSON: %w", err)
+	}
+	datasource, _ := m["datasource"].(map[string]any)
+	if !slices.Contains(expressionDatasourceUIDs, query.DatasourceUID) && datasource["type"] != "__expr__" {
+		return nil, nil
+	}
+
+	expressionType, _ := m["type"].(string)
+	expression, _ := m["expression"].(string)
+	switch expressionType {
+	case "math":
+		if expression == "" {
+			return nil, fmt.Errorf("the math expression is empty")
+		}
+		var refs []string
+		for _, match := range mathReferenceRegexp.FindAllStringSubmatch(expression, -1) {
+			ref := match[1] + match[2]
+			if !slices.Contains(refs, ref) {
+				refs = append(refs, ref)
+			}
+		}
+		return refs, nil
+
+	case "reduce":
+		if expression == "" {
+			return nil, fmt.Errorf("the reduce expression has no input")
+		}
+		if reducer, _ := m["reducer"].(string); !slices.Contains(expressionReducers, reducer) {
+			return nil, fmt.Errorf("invalid reducer %q, must be one of %v", reducer, expressionReducers)
+		}
+		if settings, ok := m["settings"].(map[string]any); ok {
+			mode, _ := settings["mode"].(string)
+			if !slices.Contains(expressionReducerModes, mode) {
+				return nil, fmt.Errorf("invalid reduce mode %q, must be one of %v", mode, expressionReducerModes[1:])
+			}
+			if _, ok := settings["replaceWithValue"].(float64); mode == "replaceNN" && !ok {
+				return nil, fmt.Errorf("the replaceNN reduce mode requires a numeric replaceWithValue")
+			}
+		}
+		return []string{expression}, nil
+
+	case "resample":
+		if expression == "" {
+			return nil, fmt.Errorf("the resample expression has no input")
+		}
+		window, _ := m["window"].(string)
+		if _, err := model.ParseDuration(window); err != nil {
+			return nil, fmt.Errorf("invalid resample window %q: %w", window, err)
+		}
+		if downsampler, _ := m["downsampler"].(string); !slices.Contains(expressionReducers, downsampler) {
+			return nil, fmt.Errorf("invalid downsampler %q, must be one of %v", downsampler, expressionReducers)
+		}
+		if upsampler, _ := m["upsampler"].(string); !slices.Contains(expressionUpsamplers, upsampler) {
+			return nil, fmt.Errorf("invalid upsampler %q, must be one of %v", upsampler, expressionUpsamplers)
+		}
+		return []string{expression}, nil
+
+	case "threshold":
+		if expression == "" {
+			return nil, fmt.Errorf("the threshold expression has no input")
+		}
+		conditions, _ := m["conditions"].([]any)
+		if len(conditions) == 0 {
+			return nil, fmt.Errorf("the threshold expression has no conditions")
+		}
+		for _, c := range conditions {
+			c, _ := c.(map[string]any)
+			evaluator, _ := c["evaluator"].(map[string]any)
+			if err := validateAlertEvaluator(evaluator, expressionThresholdTypes); err != nil {
+				return nil, err
+			}
+		}
+		return []string{expression}, nil
+
+	case "classic_conditions":
+		conditions, _ := m["conditions"].([]any)
+		if len(conditions) == 0 {
+			return nil, fmt.Errorf("the classic condition has no conditions")
+		}
+		var refs []string
+		for i, c := range conditions {
+			c, _ := c.(map[string]any)
+			query, _ := c["query"].(map[string]any)
+			params, _ := query["params"].([]any)
+			if len(params) == 0 {
+				return nil, fmt.Errorf("the classic condition %d has no query", i)
+			}
+			if ref, _ := params[0].(string); !slices.Contains(refs, ref) {
+				refs = append(refs, ref)
+			}
+			reducer, _ := c["reducer"].(map[string]any)
+			if reducerType, _ := reducer["type"].(string); !slices.Contains(classicConditionReducers, reducerType) {
+				return nil, fmt.Errorf("invalid reducer %q in the classic condition %d, must be one of %v", reducerType, i, classicConditionReducers)
+			}
+			evaluator, _ := c["evaluator"].(map[string]any)
+			if err := validateAlertEvaluator(evaluator, classicConditionEvaluator); err != nil {
+				return nil, fmt.Errorf("classic condition %d: %w", i, err)
+			}
+		}
+		return refs, nil
+	}
+	return nil, nil
+}
+
+func validateAlertEvaluator(evaluator map[string]any, evaluatorTypes []string) error {
+	evaluatorType, _ := evaluator["type"].(string)
+	if !slices.Contains(evaluatorTypes, evaluatorType) {
+		return fmt.Errorf("invalid evaluator %q, must be one of %v", evaluatorType, evaluatorTypes)
+	}
+	params, _ := evaluator["params"].([]any)
+	expected := 1
+	switch evaluatorType {
+	case "no_value":
+		expected = 0
+	case "within_range", "outside_range", "within_range_included", "outside_range_included":
+		expected = 2
+	}
+	if len(params) < expected {
+		return fmt.Errorf("the %s evaluator requires %d parameters", evaluatorType, expected)
+	}
+	return nil
+}
+
+// alertQueryCycle returns the refIds of a reference cycle, starting and ending with the same refId, or nil.
+func alertQueryCycle(references map[string][]string) []string {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	var stack []string
+	var visit func(refID string) []string
+	visit = func(refID string) []string {
+		switch state[refID] {
+		case visiting:
+			return append(slices.Clone(stack[slices.Index(stack, refID):]), refID)
+		case visited:
+			return nil
+		}
+		state[refID] = visiting
+		stack = append(stack, refID)
+		for _, ref := range references[refID] {
+			if cycle := visit(ref); cycle != nil {
+				return cycle
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[refID] = visited
+		return nil
+	}
+
+	for _, refID := range slices.Sorted(maps.Keys(references)) {
+		if cycle := visit(refID); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitValidateAlertQueries(t *testing.T) {
+	t.Parallel()
+
+	query := func(refID string) AlertQuery {
+		return AlertQuery{RefID: refID, DatasourceUID: "prometheus-uid", Model: `{"expr": "up"}`}
+	}
+	expression := func(refID, model string) AlertQuery {
+		return AlertQuery{RefID: refID, DatasourceUID: "__expr__", Model: model}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		queries   []AlertQuery
+		condition string
+		expected  []string
+	}{
+		{
+			name: "valid",
+			queries: []AlertQuery{
+				query("A"),
+				expression("B", `{"type": "reduce", "expression": "A", "reducer": "last", "settings": {"mode": "replaceNN", "replaceWithValue": 0}}`),
+				expression("C", `{"type": "resample", "expression": "A", "window": "5m", "downsampler": "mean", "upsampler": "fillna"}`),
+				expression("D", `{"type": "math", "expression": "$B + ${C} > 1"}`),
+				expression("E", `{"type": "threshold", "expression": "D", "conditions": [{"evaluator": {"type": "within_range", "params": [0, 10]}}]}`),
+				{RefID: "F", DatasourceUID: "-100", Model: `{"type": "classic_conditions", "conditions": [{"query": {"params": ["A"]}, "reducer": {"type": "avg"}, "evaluator": {"type": "gt", "params": [3]}}]}`},
+				expression("G", `{"type": "sql", "expression": "SELECT * FROM A"}`),
+			},
+			condition: "E",
+		},
+		{
+			name: "expression datasource in the model",
+			queries: []AlertQuery{
+				query("A"),
+				{RefID: "B", DatasourceUID: "", Model: `{"type": "math", "expression": "$Z", "datasource": {"type": "__expr__", "uid": "__expr__"}}`},
+			},
+			expected: []string{`B: the expression references "Z", which is not the refId of a query or expression of the rule`},
+		},
+		{
+			name: "dangling references",
+			queries: []AlertQuery{
+				query("A"),
+				expression("B", `{"type": "math", "expression": "$A * ${My Query}"}`),
+				expression("C", `{"type": "reduce", "expression": "X", "reducer": "max"}`),
+			},
+			condition: "D",
+			expected: []string{
+				`the condition "D" is not the refId of a query or expression of the rule`,
+				`B: the expression references "My Query", which is not the refId of a query or expression of the rule`,
+				`C: the expression references "X", which is not the refId of a query or expression of the rule`,
+			},
+		},
+		{
+			name: "cycle",
+			queries: []AlertQuery{
+				query("A"),
+				expression("B", `{"type": "math", "expression": "$A + $C"}`),
+				expression("C", `{"type": "reduce", "expression": "D", "reducer": "sum"}`),
+				expression("D", `{"type": "threshold", "expression": "B", "conditions": [{"evaluator": {"type": "gt", "params": [1]}}]}`),
+			},
+			condition: "D",
+			expected:  []string{"the expressions reference each other in a cycle: B -> C -> D -> B"},
+		},
+		{
+			name: "invalid models",
+			queries: []AlertQuery{
+				query("A"),
+				query("A"),
+				expression("B", `{"type": "reduce", "expression": "A", "reducer": "average"}`),
+				expression("C", `{"type": "reduce", "expression": "A", "reducer": "last", "settings": {"mode": "replaceNN"}}`),
+				expression("D", `{"type": "resample", "expression": "A", "window": "5 minutes", "downsampler": "mean", "upsampler": "pad"}`),
+				expression("E", `{"type": "resample", "expression": "A", "window": "5m", "downsampler": "mean", "upsampler": "interpolate"}`),
+				expression("F", `{"type": "threshold", "expression": "A", "conditions": [{"evaluator": {"type": "outside_range", "params": [1]}}]}`),
+				expression("G", `{"type": "classic_conditions", "conditions": [{"query": {"params": ["A"]}, "reducer": {"type": "p95"}, "evaluator": {"type": "gt", "params": [1]}}]}`),
+				expression("H", `{"type": "math", "expression": ""}`),
+				expression("I", `{`),
+			},
+			expected: []string{
+				`the refId "A" is used by several queries`,
+				`B: invalid reducer "average", must be one of [sum mean min max count last median]`,
+				`C: the replaceNN reduce mode requires a numeric replaceWithValue`,
+				`D: invalid resample window "5 minutes": unknown unit " minutes" in duration "5 minutes"`,
+				`E: invalid upsampler "interpolate", must be one of [pad backfilling fillna]`,
+				`F: the outside_range evaluator requires 2 parameters`,
+				`G: invalid reducer "p95" in the classic condition 0, must be one of [avg min max sum count last median diff diff_abs percent_diff percent_diff_abs count_non_null]`,
+				`H: the math expression is empty`,
+				`I: the model is not valid JSON: unexpected end of JSON input`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, err := range ValidateAlertQueries(tc.queries, tc.condition) {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -2,11 +2,14 @@ package common
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync"
 
+	"github.com/go-openapi/runtime"
 	onCallAPI "github.com/grafana/amixr-api-go-client"
 	"github.com/grafana/grafana-app-sdk/k8s"
 	assertsapi "github.com/grafana/grafana-asserts-public-clients/go/gcom"
@@ -58,11 +61,55 @@ type Client struct {
 	K6APIClient *k6.APIClient
 	K6APIConfig *k6providerapi.K6APIConfig
 
+	// HTTPClient has the retries, request limits, read-only mode and audit log of the provider, without credentials.
+	// It's given to the API clients which the resources create themselves, e.g. for the URL of a stack.
+	HTTPClient *http.Client
+
+	// DashboardLint is nil unless the `dashboard_lint` provider block is set
+	DashboardLint DashboardLintConfig
+
+	// ReadOnly is true when the `read_only` provider attribute is set. The HTTP clients then reject the write requests.
+	ReadOnly bool
+
+	// DefaultLabels are the labels of the `default_labels` provider attribute, merged into the labels of the resources
+	DefaultLabels DefaultLabels
+
+	// DatasourceUIDMap is nil unless the `datasource_uid_map` provider attribute is set
+	DatasourceUIDMap DatasourceUIDMap
+
+	// RequestsLimited is true when the `max_concurrent_requests` or `requests_per_second` provider attributes are set.
+	// The folder and dashboard mutexes are then not used, the requests are limited instead.
+	RequestsLimited bool
+
+	// alertingMutex serializes the changes of the alerting provisioning singletons (notification policies, contact points
+	// and mute timings are stored in a single Alertmanager configuration), even when the requests are limited.
 	alertingMutex  sync.Mutex
 	folderMutex    sync.Mutex
 	dashboardMutex sync.Mutex
 }
 
+// ErrReadOnly is returned for the requests which could modify a resource when the provider is in read-only mode.
+var ErrReadOnly = errors.New("the provider is in read-only mode (read_only = true or GRAFANA_READ_ONLY), write requests are rejected")
+
+// ReadOnlyError is the error of a write request rejected in read-only mode, it matches ErrReadOnly with errors.Is.
+type ReadOnlyError struct {
+	Method string
+	Path   string
+	// ResourceType is empty when the request isn't sent by a resource, e.g. by the provider configuration.
+	ResourceType string
+}
+
+func (e *ReadOnlyError) Error() string {
+	if e.ResourceType == "" {
+		return fmt.Sprintf("%s %s: %s", e.Method, e.Path, ErrReadOnly)
+	}
+	return fmt.Sprintf("%s %s (%s): %s", e.Method, e.Path, e.ResourceType, ErrReadOnly)
+}
+
+func (e *ReadOnlyError) Unwrap() error {
+	return ErrReadOnly
+}
+
 // WithAlertingMutex is a helper function that wraps a CRUD Terraform function with a mutex.
 func WithAlertingMutex[T schema.CreateContextFunc | schema.ReadContextFunc | schema.UpdateContextFunc | schema.DeleteContextFunc](f T) T {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -80,41 +127,68 @@ func (c *Client) WithAlertingLock(f func()) {
 	f()
 }
 
-// WithFolderMutex is a helper function that wraps a CRUD Terraform function with a mutex.
+// WithFolderMutex is a helper function that wraps a CRUD Terraform function with a mutex, unless the requests are limited.
 func WithFolderMutex[T schema.CreateContextFunc | schema.ReadContextFunc | schema.UpdateContextFunc | schema.DeleteContextFunc](f T) T {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		lock := &meta.(*Client).folderMutex
-		lock.Lock()
-		defer lock.Unlock()
+		client := meta.(*Client)
+		defer client.lockUnlessRequestsLimited(&client.folderMutex)()
 		return f(ctx, d, meta)
 	}
 }
 
-// WithFolderLock runs f while holding the folder mutex. Used by Plugin Framework resources that need to serialize folder API calls.
+// WithFolderLock runs f while holding the folder mutex, unless the requests are limited. Used by Plugin Framework resources that need to serialize folder API calls.
 func (c *Client) WithFolderLock(f func()) {
-	c.folderMutex.Lock()
-	defer c.folderMutex.Unlock()
+	defer c.lockUnlessRequestsLimited(&c.folderMutex)()
 	f()
 }
 
-// WithDashboardMutex is a helper function that wraps a CRUD Terraform function with a mutex.
+// WithDashboardMutex is a helper function that wraps a CRUD Terraform function with a mutex, unless the requests are limited.
 func WithDashboardMutex[T schema.CreateContextFunc | schema.ReadContextFunc | schema.UpdateContextFunc | schema.DeleteContextFunc](f T) T {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		lock := &meta.(*Client).dashboardMutex
-		lock.Lock()
-		defer lock.Unlock()
+		client := meta.(*Client)
+		defer client.lockUnlessRequestsLimited(&client.dashboardMutex)()
 		return f(ctx, d, meta)
 	}
 }
 
-// WithDashboardLock runs f while holding the dashboard mutex. Used by Plugin Framework resources that need to serialize dashboard API calls.
+// WithDashboardLock runs f while holding the dashboard mutex, unless the requests are limited. Used by Plugin Framework resources that need to serialize dashboard API calls.
 func (c *Client) WithDashboardLock(f func()) {
-	c.dashboardMutex.Lock()
-	defer c.dashboardMutex.Unlock()
+	defer c.lockUnlessRequestsLimited(&c.dashboardMutex)()
 	f()
 }
 
+// lockUnlessRequestsLimited locks the mutex and returns its unlock function. The folder and dashboard APIs don't support concurrent
+// changes well, so they are serialized by default. When the requests are limited, the limits are used instead.
+func (c *Client) lockUnlessRequestsLimited(mutex *sync.Mutex) (unlock func()) {
+	if c.RequestsLimited {
+		return func() {}
+	}
+	mutex.Lock()
+	return mutex.Unlock
+}
+
 func (c *Client) GrafanaSubpath(path string) string {
 	path = strings.TrimPrefix(path, c.GrafanaAPIURLParsed.Path)
 	return c.GrafanaAPIURLParsed.JoinPath(path).String()
 }
+
+// OAPIClientWithContext returns a copy of the Grafana API client which sends its requests with the context, unless
+// their parameters have their own. Most OpenAPI calls of the resources don't take a context, this is how their requests
+// carry the resource type to the audit log and to the read-only errors. WithOrgID must be called before, it resets the transport.
+func OAPIClientWithContext(ctx context.Context, client *goapi.GrafanaHTTPAPI) *goapi.GrafanaHTTPAPI {
+	client = client.Clone()
+	client.SetTransport(&oapiContextTransport{ClientTransport: client.Transport, ctx: ctx})
+	return client
+}
+
+type oapiContextTransport struct {
+	runtime.ClientTransport
+	ctx context.Context
+}
+
+func (t *oapiContextTransport) Submit(operation *runtime.ClientOperation) (any, error) {
+	if operation.Context == nil {
+		operation.Context = t.ctx
+	}
+	return t.ClientTransport.Submit(operation)
+}
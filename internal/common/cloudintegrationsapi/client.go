@@ -90,6 +90,27 @@ func (c *Client) GetIntegration(ctx context.Context, slug string) (*models.GetIn
 	return &response, nil
 }
 
+// ListInstalledIntegrations returns the slugs of the integrations installed on the stack, sorted
+func (c *Client) ListInstalledIntegrations(ctx context.Context) ([]string, error) {
+	path := fmt.Sprintf("%s/integrations", adminBasePath)
+
+	var response models.ListIntegrationsResponse
+	err := c.doAPIRequest(ctx, http.MethodGet, path, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list integrations: %w", err)
+	}
+
+	var slugs []string
+	for slug, integration := range response.Data {
+		if integration.Installation != nil {
+			slugs = append(slugs, slug)
+		}
+	}
+	slices.Sort(slugs)
+
+	return slugs, nil
+}
+
 // PostDashboards posts dashboards for an integration with the given configuration
 func (c *Client) PostDashboards(ctx context.Context, slug string, config *models.InstallationConfig) (*models.GetDashboardsResponse, error) {
 	path := fmt.Sprintf("%s/integrations/%s/dashboards", adminBasePath, url.PathEscape(slug))
@@ -137,6 +137,46 @@ func TestUnit_GetIntegration(t *testing.T) {
 	})
 }
 
+func TestUnit_ListInstalledIntegrations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns the sorted slugs of installed integrations", func(t *testing.T) {
+		t.Parallel()
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, integrationsBasePath+"/integrations", r.URL.Path)
+
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(models.ListIntegrationsResponse{
+				Data: map[string]models.Integration{
+					"mysql":  {Slug: "mysql", Installation: &models.Installation{Version: "1.0.0"}},
+					"docker": {Slug: "docker", Installation: &models.Installation{Version: "2.1.0"}},
+					"linux":  {Slug: "linux"},
+				},
+			})
+		}))
+		defer svr.Close()
+
+		c := newTestClient(t, svr)
+		slugs, err := c.ListInstalledIntegrations(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"docker", "mysql"}, slugs)
+	})
+
+	t.Run("returns ErrUnauthorized on 401", func(t *testing.T) {
+		t.Parallel()
+		svr := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer svr.Close()
+
+		c := newTestClient(t, svr)
+		_, err := c.ListInstalledIntegrations(context.Background())
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, cloudintegrationsapi.ErrUnauthorized)
+	})
+}
+
 func TestUnit_IsIntegrationInstalled(t *testing.T) {
 	t.Parallel()
 
@@ -59,6 +59,11 @@ type GetIntegrationResponse struct {
 	Data Integration `json:"data"`
 }
 
+// ListIntegrationsResponse represents the response from the list integrations API, keyed by integration slug
+type ListIntegrationsResponse struct {
+	Data map[string]Integration `json:"data"`
+}
+
 // InstallIntegrationRequest represents the request body for installing an integration
 type InstallIntegrationRequest struct {
 	Configuration *InstallationConfig `json:"configuration,omitempty"`
@@ -84,6 +84,16 @@ func (c *Client) GetAWSAccount(ctx context.Context, stackID string, accountID st
 	return respData.Data, nil
 }
 
+func (c *Client) ListAWSAccounts(ctx context.Context, stackID string) ([]AWSAccount, error) {
+	path := fmt.Sprintf("/api/v2/stacks/%s/aws/accounts", stackID)
+	respData := apiResponseWrapper[[]AWSAccount]{}
+	err := c.doAPIRequest(ctx, http.MethodGet, path, nil, &respData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list AWS accounts: %w", err)
+	}
+	return respData.Data, nil
+}
+
 func (c *Client) UpdateAWSAccount(ctx context.Context, stackID string, accountID string, accountData AWSAccount) (AWSAccount, error) {
 	path := fmt.Sprintf("/api/v2/stacks/%s/aws/accounts/%s", stackID, accountID)
 	respData := apiResponseWrapper[AWSAccount]{}
@@ -358,6 +368,17 @@ func (c *Client) GetAzureCredential(ctx context.Context, stackID string, credent
 	return respData.Data, nil
 }
 
+func (c *Client) ListAzureCredentials(ctx context.Context, stackID string) ([]AzureCredential, error) {
+	path := fmt.Sprintf("/api/v2/stacks/%s/azure/credentials", stackID)
+	respData := apiResponseWrapper[[]AzureCredential]{}
+	err := c.doAPIRequest(ctx, http.MethodGet, path, nil, &respData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Azure credentials: %w", err)
+	}
+
+	return respData.Data, nil
+}
+
 func (c *Client) UpdateAzureCredential(ctx context.Context, stackID string, accountID string, credentialData AzureCredential) (AzureCredential, error) {
 	path := fmt.Sprintf("/api/v2/stacks/%s/azure/credentials/%s", stackID, accountID)
 	respData := apiResponseWrapper[AzureCredential]{}
@@ -84,6 +84,22 @@ func (c *Client) GetMetricsEndpointScrapeJob(ctx context.Context, stackID, jobNa
 	return respData.Data, nil
 }
 
+// NamedMetricsEndpointScrapeJob is a metrics endpoint scrape job as returned when listing the jobs of a stack.
+type NamedMetricsEndpointScrapeJob struct {
+	Name string `json:"name"`
+	MetricsEndpointScrapeJob
+}
+
+func (c *Client) ListMetricsEndpointScrapeJobs(ctx context.Context, stackID string) ([]NamedMetricsEndpointScrapeJob, error) {
+	path := fmt.Sprintf("%s/%s/metrics-endpoint/jobs", pathPrefix, stackID)
+	respData := apiResponseWrapper[[]NamedMetricsEndpointScrapeJob]{}
+	err := c.doAPIRequest(ctx, http.MethodGet, path, nil, &respData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list metrics endpoint scrape jobs: %w", err)
+	}
+	return respData.Data, nil
+}
+
 func (c *Client) UpdateMetricsEndpointScrapeJob(ctx context.Context, stackID, jobName string, jobData MetricsEndpointScrapeJob) (MetricsEndpointScrapeJob, error) {
 	path := fmt.Sprintf("%s/%s/metrics-endpoint/jobs/%s", pathPrefix, stackID, jobName)
 	respData := apiResponseWrapper[MetricsEndpointScrapeJob]{}
@@ -258,6 +258,66 @@ func TestClient_GetMetricsEndpointScrapeJob(t *testing.T) {
 	})
 }
 
+func TestClient_ListMetricsEndpointScrapeJobs(t *testing.T) {
+	defaultHeaders := map[string]string{"Grafana-Terraform-Provider": "True"}
+	t.Run("successfully sends request and receives response", func(t *testing.T) {
+		svr := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "True", r.Header.Get("Grafana-Terraform-Provider"))
+			assert.Equal(t, "/api/v1/stacks/some-stack-id/metrics-endpoint/jobs", r.URL.Path)
+
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`
+			{
+				"status":"success",
+				"data":[
+					{
+						"name":"test_job",
+						"enabled":true,
+						"authentication_method":"bearer",
+						"url":"https://my-example-url.com:9000/metrics",
+						"scrape_interval_seconds":60,
+						"flavor":"default"
+					}
+				]
+			}`))
+		}))
+		defer svr.Close()
+
+		c, err := connectionsapi.NewClient("some token", svr.URL, svr.Client(), "some-user-agent", defaultHeaders)
+		require.NoError(t, err)
+		actualJobs, err := c.ListMetricsEndpointScrapeJobs(context.Background(), "some-stack-id")
+		assert.NoError(t, err)
+
+		assert.Equal(t, []connectionsapi.NamedMetricsEndpointScrapeJob{
+			{
+				Name: "test_job",
+				MetricsEndpointScrapeJob: connectionsapi.MetricsEndpointScrapeJob{
+					Enabled:               true,
+					AuthenticationMethod:  "bearer",
+					URL:                   "https://my-example-url.com:9000/metrics",
+					ScrapeIntervalSeconds: 60,
+				},
+			},
+		}, actualJobs)
+	})
+
+	t.Run("returns ErrUnauthorized when connections API responds 401", func(t *testing.T) {
+		svr := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(401)
+		}))
+		defer svr.Close()
+
+		c, err := connectionsapi.NewClient("some token", svr.URL, svr.Client(), "some-user-agent", defaultHeaders)
+		require.NoError(t, err)
+		_, err = c.ListMetricsEndpointScrapeJobs(context.Background(), "some-stack-id")
+
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, connectionsapi.ErrUnauthorized)
+		assert.Equal(t, `failed to list metrics endpoint scrape jobs: request not authorized for stack`, err.Error())
+	})
+}
+
 func TestClient_UpdateMetricsEndpointScrapeJob(t *testing.T) {
 	defaultHeaders := map[string]string{"Grafana-Terraform-Provider": "True"}
 	t.Run("successfully sends request and receives response", func(t *testing.T) {
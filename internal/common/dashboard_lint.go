@@ -0,0 +1,237 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type DashboardLintSeverity string
+
+const (
+	DashboardLintOff     DashboardLintSeverity = "off"
+	DashboardLintWarning DashboardLintSeverity = "warning"
+	DashboardLintError   DashboardLintSeverity = "error"
+)
+
+var DashboardLintSeverities = []string{string(DashboardLintOff), string(DashboardLintWarning), string(DashboardLintError)}
+
+// DashboardLintRule is a check of the dashboard model, run at plan time when the `dashboard_lint` provider block is set.
+type DashboardLintRule struct {
+	Name        string
+	Description string
+	check       func(dashboard map[string]any) []string
+}
+
+// DashboardLintRules are the rules which can be configured in the `dashboard_lint` provider block.
+var DashboardLintRules = []DashboardLintRule{
+	{
+		Name:        "datasource_uid",
+		Description: "Panels, queries, variables and annotations reference their datasource by UID rather than by name.",
+		check:       lintDatasourceUID,
+	},
+	{
+		Name:        "no_hardcoded_id",
+		Description: "The dashboard model does not set the `id` field, which is assigned by Grafana.",
+		check:       lintNoHardcodedID,
+	},
+	{
+		Name:        "unused_variables",
+		Description: "Templating variables are used by a panel, a query or another variable.",
+		check:       lintUnusedVariables,
+	},
+	{
+		Name:        "unique_panel_ids",
+		Description: "Panel IDs are unique within the dashboard.",
+		check:       lintUniquePanelIDs,
+	},
+}
+
+// Datasources which are not referenced by UID, even in dashboards exported by Grafana
+var builtinDatasourceNames = []string{"-- Grafana --", "-- Mixed --", "-- Dashboard --"}
+
+// DashboardLintConfig maps the names of the enabled rules to their severity. A nil config disables linting.
+type DashboardLintConfig map[string]DashboardLintSeverity
+
+type DashboardLintIssue struct {
+	Rule     string
+	Severity DashboardLintSeverity
+	Message  string
+}
+
+// LintDashboardJSON runs the configured rules on a dashboard model.
+// Both the classic and the v2 (elements and layouts) dashboard models are supported,
+// as well as the Kubernetes-style envelope of the dashboard.
+// Invalid JSON is not reported, as it is already rejected by the resources.
+func (c DashboardLintConfig) LintDashboardJSON(configJSON string) []DashboardLintIssue {
+	if len(c) == 0 {
+		return nil
+	}
+
+	var dashboard map[string]any
+	if err := json.Unmarshal([]byte(configJSON), &dashboard); err != nil {
+		return nil
+	}
+	if spec, ok := dashboard["spec"].(map[string]any); ok && dashboard["apiVersion"] != nil {
+		dashboard = spec
+	}
+
+	var issues []DashboardLintIssue
+	for _, rule := range DashboardLintRules {
+		severity, ok := c[rule.Name]
+		if !ok || severity == DashboardLintOff {
+			continue
+		}
+		for _, message := range rule.check(dashboard) {
+			issues = append(issues, DashboardLintIssue{Rule: rule.Name, Severity: severity, Message: message})
+		}
+	}
+	return issues
+}
+
+func (i DashboardLintIssue) String() string {
+	return fmt.Sprintf("%s: %s", i.Rule, i.Message)
+}
+
+func lintDatasourceUID(dashboard map[string]any) []string {
+	var messages []string
+	walkDashboardJSON(dashboard, "", func(path string, key string, value any) {
+		name, ok := value.(string)
+		if key != "datasource" || !ok || name == "" || strings.HasPrefix(name, "$") || slices.Contains(builtinDatasourceNames, name) {
+			return
+		}
+		messages = append(messages, fmt.Sprintf("%s references the datasource %q by name, reference it by UID instead (`{\"type\": \"...\", \"uid\": \"...\"}`)", path, name))
+	})
+	return messages
+}
+
+func lintNoHardcodedID(dashboard map[string]any) []string {
+	if id, ok := dashboard["id"]; ok && id != nil {
+		return []string{fmt.Sprintf("the dashboard sets the `id` field (%v), remove it to let Grafana assign it", id)}
+	}
+	return nil
+}
+
+func lintUnusedVariables(dashboard map[string]any) []string {
+	// Classic model: templating.list[].name, v2 model: variables[].spec.name
+	var variables []any
+	var names []string
+	if templating, ok := dashboard["templating"].(map[string]any); ok {
+		variables, _ = templating["list"].([]any)
+		for _, v := range variables {
+			variable, _ := v.(map[string]any)
+			name, _ := variable["name"].(string)
+			names = append(names, name)
+		}
+	} else {
+		variables, _ = dashboard["variables"].([]any)
+		for _, v := range variables {
+			variable, _ := v.(map[string]any)
+			spec, _ := variable["spec"].(map[string]any)
+			name, _ := spec["name"].(string)
+			names = append(names, name)
+		}
+	}
+
+	var messages []string
+	for i, name := range names {
+		if name == "" {
+			continue
+		}
+
+		// Look for the variable everywhere except in its own definition
+		definition := variables[i]
+		variables[i] = nil
+		used := dashboardUsesVariable(dashboard, name)
+		variables[i] = definition
+		if !used {
+			messages = append(messages, fmt.Sprintf("the variable %q is not used", name))
+		}
+	}
+	return messages
+}
+
+func dashboardUsesVariable(dashboard map[string]any, name string) bool {
+	// $name, ${name}, ${name:format}, ${name.field} and the deprecated [[name]]
+	usage := regexp.MustCompile(`\$` + regexp.QuoteMeta(name) + `\b|\$\{` + regexp.QuoteMeta(name) + `[}:.]|\[\[` + regexp.QuoteMeta(name) + `[\]:]`)
+
+	used := false
+	walkDashboardJSON(dashboard, "", func(_ string, key string, value any) {
+		switch value := value.(type) {
+		case string:
+			// Repeated panels and rows reference the variable by name
+			if key == "repeat" && value == name || usage.MatchString(value) {
+				used = true
+			}
+		case map[string]any:
+			// v2 model: "repeat": {"mode": "variable", "value": "<name>"}
+			if key == "repeat" && value["value"] == name {
+				used = true
+			}
+		}
+	})
+	return used
+}
+
+func lintUniquePanelIDs(dashboard map[string]any) []string {
+	var ids []string
+	if elements, ok := dashboard["elements"].(map[string]any); ok {
+		// v2 model: elements.<key>.spec.id
+		for _, element := range elements {
+			element, _ := element.(map[string]any)
+			spec, _ := element["spec"].(map[string]any)
+			if id, ok := spec["id"]; ok && id != nil {
+				ids = append(ids, fmt.Sprint(id))
+			}
+		}
+	} else {
+		// Classic model: panels[].id, including the panels of collapsed rows
+		var collect func(panels []any)
+		collect = func(panels []any) {
+			for _, panel := range panels {
+				panel, _ := panel.(map[string]any)
+				if id, ok := panel["id"]; ok && id != nil {
+					ids = append(ids, fmt.Sprint(id))
+				}
+				if nested, ok := panel["panels"].([]any); ok {
+					collect(nested)
+				}
+			}
+		}
+		panels, _ := dashboard["panels"].([]any)
+		collect(panels)
+	}
+
+	slices.Sort(ids)
+	var messages []string
+	for i := 1; i < len(ids); i++ {
+		if ids[i] == ids[i-1] && (i == 1 || ids[i] != ids[i-2]) {
+			messages = append(messages, fmt.Sprintf("the panel ID %s is used by several panels", ids[i]))
+		}
+	}
+	return messages
+}
+
+// walkDashboardJSON calls f for each value of the dashboard model, with its JSON path and the key it is set at (empty for list elements).
+func walkDashboardJSON(value any, path string, f func(path string, key string, value any)) {
+	switch value := value.(type) {
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(value)) {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			f(childPath, key, value[key])
+			walkDashboardJSON(value[key], childPath, f)
+		}
+	case []any:
+		for i, element := range value {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			f(childPath, "", element)
+			walkDashboardJSON(element, childPath, f)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitLintDashboardJSON(t *testing.T) {
+	t.Parallel()
+
+	allRules := DashboardLintConfig{
+		"datasource_uid":   DashboardLintError,
+		"no_hardcoded_id":  DashboardLintError,
+		"unused_variables": DashboardLintWarning,
+		"unique_panel_ids": DashboardLintError,
+	}
+
+	for _, tc := range []struct {
+		name     string
+		config   DashboardLintConfig
+		json     string
+		expected []string
+	}{
+		{
+			name:   "disabled",
+			config: nil,
+			json:   `{"id": 1, "panels": [{"id": 1}, {"id": 1}]}`,
+		},
+		{
+			name:   "invalid JSON",
+			config: allRules,
+			json:   `{`,
+		},
+		{
+			name:   "clean classic dashboard",
+			config: allRules,
+			json: `{
+				"title": "Clean",
+				"panels": [
+					{"id": 1, "datasource": {"type": "prometheus", "uid": "prom"}, "targets": [{"expr": "up{job=\"$job\"}"}]},
+					{"id": 2, "datasource": "$datasource", "repeat": "instance"},
+					{"id": 3, "datasource": "-- Grafana --"}
+				],
+				"templating": {"list": [
+					{"name": "datasource", "type": "datasource"},
+					{"name": "job", "query": "label_values(${datasource}, job)"},
+					{"name": "instance", "query": "label_values(up{job=\"[[job]]\"}, instance)"}
+				]}
+			}`,
+		},
+		{
+			name:   "classic dashboard",
+			config: allRules,
+			json: `{
+				"id": 12,
+				"panels": [
+					{"id": 1, "datasource": "Prometheus"},
+					{"id": 2, "type": "row", "panels": [
+						{"id": 1, "targets": [{"datasource": "Loki"}]}
+					]}
+				],
+				"templating": {"list": [
+					{"name": "unused", "query": "label_values($unused)"},
+					{"name": "env", "datasource": {"uid": "prom"}}
+				]}
+			}`,
+			expected: []string{
+				`datasource_uid: panels[0].datasource references the datasource "Prometheus" by name, reference it by UID instead (` + "`" + `{"type": "...", "uid": "..."}` + "`" + `)`,
+				`datasource_uid: panels[1].panels[0].targets[0].datasource references the datasource "Loki" by name, reference it by UID instead (` + "`" + `{"type": "...", "uid": "..."}` + "`" + `)`,
+				"no_hardcoded_id: the dashboard sets the `id` field (12), remove it to let Grafana assign it",
+				`unused_variables: the variable "unused" is not used`,
+				`unused_variables: the variable "env" is not used`,
+				"unique_panel_ids: the panel ID 1 is used by several panels",
+			},
+		},
+		{
+			name: "rules turned off",
+			config: DashboardLintConfig{
+				"datasource_uid":   DashboardLintOff,
+				"no_hardcoded_id":  DashboardLintWarning,
+				"unused_variables": DashboardLintOff,
+				"unique_panel_ids": DashboardLintOff,
+			},
+			json: `{"id": 12, "panels": [{"id": 1, "datasource": "Prometheus"}, {"id": 1}]}`,
+			expected: []string{
+				"no_hardcoded_id: the dashboard sets the `id` field (12), remove it to let Grafana assign it",
+			},
+		},
+		{
+			name:   "v2 dashboard in a Kubernetes envelope",
+			config: allRules,
+			json: `{
+				"apiVersion": "dashboard.grafana.app/v2beta1",
+				"kind": "Dashboard",
+				"spec": {
+					"elements": {
+						"panel-1": {"kind": "Panel", "spec": {"id": 1, "title": "Requests in $cluster"}},
+						"panel-2": {"kind": "Panel", "spec": {"id": 1, "repeat": {"mode": "variable", "value": "pod"}}}
+					},
+					"variables": [
+						{"kind": "QueryVariable", "spec": {"name": "cluster"}},
+						{"kind": "QueryVariable", "spec": {"name": "pod"}},
+						{"kind": "CustomVariable", "spec": {"name": "unused"}}
+					]
+				}
+			}`,
+			expected: []string{
+				`unused_variables: the variable "unused" is not used`,
+				"unique_panel_ids: the panel ID 1 is used by several panels",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, issue := range tc.config.LintDashboardJSON(tc.json) {
+				assert.Equal(t, tc.config[issue.Rule], issue.Severity)
+				got = append(got, issue.String())
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
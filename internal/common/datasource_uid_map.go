@@ -0,0 +1,117 @@
+package common
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// DatasourceUIDMap maps the datasource UIDs of the configuration to the datasource UIDs of the Grafana instance,
+// see the `datasource_uid_map` provider attribute. The references are rewritten on write and reverted on read,
+// so that the same configuration can be applied to instances whose datasources have different UIDs.
+type DatasourceUIDMap map[string]string
+
+// NewDatasourceUIDMap returns the map, or an error when several UIDs are mapped to the same UID: it couldn't be reverted on read.
+func NewDatasourceUIDMap(uids map[string]string) (DatasourceUIDMap, error) {
+	if len(uids) == 0 {
+		return nil, nil
+	}
+	from := map[string]string{}
+	for _, uid := range slices.Sorted(maps.Keys(uids)) {
+		mapped := uids[uid]
+		if other, ok := from[mapped]; ok {
+			return nil, fmt.Errorf("datasource_uid_map maps both %q and %q to %q, the references can't be reverted on read", other, uid, mapped)
+		}
+		from[mapped] = uid
+	}
+	return DatasourceUIDMap(uids), nil
+}
+
+// MapUID returns the UID of the Grafana instance for a UID of the configuration, or the UID of the configuration
+// for a UID of the Grafana instance when reverse is set. The UIDs which aren't in the map are returned as they are.
+func (m DatasourceUIDMap) MapUID(uid string, reverse bool) string {
+	if !reverse {
+		if mapped, ok := m[uid]; ok {
+			return mapped
+		}
+		return uid
+	}
+	for from, to := range m {
+		if to == uid {
+			return from
+		}
+	}
+	return uid
+}
+
+// CheckUID returns an error when a UID of the configuration is a UID of the Grafana instance which another UID is mapped to.
+// The references to it would be reverted to the other UID on read, the configuration must reference the other UID instead.
+func (m DatasourceUIDMap) CheckUID(uid string) error {
+	if _, ok := m[uid]; ok {
+		return nil
+	}
+	for _, from := range slices.Sorted(maps.Keys(m)) {
+		if m[from] == uid {
+			return fmt.Errorf("the datasource UID %q is mapped to by %q in datasource_uid_map, the references to it would be read as %q: reference %q instead", uid, from, from, from)
+		}
+	}
+	return nil
+}
+
+// CheckJSON returns the error of CheckUID for a datasource reference of a JSON value which fails it, see MapJSON.
+func (m DatasourceUIDMap) CheckJSON(value any) error {
+	var err error
+	m.walkJSON(value, func(uid string) string {
+		if err == nil {
+			err = m.CheckUID(uid)
+		}
+		return uid
+	})
+	return err
+}
+
+// MapJSON rewrites the datasource references of a JSON value in place, e.g. a dashboard model or the model of an alert query.
+// The references are the `uid` (or `name`, in v2 dashboards) of the `datasource` objects, the `datasource` strings
+// the `datasourceUid` strings of the alert queries and the `targetDatasourceUid` strings of the recording rules.
+func (m DatasourceUIDMap) MapJSON(value any, reverse bool) {
+	m.walkJSON(value, func(uid string) string {
+		return m.MapUID(uid, reverse)
+	})
+}
+
+// walkJSON replaces the datasource references of a JSON value (see MapJSON) with the result of mapUID.
+func (m DatasourceUIDMap) walkJSON(value any, mapUID func(uid string) string) {
+	if len(m) == 0 {
+		return
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			switch {
+			case key == "datasource":
+				switch ref := child.(type) {
+				case string:
+					v[key] = mapUID(ref)
+					continue
+				case map[string]any:
+					for _, uidKey := range []string{"uid", "name"} {
+						if uid, ok := ref[uidKey].(string); ok {
+							ref[uidKey] = mapUID(uid)
+						}
+					}
+				}
+			case strings.EqualFold(key, "datasourceUid"), strings.EqualFold(key, "targetDatasourceUid"):
+				if uid, ok := child.(string); ok {
+					v[key] = mapUID(uid)
+					continue
+				}
+			}
+			m.walkJSON(child, mapUID)
+		}
+	case []any:
+		for _, child := range v {
+			m.walkJSON(child, mapUID)
+		}
+	}
+}
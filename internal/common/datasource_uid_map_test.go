@@ -0,0 +1,106 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitDatasourceUIDMap(t *testing.T) {
+	t.Parallel()
+
+	uids, err := NewDatasourceUIDMap(map[string]string{"prom-staging": "prom-prod", "loki-staging": "loki-prod"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name   string
+		config string
+		remote string
+	}{
+		{
+			name:   "dashboard",
+			config: `{"panels":[{"datasource":{"type":"prometheus","uid":"prom-staging"},"targets":[{"datasource":{"uid":"loki-staging"}},{"datasource":{"uid":"other"}}]}],"templating":{"list":[{"datasource":"prom-staging"}]}}`,
+			remote: `{"panels":[{"datasource":{"type":"prometheus","uid":"prom-prod"},"targets":[{"datasource":{"uid":"loki-prod"}},{"datasource":{"uid":"other"}}]}],"templating":{"list":[{"datasource":"prom-prod"}]}}`,
+		},
+		{
+			name:   "v2 dashboard",
+			config: `{"elements":{"panel-1":{"spec":{"data":{"spec":{"queries":[{"spec":{"query":{"datasource":{"name":"prom-staging"}}}}]}}}}}}`,
+			remote: `{"elements":{"panel-1":{"spec":{"data":{"spec":{"queries":[{"spec":{"query":{"datasource":{"name":"prom-prod"}}}}]}}}}}}`,
+		},
+		{
+			name:   "alert rule",
+			config: `{"expressions":{"A":{"datasourceUID":"prom-staging","model":{"expr":"up"}},"B":{"datasourceUID":"__expr__"}}}`,
+			remote: `{"expressions":{"A":{"datasourceUID":"prom-prod","model":{"expr":"up"}},"B":{"datasourceUID":"__expr__"}}}`,
+		},
+		{
+			name:   "recording rule",
+			config: `{"expressions":{"A":{"datasourceUID":"prom-staging","model":{"expr":"up"}}},"metric":"up_total","targetDatasourceUID":"prom-staging"}`,
+			remote: `{"expressions":{"A":{"datasourceUID":"prom-prod","model":{"expr":"up"}}},"metric":"up_total","targetDatasourceUID":"prom-prod"}`,
+		},
+		{
+			name:   "recording rule with unmapped target",
+			config: `{"expressions":{"A":{"datasourceUID":"loki-staging"}},"targetDatasourceUID":"other"}`,
+			remote: `{"expressions":{"A":{"datasourceUID":"loki-prod"}},"targetDatasourceUID":"other"}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var value any
+			require.NoError(t, json.Unmarshal([]byte(tc.config), &value))
+			uids.MapJSON(value, false)
+			mapped, err := json.Marshal(value)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.remote, string(mapped))
+
+			uids.MapJSON(value, true)
+			reverted, err := json.Marshal(value)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.config, string(reverted))
+		})
+	}
+}
+
+func TestUnitNewDatasourceUIDMap(t *testing.T) {
+	t.Parallel()
+
+	uids, err := NewDatasourceUIDMap(nil)
+	require.NoError(t, err)
+	assert.Nil(t, uids)
+	assert.Equal(t, "prom-staging", uids.MapUID("prom-staging", false))
+
+	_, err = NewDatasourceUIDMap(map[string]string{"a": "prod", "b": "prod"})
+	require.EqualError(t, err, `datasource_uid_map maps both "a" and "b" to "prod", the references can't be reverted on read`)
+}
+
+func TestUnitDatasourceUIDMapCheck(t *testing.T) {
+	t.Parallel()
+
+	uids, err := NewDatasourceUIDMap(map[string]string{"prom-staging": "prom-prod", "prom-dev": "prom-staging"})
+	require.NoError(t, err)
+
+	// A target UID referenced by the configuration would be read as the UID mapped to it: the state would never match the configuration
+	var value any
+	require.NoError(t, json.Unmarshal([]byte(`{"panels":[{"datasource":{"uid":"prom-prod"}}]}`), &value))
+	uids.MapJSON(value, false)
+	uids.MapJSON(value, true)
+	reverted, err := json.Marshal(value)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"panels":[{"datasource":{"uid":"prom-staging"}}]}`, string(reverted))
+
+	// Such references are rejected
+	require.NoError(t, json.Unmarshal([]byte(`{"panels":[{"datasource":{"uid":"prom-prod"}}]}`), &value))
+	require.EqualError(t, uids.CheckJSON(value), `the datasource UID "prom-prod" is mapped to by "prom-staging" in datasource_uid_map, the references to it would be read as "prom-staging": reference "prom-staging" instead`)
+	require.Error(t, uids.CheckUID("prom-prod"))
+
+	// The UIDs of the configuration are accepted, including the targets which are mapped too, and the UIDs which aren't in the map
+	require.NoError(t, json.Unmarshal([]byte(`{"panels":[{"datasource":{"uid":"prom-staging"}},{"datasource":"prom-dev"}],"targets":[{"datasourceUid":"other"}]}`), &value))
+	assert.NoError(t, uids.CheckJSON(value))
+	assert.NoError(t, uids.CheckUID("prom-staging"))
+	assert.NoError(t, uids.CheckUID("other"))
+
+	var empty DatasourceUIDMap
+	assert.NoError(t, empty.CheckUID("prom-prod"))
+}
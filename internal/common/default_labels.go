@@ -0,0 +1,158 @@
+package common
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DefaultLabels are the labels of the `default_labels` provider attribute. They are merged into the labels of the resources
+// and hidden from their state, so that they don't show in the diffs, like the `default_tags` of the AWS provider.
+// The resources show all their labels in a computed `labels_all` attribute (`tags_all` for dashboards), which is planned
+// from the configured labels and the default labels: changing the default labels updates the resources.
+type DefaultLabels map[string]string
+
+// Merge returns the labels of a resource with the default labels it doesn't set. The labels of the resource take precedence.
+func (l DefaultLabels) Merge(labels map[string]string) map[string]string {
+	if len(l) == 0 {
+		return labels
+	}
+	merged := maps.Clone(map[string]string(l))
+	maps.Copy(merged, labels)
+	return merged
+}
+
+// Strip returns the labels read from the API without the default labels, so that they don't show in the state of the resource.
+// A default label is kept when it's set in the configuration of the resource (configured) or when its value was changed outside of Terraform.
+func (l DefaultLabels) Strip(labels, configured map[string]string) map[string]string {
+	if len(l) == 0 || labels == nil {
+		return labels
+	}
+	stripped := make(map[string]string, len(labels))
+	for key, value := range labels {
+		if l.IsDefault(key, value) {
+			if _, ok := configured[key]; !ok {
+				continue
+			}
+		}
+		stripped[key] = value
+	}
+	return stripped
+}
+
+// All returns the labels of the `labels_all` attribute of a resource read from the API: the labels of its state (see Strip)
+// and the default labels set on the resource, with their values read from the API.
+// When the default labels change, All no longer matches the labels planned with Merge, which updates the resource.
+func (l DefaultLabels) All(labels, stripped map[string]string) map[string]string {
+	all := maps.Clone(stripped)
+	if all == nil {
+		all = map[string]string{}
+	}
+	for key := range l {
+		if value, ok := labels[key]; ok {
+			all[key] = value
+		}
+	}
+	return all
+}
+
+// Tags returns the default labels as `key:value` tags, for the resources which have tags rather than labels, e.g. dashboards.
+func (l DefaultLabels) Tags() []string {
+	tags := make([]string, 0, len(l))
+	for key, value := range l {
+		tags = append(tags, key+":"+value)
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+// MergeTags returns the tags of a resource with the default tags it doesn't have, see Tags.
+// A tag of the resource with the key of a default label takes precedence, e.g. `team:a` overrides the default `team:b`.
+func (l DefaultLabels) MergeTags(tags []string) []string {
+	merged := slices.Clone(tags)
+	for _, tag := range l.Tags() {
+		key, _, _ := strings.Cut(tag, ":")
+		if !slices.ContainsFunc(tags, func(t string) bool { return strings.HasPrefix(t, key+":") }) {
+			merged = append(merged, tag)
+		}
+	}
+	return merged
+}
+
+// StripTags returns the tags read from the API without the default tags which aren't in the configuration of the resource, see Strip.
+func (l DefaultLabels) StripTags(tags, configured []string) []string {
+	if len(l) == 0 || tags == nil {
+		return tags
+	}
+	stripped := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		key, value, ok := strings.Cut(tag, ":")
+		if ok && l.IsDefault(key, value) && !slices.Contains(configured, tag) {
+			continue
+		}
+		stripped = append(stripped, tag)
+	}
+	return stripped
+}
+
+// AllTags is All for tags, the tags of the `tags_all` attribute are sorted.
+func (l DefaultLabels) AllTags(tags, stripped []string) []string {
+	all := slices.Clone(stripped)
+	for _, tag := range tags {
+		key, _, ok := strings.Cut(tag, ":")
+		if _, isDefault := l[key]; ok && isDefault && !slices.Contains(all, tag) {
+			all = append(all, tag)
+		}
+	}
+	slices.Sort(all)
+	return slices.Compact(all)
+}
+
+// LabelsAllSchema is the `labels_all` attribute of the SDKv2 resources whose labels are the given map attribute, see DiffLabelsAll.
+func LabelsAllSchema(labels string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Description: "All the labels of the resource: the `" + labels + "` and the `default_labels` of the provider. " +
+			"Changing the `default_labels` of the provider updates the resource.",
+	}
+}
+
+// DiffLabelsAll plans the `labels_all` attribute of an SDKv2 resource from its labels and the default labels of the context,
+// see DefaultLabels. labels is the name of the map attribute of the configured labels.
+func DiffLabelsAll(labels string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if !d.NewValueKnown(labels) {
+			return d.SetNewComputed("labels_all")
+		}
+		planned := DefaultLabelsFromContext(ctx).Merge(UnpackMap[string](d.Get(labels)))
+		if maps.Equal(planned, UnpackMap[string](d.Get("labels_all"))) {
+			return nil
+		}
+		return d.SetNew("labels_all", planned)
+	}
+}
+
+// IsDefault returns whether the label has the key and the value of a default label.
+func (l DefaultLabels) IsDefault(key, value string) bool {
+	defaultValue, ok := l[key]
+	return ok && defaultValue == value
+}
+
+type defaultLabelsContextKey struct{}
+
+// ContextWithDefaultLabels returns a context carrying the default labels. The provider adds them to the context of the functions
+// of all resources, it's the only source of the default labels for the resources.
+func ContextWithDefaultLabels(ctx context.Context, labels DefaultLabels) context.Context {
+	return context.WithValue(ctx, defaultLabelsContextKey{}, labels)
+}
+
+// DefaultLabelsFromContext returns the default labels set by ContextWithDefaultLabels, or nil.
+func DefaultLabelsFromContext(ctx context.Context) DefaultLabels {
+	labels, _ := ctx.Value(defaultLabelsContextKey{}).(DefaultLabels)
+	return labels
+}
@@ -0,0 +1,77 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDefaultLabels(t *testing.T) {
+	t.Parallel()
+
+	defaultLabels := DefaultLabels{"managed-by": "terraform", "team": "default-team", "env": "prod"}
+
+	t.Run("merge", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t,
+			map[string]string{"managed-by": "terraform", "team": "my-team", "env": "prod", "app": "my-app"},
+			defaultLabels.Merge(map[string]string{"team": "my-team", "app": "my-app"}),
+		)
+		assert.Equal(t, map[string]string{"app": "my-app"}, DefaultLabels(nil).Merge(map[string]string{"app": "my-app"}))
+	})
+
+	t.Run("strip", func(t *testing.T) {
+		t.Parallel()
+		// The configured default labels and the ones changed outside of Terraform are kept
+		assert.Equal(t,
+			map[string]string{"env": "prod", "team": "changed", "app": "my-app"},
+			defaultLabels.Strip(
+				map[string]string{"managed-by": "terraform", "team": "changed", "env": "prod", "app": "my-app"},
+				map[string]string{"env": "prod", "app": "my-app"},
+			),
+		)
+		assert.Nil(t, defaultLabels.Strip(nil, nil))
+	})
+
+	t.Run("all", func(t *testing.T) {
+		t.Parallel()
+		// The default labels set on the resource are added to the labels of its state, with their remote values
+		assert.Equal(t,
+			map[string]string{"managed-by": "terraform", "team": "changed", "app": "my-app"},
+			defaultLabels.All(
+				map[string]string{"managed-by": "terraform", "team": "changed", "app": "my-app", "other": "value"},
+				map[string]string{"team": "changed", "app": "my-app"},
+			),
+		)
+		// A default label which isn't set on the resource yet doesn't match the planned labels
+		assert.NotEqual(t,
+			defaultLabels.Merge(map[string]string{"app": "my-app"}),
+			defaultLabels.All(map[string]string{"managed-by": "terraform", "team": "default-team", "app": "my-app"}, map[string]string{"app": "my-app"}),
+		)
+		assert.Equal(t, map[string]string{}, DefaultLabels(nil).All(nil, nil))
+	})
+
+	t.Run("tags", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, []string{"env:prod", "managed-by:terraform", "team:default-team"}, defaultLabels.Tags())
+		assert.Equal(t,
+			[]string{"team:my-team", "prod", "env:prod", "managed-by:terraform"},
+			defaultLabels.MergeTags([]string{"team:my-team", "prod"}),
+		)
+		assert.Equal(t,
+			[]string{"team:my-team", "prod", "env:prod"},
+			defaultLabels.StripTags([]string{"team:my-team", "prod", "env:prod", "managed-by:terraform"}, []string{"env:prod"}),
+		)
+		assert.Equal(t,
+			[]string{"env:prod", "managed-by:terraform", "prod", "team:my-team"},
+			defaultLabels.AllTags([]string{"team:my-team", "prod", "env:prod", "managed-by:terraform", "other:value"}, []string{"team:my-team", "prod", "env:prod"}),
+		)
+	})
+
+	t.Run("context", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, DefaultLabelsFromContext(context.Background()))
+		assert.Equal(t, defaultLabels, DefaultLabelsFromContext(ContextWithDefaultLabels(context.Background(), defaultLabels)))
+	})
+}
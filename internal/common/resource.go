@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -67,16 +69,40 @@ func NewDataSource(category ResourceCategory, name string, schema datasource.Dat
 	return d
 }
 
+// EphemeralResource represents a Terraform ephemeral resource, implemented with the Terraform Plugin Framework.
+// Ephemeral resources are never persisted in the plan or state, which makes them a good fit for short-lived credentials.
+type EphemeralResource struct {
+	ResourceCommon
+	PluginFrameworkSchema ephemeral.EphemeralResourceWithConfigure
+}
+
+func NewEphemeralResource(category ResourceCategory, name string, schema ephemeral.EphemeralResourceWithConfigure) *EphemeralResource {
+	e := &EphemeralResource{
+		ResourceCommon: ResourceCommon{
+			Name:     name,
+			Category: category,
+		},
+		PluginFrameworkSchema: schema,
+	}
+	return e
+}
+
 // ResourceListIDsFunc is a function that returns a list of resource IDs.
 // This is used to generate TF config from existing resources.
 // The data arg can be used to pass information between different listers. For example, the list of stacks will be used when listing stack plugins.
 type ResourceListIDsFunc func(ctx context.Context, client *Client, data any) ([]string, error)
 
+// ResourceClientValidatorFunc validates the config of an SDKv2 resource with the provider client, e.g. with rules set in the provider block.
+// Terraform validates the config again once the provider is configured, right before the plan: unlike CustomizeDiff, the validation
+// can return warnings. The client is nil when the provider isn't configured yet, e.g. during `terraform validate`.
+type ResourceClientValidatorFunc func(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, client *Client) diag.Diagnostics
+
 // Resource represents a Terraform resource, implemented either with the SDKv2 or Terraform Plugin Framework.
 type Resource struct {
 	ResourceCommon
 	IDType                *ResourceID
 	PluginFrameworkSchema resource.ResourceWithConfigure
+	ClientValidator       ResourceClientValidatorFunc // Only for SDKv2 resources
 
 	// Generation configuration
 	ListIDsFunc                ResourceListIDsFunc
@@ -112,6 +138,11 @@ func (r *Resource) WithLister(lister ResourceListIDsFunc) *Resource {
 	return r
 }
 
+func (r *Resource) WithClientValidator(validator ResourceClientValidatorFunc) *Resource {
+	r.ClientValidator = validator
+	return r
+}
+
 func (r *Resource) WithPreferredResourceNameField(fieldName string) *Resource {
 	r.PreferredResourceNameField = fieldName
 	return r
@@ -132,6 +163,58 @@ func (r *Resource) ImportExample() string {
 	if len(id.expectedFields) != len(id.RequiredFields()) {
 		example += exampleFromFields(id.expectedFields)
 	}
+	if id.HasIdentity() {
+		example += r.identityImportExample()
+	}
 
 	return example
 }
+
+// identityImportExample is an import block with the identity of the resource, commented out to keep the example a shell script.
+// The values are quoted, Terraform converts them to numbers for the int fields.
+func (r *Resource) identityImportExample() string {
+	width := 0
+	for _, f := range r.IDType.expectedFields {
+		width = max(width, len(f.IdentityAttribute()))
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n# With Terraform 1.12+, the resource can also be imported from its identity:\n# import {\n#   to = %s.name\n#   identity = {\n", r.Name)
+	for _, f := range r.IDType.expectedFields {
+		line := fmt.Sprintf("#     %-*s = \"{{ %s }}\"", width, f.IdentityAttribute(), f.Name)
+		if f.Optional {
+			line += " # Optional"
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("#   }\n# }\n")
+	return b.String()
+}
+
+type resourceTypeContextKey struct{}
+
+// ContextWithResourceType returns a context carrying the type of the resource being modified, e.g. `grafana_folder`.
+// The clients which send their requests with this context record the resource type in the audit log.
+func ContextWithResourceType(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeContextKey{}, resourceType)
+}
+
+// ResourceTypeFromContext returns the resource type set by ContextWithResourceType, or an empty string.
+func ResourceTypeFromContext(ctx context.Context) string {
+	resourceType, _ := ctx.Value(resourceTypeContextKey{}).(string)
+	return resourceType
+}
+
+type resourceIDContextKey struct{}
+
+// ContextWithResourceID returns a context carrying the ID of the resource being modified, e.g. `1:my-folder`. Terraform doesn't
+// send the resource addresses to the providers: the type and ID tell the resources apart in the audit log, once they're created.
+func ContextWithResourceID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, resourceIDContextKey{}, id)
+}
+
+// ResourceIDFromContext returns the resource ID set by ContextWithResourceID, or an empty string.
+func ResourceIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(resourceIDContextKey{}).(string)
+	return id
+}
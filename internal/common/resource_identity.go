@@ -0,0 +1,137 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IdentityAttribute returns the name of the identity attribute of the field: the field name in snake case, without
+// the description in parentheses. For example, `orgID` → `org_id` and `type (role, team, or user)` → `type`.
+func (f ResourceIDField) IdentityAttribute() string {
+	name, _, _ := strings.Cut(f.Name, " (")
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (nextLower && unicode.IsUpper(runes[i-1])) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// HasIdentity returns whether resources with this ID have an identity, i.e. whether the ID has fields.
+func (id *ResourceID) HasIdentity() bool {
+	return id != nil && len(id.expectedFields) > 0
+}
+
+// SDKIdentity returns the identity of the SDKv2 resources with this ID: one attribute per field, required for import
+// unless the field is optional.
+func (id *ResourceID) SDKIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			attributes := map[string]*schema.Schema{}
+			for _, f := range id.expectedFields {
+				attribute := &schema.Schema{
+					Type:              schema.TypeString,
+					Description:       fmt.Sprintf("The `%s` part of the resource ID.", f.Name),
+					RequiredForImport: !f.Optional,
+					OptionalForImport: f.Optional,
+				}
+				if f.Type == ResourceIDFieldTypeInt {
+					attribute.Type = schema.TypeInt
+				}
+				attributes[f.IdentityAttribute()] = attribute
+			}
+			return attributes
+		},
+	}
+}
+
+// IdentitySchema returns the identity schema of the Plugin Framework resources with this ID, see SDKIdentity.
+func (id *ResourceID) IdentitySchema() identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{}
+	for _, f := range id.expectedFields {
+		description := fmt.Sprintf("The `%s` part of the resource ID.", f.Name)
+		if f.Type == ResourceIDFieldTypeInt {
+			attributes[f.IdentityAttribute()] = identityschema.Int64Attribute{
+				Description:       description,
+				RequiredForImport: !f.Optional,
+				OptionalForImport: f.Optional,
+			}
+		} else {
+			attributes[f.IdentityAttribute()] = identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: !f.Optional,
+				OptionalForImport: f.Optional,
+			}
+		}
+	}
+	return identityschema.Schema{Attributes: attributes}
+}
+
+// IdentityValues splits a resource ID into the values of its identity attributes, int64 or string depending on the field type.
+// The optional fields missing from the ID are omitted.
+func (id *ResourceID) IdentityValues(resourceID string) (map[string]any, error) {
+	parts, err := id.Split(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	fields := id.expectedFields
+	if len(parts) != len(fields) {
+		fields = id.RequiredFields()
+	}
+
+	values := map[string]any{}
+	for i, f := range fields {
+		values[f.IdentityAttribute()] = parts[i]
+	}
+	return values, nil
+}
+
+// FromIdentityValues joins the values of the identity attributes into a resource ID, the reverse of IdentityValues.
+// Int values can be given as int or int64. The optional fields are only used if they are all given.
+func (id *ResourceID) FromIdentityValues(values map[string]any) (string, error) {
+	fields := id.expectedFields
+	for _, f := range id.expectedFields {
+		if f.Optional && values[f.IdentityAttribute()] == nil {
+			fields = id.RequiredFields()
+			break
+		}
+	}
+
+	parts := make([]string, len(fields))
+	for i, f := range fields {
+		switch value := values[f.IdentityAttribute()].(type) {
+		case nil:
+			return "", fmt.Errorf("missing identity attribute %q", f.IdentityAttribute())
+		case string:
+			if f.Type != ResourceIDFieldTypeString {
+				return "", fmt.Errorf("expected a number for identity attribute %q, got %q", f.IdentityAttribute(), value)
+			}
+			parts[i] = value
+		case int:
+			if f.Type != ResourceIDFieldTypeInt {
+				return "", fmt.Errorf("expected a string for identity attribute %q, got %d", f.IdentityAttribute(), value)
+			}
+			parts[i] = strconv.Itoa(value)
+		case int64:
+			if f.Type != ResourceIDFieldTypeInt {
+				return "", fmt.Errorf("expected a string for identity attribute %q, got %d", f.IdentityAttribute(), value)
+			}
+			parts[i] = strconv.FormatInt(value, 10)
+		default:
+			return "", fmt.Errorf("unexpected type %T for identity attribute %q", value, f.IdentityAttribute())
+		}
+	}
+	return strings.Join(parts, ResourceIDSeparator), nil
+}
@@ -0,0 +1,73 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceIDFieldIdentityAttribute(t *testing.T) {
+	t.Parallel()
+
+	for name, expected := range map[string]string{
+		"uid":                        "uid",
+		"stack_id":                   "stack_id",
+		"orgID":                      "org_id",
+		"dashboardUID":               "dashboard_uid",
+		"usernameOrID":               "username_or_id",
+		"policyId":                   "policy_id",
+		"type (role, team, or user)": "type",
+	} {
+		assert.Equal(t, expected, StringIDField(name).IdentityAttribute(), name)
+	}
+}
+
+func TestResourceIDIdentityValues(t *testing.T) {
+	t.Parallel()
+
+	id := NewResourceID(OptionalIntIDField("orgID"), StringIDField("uid"))
+
+	values, err := id.IdentityValues("1:abc")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"org_id": int64(1), "uid": "abc"}, values)
+	got, err := id.FromIdentityValues(values)
+	require.NoError(t, err)
+	assert.Equal(t, "1:abc", got)
+
+	// The optional fields are omitted when they are not in the ID
+	values, err = id.IdentityValues("abc")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"uid": "abc"}, values)
+	got, err = id.FromIdentityValues(values)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", got)
+
+	// SDKv2 identities have int values
+	got, err = id.FromIdentityValues(map[string]any{"org_id": 2, "uid": "abc"})
+	require.NoError(t, err)
+	assert.Equal(t, "2:abc", got)
+
+	_, err = id.FromIdentityValues(map[string]any{"org_id": int64(1)})
+	require.EqualError(t, err, `missing identity attribute "uid"`)
+	_, err = id.FromIdentityValues(map[string]any{"org_id": "1", "uid": "abc"})
+	require.EqualError(t, err, `expected a number for identity attribute "org_id", got "1"`)
+}
+
+func TestResourceImportExample(t *testing.T) {
+	t.Parallel()
+
+	r := NewLegacySDKResource(CategoryGrafanaOSS, "grafana_folder", NewResourceID(OptionalIntIDField("orgID"), StringIDField("uid")), nil)
+	assert.Equal(t, `terraform import grafana_folder.name "{{ uid }}"
+terraform import grafana_folder.name "{{ orgID }}:{{ uid }}"
+
+# With Terraform 1.12+, the resource can also be imported from its identity:
+# import {
+#   to = grafana_folder.name
+#   identity = {
+#     org_id = "{{ orgID }}" # Optional
+#     uid    = "{{ uid }}"
+#   }
+# }
+`, r.ImportExample())
+}
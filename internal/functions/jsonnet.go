@@ -0,0 +1,94 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/google/go-jsonnet"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &JsonnetFunction{}
+
+type JsonnetFunction struct{}
+
+func NewJsonnetFunction() function.Function {
+	return &JsonnetFunction{}
+}
+
+func (f *JsonnetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jsonnet"
+}
+
+func (f *JsonnetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluate a Jsonnet file",
+		Description: "Evaluates a Jsonnet file, e.g. a Grafonnet dashboard, and returns the resulting JSON as a string, " +
+			"which can be used as the `config_json` of a dashboard. Imports are resolved relative to the importing file, then in the `jpath` directories.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "file_path",
+				Description: "Path to the Jsonnet file to evaluate",
+			},
+			function.MapParameter{
+				Name:           "ext_vars",
+				Description:    "External variables, available with `std.extVar(name)`",
+				ElementType:    types.StringType,
+				AllowNullValue: true,
+			},
+			function.MapParameter{
+				Name:           "tla_vars",
+				Description:    "Top-level arguments, passed to the function returned by the file",
+				ElementType:    types.StringType,
+				AllowNullValue: true,
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "jpath",
+			Description: "Library search directories, e.g. the `vendor` directory of jsonnet-bundler. Like `jsonnet -J`, the right-most directory wins",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *JsonnetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		filePath         string
+		extVars, tlaVars types.Map
+		jpath            []string
+	)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &filePath, &extVars, &tlaVars, &jpath))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		resp.Error = function.ConcatFuncErrors(resp.Error,
+			function.NewFuncError(fmt.Sprintf("File does not exist: %s", filePath)))
+		return
+	}
+
+	vm := jsonnet.MakeVM()
+	vm.Importer(&jsonnet.FileImporter{JPaths: jpath})
+
+	for name, value := range extVars.Elements() {
+		vm.ExtVar(name, value.(types.String).ValueString())
+	}
+	for name, value := range tlaVars.Elements() {
+		vm.TLAVar(name, value.(types.String).ValueString())
+	}
+
+	// The error messages include the file and the line of the error, and the stack trace for runtime errors.
+	result, err := vm.EvaluateFile(filePath)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error,
+			function.NewArgumentFuncError(0, fmt.Sprintf("Jsonnet evaluation failed: %s", err)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,122 @@
+package functions_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/functions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runJsonnetFunction(filePath string, extVars, tlaVars map[string]string, jpath ...string) *function.RunResponse {
+	stringMap := func(values map[string]string) types.Map {
+		if values == nil {
+			return types.MapNull(types.StringType)
+		}
+		elements := map[string]attr.Value{}
+		for k, v := range values {
+			elements[k] = types.StringValue(v)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+	jpathValues := []attr.Value{}
+	jpathTypes := []attr.Type{}
+	for _, dir := range jpath {
+		jpathValues = append(jpathValues, types.StringValue(dir))
+		jpathTypes = append(jpathTypes, types.StringType)
+	}
+
+	f := functions.NewJsonnetFunction()
+	args := function.NewArgumentsData([]attr.Value{
+		types.StringValue(filePath),
+		stringMap(extVars),
+		stringMap(tlaVars),
+		types.TupleValueMust(jpathTypes, jpathValues),
+	})
+	req := function.RunRequest{Arguments: args}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	f.Run(context.Background(), req, resp)
+	return resp
+}
+
+func TestJsonnetFunction_Basic(t *testing.T) {
+	tempDir := t.TempDir()
+
+	libDir := filepath.Join(tempDir, "vendor")
+	if err := os.Mkdir(libDir, 0700); err != nil {
+		t.Fatalf("Failed to create lib dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(libDir, "panels.libsonnet"), []byte(`{ stat(title):: { type: 'stat', title: title } }`), 0600); err != nil {
+		t.Fatalf("Failed to create lib file: %v", err)
+	}
+
+	testFile := filepath.Join(tempDir, "dashboard.jsonnet")
+	testContent := `
+local panels = import 'panels.libsonnet';
+
+function(uid) {
+  uid: uid,
+  title: std.extVar('env') + ' overview',
+  panels: [panels.stat('Requests')],
+}
+`
+	if err := os.WriteFile(testFile, []byte(testContent), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	resp := runJsonnetFunction(testFile, map[string]string{"env": "prod"}, map[string]string{"uid": "prod-overview"}, libDir)
+	if resp.Error != nil {
+		t.Fatalf("Unexpected error: %v", resp.Error)
+	}
+
+	var dashboard struct {
+		UID    string           `json:"uid"`
+		Title  string           `json:"title"`
+		Panels []map[string]any `json:"panels"`
+	}
+	if err := json.Unmarshal([]byte(resp.Result.Value().(types.String).ValueString()), &dashboard); err != nil {
+		t.Fatalf("Result is not valid JSON: %v", err)
+	}
+	if dashboard.UID != "prod-overview" || dashboard.Title != "prod overview" {
+		t.Errorf("Unexpected dashboard: %+v", dashboard)
+	}
+	if len(dashboard.Panels) != 1 || dashboard.Panels[0]["title"] != "Requests" {
+		t.Errorf("Unexpected panels: %+v", dashboard.Panels)
+	}
+}
+
+func TestJsonnetFunction_EvaluationError(t *testing.T) {
+	testFile := filepath.Join(t.TempDir(), "dashboard.jsonnet")
+	testContent := `{
+  title: std.extVar('missing'),
+}
+`
+	if err := os.WriteFile(testFile, []byte(testContent), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	resp := runJsonnetFunction(testFile, nil, nil)
+	if resp.Error == nil {
+		t.Fatal("Expected an evaluation error")
+	}
+	if !strings.Contains(resp.Error.Error(), "dashboard.jsonnet:2:") {
+		t.Errorf("Expected the error to include the file and the line, got: %s", resp.Error.Error())
+	}
+}
+
+func TestJsonnetFunction_FileNotFound(t *testing.T) {
+	resp := runJsonnetFunction("/nonexistent.jsonnet", nil, nil)
+	if resp.Error == nil {
+		t.Fatal("Expected error for nonexistent file")
+	}
+	if !strings.Contains(resp.Error.Error(), "File does not exist") {
+		t.Errorf("Expected 'File does not exist' error, got: %s", resp.Error.Error())
+	}
+}
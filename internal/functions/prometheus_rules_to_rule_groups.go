@@ -0,0 +1,392 @@
+package functions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prometheus/common/model"
+	"go.yaml.in/yaml/v3"
+)
+
+var _ function.Function = &PrometheusRulesToRuleGroupsFunction{}
+
+// Prometheus evaluates the rule groups every minute by default (global.evaluation_interval)
+const defaultPrometheusRuleGroupInterval = time.Minute
+
+var (
+	ruleGroupRelativeTimeRangeType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"from": types.Int64Type,
+		"to":   types.Int64Type,
+	}}
+	ruleGroupDataType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"ref_id":              types.StringType,
+		"datasource_uid":      types.StringType,
+		"query_type":          types.StringType,
+		"model":               types.StringType,
+		"relative_time_range": ruleGroupRelativeTimeRangeType,
+	}}
+	ruleGroupRecordType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"metric":                types.StringType,
+		"from":                  types.StringType,
+		"target_datasource_uid": types.StringType,
+	}}
+	ruleGroupRuleType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":            types.StringType,
+		"condition":       types.StringType,
+		"for":             types.StringType,
+		"keep_firing_for": types.StringType,
+		"no_data_state":   types.StringType,
+		"exec_err_state":  types.StringType,
+		"labels":          types.MapType{ElemType: types.StringType},
+		"annotations":     types.MapType{ElemType: types.StringType},
+		"data":            types.ListType{ElemType: ruleGroupDataType},
+		"record":          types.ListType{ElemType: ruleGroupRecordType},
+	}}
+	ruleGroupType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":             types.StringType,
+		"interval_seconds": types.Int64Type,
+		"rules":            types.ListType{ElemType: ruleGroupRuleType},
+	}}
+)
+
+// Prometheus rule files, with the Mimir and Thanos extensions so that they are reported instead of rejected as unknown fields.
+type prometheusRuleFile struct {
+	Groups []prometheusRuleGroup `yaml:"groups"`
+}
+
+type prometheusRuleGroup struct {
+	Name        string            `yaml:"name"`
+	Interval    string            `yaml:"interval"`
+	QueryOffset string            `yaml:"query_offset"`
+	Limit       int               `yaml:"limit"`
+	Labels      map[string]string `yaml:"labels"`
+	Rules       []prometheusRule  `yaml:"rules"`
+
+	EvaluationDelay               string   `yaml:"evaluation_delay"` // Mimir's deprecated name of query_offset
+	SourceTenants                 []string `yaml:"source_tenants"`
+	AlignEvaluationTimeOnInterval bool     `yaml:"align_evaluation_time_on_interval"`
+	PartialResponseStrategy       string   `yaml:"partial_response_strategy"`
+}
+
+type prometheusRule struct {
+	Record        string            `yaml:"record"`
+	Alert         string            `yaml:"alert"`
+	Expr          string            `yaml:"expr"`
+	For           string            `yaml:"for"`
+	KeepFiringFor string            `yaml:"keep_firing_for"`
+	Labels        map[string]string `yaml:"labels"`
+	Annotations   map[string]string `yaml:"annotations"`
+}
+
+type ruleGroupModel struct {
+	Name            string          `tfsdk:"name"`
+	IntervalSeconds int64           `tfsdk:"interval_seconds"`
+	Rules           []ruleGroupRule `tfsdk:"rules"`
+}
+
+type ruleGroupRule struct {
+	Name          string                `tfsdk:"name"`
+	Condition     string                `tfsdk:"condition"`
+	For           types.String          `tfsdk:"for"`
+	KeepFiringFor types.String          `tfsdk:"keep_firing_for"`
+	NoDataState   types.String          `tfsdk:"no_data_state"`
+	ExecErrState  types.String          `tfsdk:"exec_err_state"`
+	Labels        map[string]string     `tfsdk:"labels"`
+	Annotations   map[string]string     `tfsdk:"annotations"`
+	Data          []ruleGroupData       `tfsdk:"data"`
+	Record        []ruleGroupRuleRecord `tfsdk:"record"`
+}
+
+type ruleGroupData struct {
+	RefID             string                     `tfsdk:"ref_id"`
+	DatasourceUID     string                     `tfsdk:"datasource_uid"`
+	QueryType         string                     `tfsdk:"query_type"`
+	Model             string                     `tfsdk:"model"`
+	RelativeTimeRange ruleGroupRelativeTimeRange `tfsdk:"relative_time_range"`
+}
+
+type ruleGroupRelativeTimeRange struct {
+	From int64 `tfsdk:"from"`
+	To   int64 `tfsdk:"to"`
+}
+
+type ruleGroupRuleRecord struct {
+	Metric              string `tfsdk:"metric"`
+	From                string `tfsdk:"from"`
+	TargetDatasourceUID string `tfsdk:"target_datasource_uid"`
+}
+
+type PrometheusRulesToRuleGroupsFunction struct{}
+
+func NewPrometheusRulesToRuleGroupsFunction() function.Function {
+	return &PrometheusRulesToRuleGroupsFunction{}
+}
+
+func (f *PrometheusRulesToRuleGroupsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "prometheus_rules_to_rule_groups"
+}
+
+func (f *PrometheusRulesToRuleGroupsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert Prometheus rule files to Grafana-managed rule groups",
+		Description: "Converts the groups of a Prometheus or Mimir rule file to the rule groups of the `grafana_rule_group` resource, to be used in `dynamic` blocks. " +
+			"Each alerting rule becomes an instant query of the datasource, a math expression which is 1 for every series returned by the query, and a threshold on it, " +
+			"so that every series returned by the query fires like in Prometheus. Each recording rule becomes an instant query written back to the datasource. " +
+			"The constructs which can't be translated (e.g. `limit` or `source_tenants`) are reported as errors.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "yaml",
+				Description: "Content of the rule file",
+			},
+			function.StringParameter{
+				Name:        "datasource_uid",
+				Description: "UID of the Prometheus datasource which evaluates the rules, and where recording rules are written",
+			},
+		},
+		Return: function.ListReturn{ElementType: ruleGroupType},
+	}
+}
+
+func (f *PrometheusRulesToRuleGroupsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content, datasourceUID string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &content, &datasourceUID))
+	if resp.Error != nil {
+		return
+	}
+
+	groups, err := convertPrometheusRules([]byte(content), datasourceUID)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, diags := types.ListValueFrom(ctx, ruleGroupType, groups)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// convertPrometheusRules converts all the groups of a rule file, or returns all the constructs which can't be translated.
+func convertPrometheusRules(content []byte, datasourceUID string) ([]ruleGroupModel, error) {
+	var file prometheusRuleFile
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid rule file: %w", err)
+	}
+
+	var errs []error
+	groups := make([]ruleGroupModel, 0, len(file.Groups))
+	names := map[string]bool{}
+	for _, group := range file.Groups {
+		if names[group.Name] {
+			errs = append(errs, fmt.Errorf("group %q: the group names must be unique", group.Name))
+		}
+		names[group.Name] = true
+
+		converted, groupErrs := convertPrometheusRuleGroup(group, datasourceUID)
+		for _, err := range groupErrs {
+			errs = append(errs, fmt.Errorf("group %q: %w", group.Name, err))
+		}
+		groups = append(groups, converted)
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("the rule file can't be converted:\n%w", errors.Join(errs...))
+	}
+	return groups, nil
+}
+
+func convertPrometheusRuleGroup(group prometheusRuleGroup, datasourceUID string) (ruleGroupModel, []error) {
+	var errs []error
+	if group.Name == "" {
+		errs = append(errs, errors.New("the group has no name"))
+	}
+	if group.Limit > 0 {
+		errs = append(errs, errors.New("limit is not supported by Grafana-managed rules"))
+	}
+	if len(group.SourceTenants) > 0 {
+		errs = append(errs, errors.New("source_tenants (federated rule groups) is not supported by Grafana-managed rules"))
+	}
+	if group.AlignEvaluationTimeOnInterval {
+		errs = append(errs, errors.New("align_evaluation_time_on_interval is not supported by Grafana-managed rules"))
+	}
+	if group.PartialResponseStrategy != "" {
+		errs = append(errs, errors.New("partial_response_strategy is not supported by Grafana-managed rules"))
+	}
+
+	interval := defaultPrometheusRuleGroupInterval
+	if group.Interval != "" {
+		d, err := model.ParseDuration(group.Interval)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid interval: %w", err))
+		}
+		interval = time.Duration(d)
+	}
+	if interval%time.Second != 0 {
+		errs = append(errs, fmt.Errorf("the interval %s must be a whole number of seconds", interval))
+	}
+
+	queryOffset := group.QueryOffset
+	if queryOffset == "" {
+		queryOffset = group.EvaluationDelay
+	}
+	var offset time.Duration
+	if queryOffset != "" {
+		d, err := model.ParseDuration(queryOffset)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid query_offset: %w", err))
+		}
+		offset = time.Duration(d)
+	}
+
+	converted := ruleGroupModel{
+		Name:            group.Name,
+		IntervalSeconds: int64(interval / time.Second),
+		Rules:           make([]ruleGroupRule, 0, len(group.Rules)),
+	}
+	for i, rule := range group.Rules {
+		convertedRule, ruleErrs := convertPrometheusRule(rule, group.Labels, offset, datasourceUID)
+		for _, err := range ruleErrs {
+			errs = append(errs, fmt.Errorf("rule %d (%s): %w", i, convertedRule.Name, err))
+		}
+		converted.Rules = append(converted.Rules, convertedRule)
+	}
+
+	return converted, errs
+}
+
+func convertPrometheusRule(rule prometheusRule, groupLabels map[string]string, offset time.Duration, datasourceUID string) (ruleGroupRule, []error) {
+	var errs []error
+	recording := rule.Record != ""
+	switch {
+	case recording && rule.Alert != "":
+		errs = append(errs, errors.New("a rule can't have both alert and record"))
+	case !recording && rule.Alert == "":
+		errs = append(errs, errors.New("a rule must have either alert or record"))
+	}
+	if rule.Expr == "" {
+		errs = append(errs, errors.New("the rule has no expr"))
+	}
+	if recording && (rule.For != "" || rule.KeepFiringFor != "" || len(rule.Annotations) > 0) {
+		errs = append(errs, errors.New("recording rules can't have for, keep_firing_for or annotations"))
+	}
+
+	// The labels of the rule override the labels of the group, like in Prometheus.
+	labels := map[string]string{}
+	maps.Copy(labels, groupLabels)
+	maps.Copy(labels, rule.Labels)
+	annotations := map[string]string{}
+	maps.Copy(annotations, rule.Annotations)
+
+	converted := ruleGroupRule{
+		Name:          rule.Alert,
+		Condition:     "A",
+		For:           types.StringNull(),
+		KeepFiringFor: types.StringNull(),
+		NoDataState:   types.StringNull(),
+		ExecErrState:  types.StringNull(),
+		Labels:        labels,
+		Annotations:   annotations,
+		Data: []ruleGroupData{{
+			RefID:         "A",
+			DatasourceUID: datasourceUID,
+			Model: ruleGroupModelJSON(map[string]any{
+				"refId":         "A",
+				"datasource":    map[string]any{"type": "prometheus", "uid": datasourceUID},
+				"expr":          rule.Expr,
+				"instant":       true,
+				"range":         false,
+				"intervalMs":    1000,
+				"maxDataPoints": 43200,
+			}),
+			// Only the end of the range matters for instant queries, it is shifted by the query offset of the group.
+			RelativeTimeRange: ruleGroupRelativeTimeRange{From: int64((offset + 10*time.Minute) / time.Second), To: int64(offset / time.Second)},
+		}},
+		Record: []ruleGroupRuleRecord{},
+	}
+
+	if recording {
+		converted.Name = rule.Record
+		converted.Record = []ruleGroupRuleRecord{{
+			Metric:              rule.Record,
+			From:                "A",
+			TargetDatasourceUID: datasourceUID,
+		}}
+		return converted, errs
+	}
+
+	for _, duration := range []struct {
+		name  string
+		value string
+		dst   *types.String
+	}{
+		{"for", rule.For, &converted.For},
+		{"keep_firing_for", rule.KeepFiringFor, &converted.KeepFiringFor},
+	} {
+		if duration.value == "" {
+			continue
+		}
+		d, err := model.ParseDuration(duration.value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s: %w", duration.name, err))
+			continue
+		}
+		if d > 0 {
+			*duration.dst = types.StringValue(time.Duration(d).String())
+		}
+	}
+
+	// A Prometheus alert fires for every series returned by its expression, whatever their values:
+	// the math expression is 1 for all of them, including NaN and infinite values, and the threshold fires on it.
+	// Like in Prometheus, no data doesn't fire and errors are visible in the state of the rule.
+	converted.Condition = "C"
+	converted.NoDataState = types.StringValue("OK")
+	converted.ExecErrState = types.StringValue("Error")
+	converted.Data = append(converted.Data,
+		ruleGroupData{
+			RefID:         "B",
+			DatasourceUID: "__expr__",
+			Model: ruleGroupModelJSON(map[string]any{
+				"refId":      "B",
+				"datasource": map[string]any{"type": "__expr__", "uid": "__expr__"},
+				"type":       "math",
+				"expression": "is_number($A) || is_nan($A) || is_inf($A)",
+			}),
+		},
+		ruleGroupData{
+			RefID:         "C",
+			DatasourceUID: "__expr__",
+			Model: ruleGroupModelJSON(map[string]any{
+				"refId":      "C",
+				"datasource": map[string]any{"type": "__expr__", "uid": "__expr__"},
+				"type":       "threshold",
+				"expression": "B",
+				"conditions": []any{
+					map[string]any{"evaluator": map[string]any{"type": "gt", "params": []any{0}}},
+				},
+			}),
+		},
+	)
+
+	return converted, errs
+}
+
+func ruleGroupModelJSON(model map[string]any) string {
+	j, _ := json.Marshal(model)
+	return string(j)
+}
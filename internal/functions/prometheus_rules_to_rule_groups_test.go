@@ -0,0 +1,214 @@
+package functions_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/functions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type convertedRuleGroup struct {
+	Name            string `tfsdk:"name"`
+	IntervalSeconds int64  `tfsdk:"interval_seconds"`
+	Rules           []struct {
+		Name          string            `tfsdk:"name"`
+		Condition     string            `tfsdk:"condition"`
+		For           types.String      `tfsdk:"for"`
+		KeepFiringFor types.String      `tfsdk:"keep_firing_for"`
+		NoDataState   types.String      `tfsdk:"no_data_state"`
+		ExecErrState  types.String      `tfsdk:"exec_err_state"`
+		Labels        map[string]string `tfsdk:"labels"`
+		Annotations   map[string]string `tfsdk:"annotations"`
+		Data          []struct {
+			RefID             string `tfsdk:"ref_id"`
+			DatasourceUID     string `tfsdk:"datasource_uid"`
+			QueryType         string `tfsdk:"query_type"`
+			Model             string `tfsdk:"model"`
+			RelativeTimeRange struct {
+				From int64 `tfsdk:"from"`
+				To   int64 `tfsdk:"to"`
+			} `tfsdk:"relative_time_range"`
+		} `tfsdk:"data"`
+		Record []struct {
+			Metric              string `tfsdk:"metric"`
+			From                string `tfsdk:"from"`
+			TargetDatasourceUID string `tfsdk:"target_datasource_uid"`
+		} `tfsdk:"record"`
+	} `tfsdk:"rules"`
+}
+
+func runPrometheusRulesToRuleGroupsFunction(t *testing.T, content string) ([]convertedRuleGroup, *function.FuncError) {
+	t.Helper()
+
+	f := functions.NewPrometheusRulesToRuleGroupsFunction()
+	args := function.NewArgumentsData([]attr.Value{
+		types.StringValue(content),
+		types.StringValue("prometheus-uid"),
+	})
+
+	definitionResp := &function.DefinitionResponse{}
+	f.Definition(context.Background(), function.DefinitionRequest{}, definitionResp)
+	req := function.RunRequest{Arguments: args}
+	resp := &function.RunResponse{Result: function.NewResultData(definitionResp.Definition.Return.GetType().ValueType(context.Background()))}
+
+	f.Run(context.Background(), req, resp)
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+
+	var groups []convertedRuleGroup
+	if diags := resp.Result.Value().(types.List).ElementsAs(context.Background(), &groups, false); diags.HasError() {
+		t.Fatalf("Failed to read the result: %v", diags)
+	}
+	return groups, nil
+}
+
+func TestPrometheusRulesToRuleGroupsFunction_Basic(t *testing.T) {
+	groups, err := runPrometheusRulesToRuleGroupsFunction(t, `
+groups:
+  - name: api
+    interval: 30s
+    query_offset: 1m
+    labels:
+      team: api
+    rules:
+      - record: job:http_requests:rate5m
+        expr: sum by (job) (rate(http_requests_total[5m]))
+      - alert: HighErrorRate
+        expr: job:http_errors:rate5m > 0.05
+        for: 10m
+        keep_firing_for: 1h30m
+        labels:
+          severity: page
+          team: sre
+        annotations:
+          summary: High error rate on {{ $labels.job }}
+  - name: default-interval
+    rules:
+      - alert: Down
+        expr: up == 0
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, got %d", len(groups))
+	}
+	if groups[0].Name != "api" || groups[0].IntervalSeconds != 30 {
+		t.Errorf("Unexpected group: %s, %d", groups[0].Name, groups[0].IntervalSeconds)
+	}
+	if groups[1].IntervalSeconds != 60 {
+		t.Errorf("Expected the default interval of Prometheus, got %d", groups[1].IntervalSeconds)
+	}
+
+	record := groups[0].Rules[0]
+	if record.Name != "job:http_requests:rate5m" || record.Condition != "A" || len(record.Data) != 1 {
+		t.Errorf("Unexpected recording rule: %+v", record)
+	}
+	if len(record.Record) != 1 || record.Record[0].Metric != "job:http_requests:rate5m" || record.Record[0].From != "A" || record.Record[0].TargetDatasourceUID != "prometheus-uid" {
+		t.Errorf("Unexpected record: %+v", record.Record)
+	}
+	if !record.NoDataState.IsNull() || !record.For.IsNull() {
+		t.Errorf("Expected no alerting settings on the recording rule: %+v", record)
+	}
+	if record.Labels["team"] != "api" {
+		t.Errorf("Expected the group labels on the recording rule, got %v", record.Labels)
+	}
+
+	alert := groups[0].Rules[1]
+	if alert.Name != "HighErrorRate" || alert.Condition != "C" || len(alert.Record) != 0 {
+		t.Errorf("Unexpected alert rule: %+v", alert)
+	}
+	if alert.For.ValueString() != "10m0s" || alert.KeepFiringFor.ValueString() != "1h30m0s" {
+		t.Errorf("Unexpected durations: %s, %s", alert.For, alert.KeepFiringFor)
+	}
+	if alert.NoDataState.ValueString() != "OK" || alert.ExecErrState.ValueString() != "Error" {
+		t.Errorf("Unexpected states: %s, %s", alert.NoDataState, alert.ExecErrState)
+	}
+	if alert.Labels["team"] != "sre" || alert.Labels["severity"] != "page" {
+		t.Errorf("Expected the rule labels to override the group labels, got %v", alert.Labels)
+	}
+	if alert.Annotations["summary"] != "High error rate on {{ $labels.job }}" {
+		t.Errorf("Unexpected annotations: %v", alert.Annotations)
+	}
+
+	if len(alert.Data) != 3 {
+		t.Fatalf("Expected a query, a math expression and a threshold, got %d data", len(alert.Data))
+	}
+	query := alert.Data[0]
+	if query.RefID != "A" || query.DatasourceUID != "prometheus-uid" || query.RelativeTimeRange.From != 660 || query.RelativeTimeRange.To != 60 {
+		t.Errorf("Unexpected query: %+v", query)
+	}
+	var queryModel map[string]any
+	if err := json.Unmarshal([]byte(query.Model), &queryModel); err != nil {
+		t.Fatalf("Invalid query model: %v", err)
+	}
+	if queryModel["expr"] != "job:http_errors:rate5m > 0.05" || queryModel["instant"] != true || queryModel["refId"] != "A" {
+		t.Errorf("Unexpected query model: %s", query.Model)
+	}
+	for i, refID := range []string{"B", "C"} {
+		expression := alert.Data[i+1]
+		if expression.RefID != refID || expression.DatasourceUID != "__expr__" {
+			t.Errorf("Unexpected expression: %+v", expression)
+		}
+	}
+	if !strings.Contains(alert.Data[2].Model, `"expression":"B"`) {
+		t.Errorf("Expected the threshold on the math expression, got %s", alert.Data[2].Model)
+	}
+}
+
+func TestPrometheusRulesToRuleGroupsFunction_UnsupportedConstructs(t *testing.T) {
+	_, err := runPrometheusRulesToRuleGroupsFunction(t, `
+groups:
+  - name: federated
+    limit: 10
+    source_tenants: [team-a, team-b]
+    rules:
+      - alert: Down
+        expr: up == 0
+        for: 5 minutes
+      - record: job:up:sum
+        expr: sum by (job) (up)
+        for: 5m
+      - expr: up
+  - name: federated
+    rules: []
+`)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	for _, expected := range []string{
+		`group "federated": limit is not supported`,
+		`group "federated": source_tenants`,
+		`group "federated": rule 0 (Down): invalid for`,
+		`group "federated": rule 1 (job:up:sum): recording rules can't have for`,
+		`group "federated": rule 2 (): a rule must have either alert or record`,
+		`group "federated": the group names must be unique`,
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected the error to contain %q, got: %s", expected, err.Error())
+		}
+	}
+}
+
+func TestPrometheusRulesToRuleGroupsFunction_UnknownField(t *testing.T) {
+	_, err := runPrometheusRulesToRuleGroupsFunction(t, `
+groups:
+  - name: api
+    rules:
+      - alert: Down
+        expr: up == 0
+        severity: page
+`)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if !strings.Contains(err.Error(), "field severity not found") {
+		t.Errorf("Expected the unknown field to be reported, got: %s", err.Error())
+	}
+}
@@ -124,6 +124,8 @@ func AlertRule() NamedResource {
 				MarkdownDescription: `
 Manages Grafana Alert Rules.
 
+A ` + "`grafana_rule_group`" + ` resource with a single alert rule can be moved to this resource with a ` + "`moved`" + ` block (Terraform 1.8+), without recreating the rule.
+
 This resource is currently in alpha and is subject to change. Grafana 12.4+ users must enable the ` + "`kubernetesAlertingRules`" + ` [feature toggle](https://grafana.com/docs/grafana/latest/setup-grafana/configure-grafana/feature-toggles/).
 `,
 				SpecAttributes: map[string]schema.Attribute{
@@ -135,6 +137,9 @@ This resource is currently in alpha and is subject to change. Grafana 12.4+ user
 						Required:    true,
 						ElementType: types.StringType,
 						Description: "A sequence of stages that describe the contents of the rule. Each value is a JSON string representing an expression object.",
+						Validators: []validator.Map{
+							ExpressionGraphValidator{},
+						},
 					},
 					"paused": schema.BoolAttribute{
 						Optional:    true,
@@ -198,8 +203,10 @@ This resource is currently in alpha and is subject to change. Grafana 12.4+ user
 					"notification_settings": nfSettingsBlock(),
 				},
 			},
-			SpecParser: parseAlertRuleSpec,
-			SpecSaver:  saveAlertRuleSpec,
+			SpecParser:         parseAlertRuleSpec,
+			SpecSaver:          saveAlertRuleSpec,
+			StateMovers:        []StateMover{legacyAlertRuleStateMover},
+			MapsDatasourceUIDs: true,
 		})
 }
 
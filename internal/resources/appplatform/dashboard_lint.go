@@ -0,0 +1,39 @@
+package appplatform
+
+import (
+	"context"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// lintDashboardSpec runs the rules of the `dashboard_lint` provider block on the JSON of a dashboard spec.
+func lintDashboardSpec(ctx context.Context, client *common.Client, spec types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(client.DashboardLint) == 0 || spec.IsNull() || spec.IsUnknown() {
+		return diags
+	}
+
+	specJSONValue, ok := spec.Attributes()["json"].(basetypes.StringValuable)
+	if !ok {
+		return diags
+	}
+	specJSON, d := specJSONValue.ToStringValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() || specJSON.IsNull() || specJSON.IsUnknown() {
+		return diags
+	}
+
+	jsonPath := path.Root("spec").AtName("json")
+	for _, issue := range client.DashboardLint.LintDashboardJSON(specJSON.ValueString()) {
+		if issue.Severity == common.DashboardLintError {
+			diags.AddAttributeError(jsonPath, "Dashboard lint error", issue.String())
+		} else {
+			diags.AddAttributeWarning(jsonPath, "Dashboard lint warning", issue.String())
+		}
+	}
+	return diags
+}
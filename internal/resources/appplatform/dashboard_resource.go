@@ -32,6 +32,8 @@ func Dashboard() NamedResource {
 				MarkdownDescription: `
 Manages Grafana dashboards using the new Grafana APIs.
 
+A ` + "`grafana_dashboard`" + ` resource can be moved to this resource with a ` + "`moved`" + ` block (Terraform 1.8+), without recreating the dashboard.
+
 * [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/dashboard/#new-dashboard-apis)
 	`,
@@ -58,6 +60,9 @@ Manages Grafana dashboards using the new Grafana APIs.
 					},
 				},
 			},
+			SpecValidator:      lintDashboardSpec,
+			MapsDatasourceUIDs: true,
+			StateMovers:        []StateMover{legacyDashboardStateMover},
 			SpecParser: func(ctx context.Context, spec types.Object, dst *v1beta1.Dashboard) diag.Diagnostics {
 				var data DashboardSpecModel
 				if diag := spec.As(ctx, &data, basetypes.ObjectAsOptions{
@@ -58,6 +58,8 @@ Manages Grafana dashboards using the v2beta1 (Dynamic Dashboards) schema.
 					},
 				},
 			},
+			SpecValidator:      lintDashboardSpec,
+			MapsDatasourceUIDs: true,
 			SpecParser: func(ctx context.Context, spec types.Object, dst *v2beta1.Dashboard) diag.Diagnostics {
 				var data DashboardV2SpecModel
 				if diag := spec.As(ctx, &data, basetypes.ObjectAsOptions{
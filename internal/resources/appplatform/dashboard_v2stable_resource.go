@@ -58,6 +58,8 @@ Manages Grafana dashboards using the v2 (Dynamic Dashboards) schema.
 					},
 				},
 			},
+			SpecValidator:      lintDashboardSpec,
+			MapsDatasourceUIDs: true,
 			SpecParser: func(ctx context.Context, spec types.Object, dst *v2.Dashboard) diag.Diagnostics {
 				var data DashboardV2StableSpecModel
 				if diag := spec.As(ctx, &data, basetypes.ObjectAsOptions{
@@ -0,0 +1,111 @@
+package generic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/appplatform"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// managedResourceVerbs are the verbs a kind must support to be managed with the generic resource.
+var managedResourceVerbs = []string{"list", "get", "create", "update", "delete"}
+
+// ListIDs returns the import IDs (`<api_group>/<version>/<kind>/<object_name>`) of all the namespaced objects
+// served by the Grafana App Platform API, using the preferred version of each API group.
+// Kinds for which skip returns true are ignored, so that they can be exported with their typed resource instead.
+func ListIDs(ctx context.Context, client *common.Client, skip func(apiGroup, kind string) bool) ([]string, error) {
+	if client.GrafanaAppPlatformAPI == nil {
+		return nil, errors.New("the Grafana App Platform API client is not configured")
+	}
+
+	r := &genericResource{client: client}
+	namespace, diags := r.resolveNamespace(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s: %s", diags.Errors()[0].Summary(), diags.Errors()[0].Detail())
+	}
+
+	body, err := r.grafanaGet(ctx, "/apis")
+	if err != nil {
+		return nil, err
+	}
+
+	var groups metav1.APIGroupList
+	if err := json.Unmarshal(body, &groups); err != nil {
+		return nil, fmt.Errorf("failed to decode discovery response: %w", err)
+	}
+
+	var ids []string
+	for _, group := range groups.Groups {
+		version := group.PreferredVersion.Version
+		body, err := r.grafanaGet(ctx, fmt.Sprintf("/apis/%s/%s", group.Name, version))
+		if err != nil {
+			return nil, err
+		}
+
+		var resources metav1.APIResourceList
+		if err := json.Unmarshal(body, &resources); err != nil {
+			return nil, fmt.Errorf("failed to decode discovery response: %w", err)
+		}
+
+		for _, candidate := range resources.APIResources {
+			if strings.Contains(candidate.Name, "/") || !candidate.Namespaced || !supportsVerbs(candidate.Verbs) {
+				continue
+			}
+			if skip != nil && skip(group.Name, candidate.Kind) {
+				continue
+			}
+
+			cli, diags := r.clientForResolved(resolvedGenericResource{
+				APIGroup:  group.Name,
+				Version:   version,
+				Kind:      candidate.Kind,
+				Plural:    candidate.Name,
+				Namespace: namespace,
+			})
+			if diags.HasError() {
+				return nil, fmt.Errorf("%s: %s", diags.Errors()[0].Summary(), diags.Errors()[0].Detail())
+			}
+
+			names, err := appplatform.ListNames(ctx, cli)
+			if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) || apierrors.IsMethodNotSupported(err) {
+				// Some kinds are advertised but cannot be listed by every identity (or at all), skip them.
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s/%s %s: %w", group.Name, version, candidate.Kind, err)
+			}
+
+			for _, name := range names {
+				ids = append(ids, strings.Join([]string{group.Name, version, candidate.Kind, name}, "/"))
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// DiscoverStackID returns the ID of the Grafana Cloud stack reported by the instance's `/bootdata` endpoint.
+// An error is returned for instances which are not Grafana Cloud stacks.
+func DiscoverStackID(ctx context.Context, client *common.Client) (int64, error) {
+	discoveryCtx, cancel := context.WithTimeout(ctx, bootdataRequestTimeout)
+	defer cancel()
+
+	r := &genericResource{client: client}
+	return r.discoverGrafanaStackID(discoveryCtx)
+}
+
+func supportsVerbs(verbs metav1.Verbs) bool {
+	for _, verb := range managedResourceVerbs {
+		if !slices.Contains(verbs, verb) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package appplatform
+
+import (
+	"context"
+	"errors"
+
+	sdkresource "github.com/grafana/grafana-app-sdk/resource"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+const listPageSize = 500
+
+// NewLister returns a lister which lists the IDs (the object names) of all the objects of the given kind
+// in the namespace of the configured Grafana org or stack.
+// Instances which do not serve the kind's API group yield no IDs rather than an error.
+func NewLister[T sdkresource.Object, L sdkresource.ListObject](kind sdkresource.Kind) common.ResourceListIDsFunc {
+	return func(ctx context.Context, client *common.Client, _ any) ([]string, error) {
+		if client.GrafanaAppPlatformAPI == nil {
+			return nil, errors.New("the Grafana App Platform API client is not configured")
+		}
+
+		rcli, err := client.GrafanaAppPlatformAPI.ClientFor(kind)
+		if err != nil {
+			return nil, err
+		}
+
+		ns, errMsg := namespaceForClient(client.GrafanaOrgID, client.GrafanaStackID)
+		if errMsg != "" {
+			return nil, errors.New(errMsg)
+		}
+
+		names, err := ListNames(ctx, sdkresource.NewNamespaced(sdkresource.NewTypedClient[T, L](rcli, kind), ns))
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return names, err
+	}
+}
+
+// ListNames returns the names of all the objects in the client's namespace, following pagination.
+func ListNames[T sdkresource.Object, L sdkresource.ListObject](ctx context.Context, client *sdkresource.NamespacedClient[T, L]) ([]string, error) {
+	var (
+		names []string
+		opts  = sdkresource.ListOptions{Limit: listPageSize}
+	)
+
+	for {
+		list, err := client.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range list.GetItems() {
+			names = append(names, item.GetName())
+		}
+
+		opts.Continue = list.GetContinue()
+		if opts.Continue == "" {
+			return names, nil
+		}
+	}
+}
@@ -0,0 +1,418 @@
+package appplatform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prometheus/common/model"
+)
+
+// State movers from the legacy resources. They read the raw state of the source resource, so they must
+// handle the states written by all the provider versions with the current schema version of the source.
+// The org_id of the source is ignored: the moved resource is in the namespace of the provider configuration.
+
+// legacyDashboardStateMover moves a `grafana_dashboard` to a dashboard with a v1 spec.
+var legacyDashboardStateMover = StateMover{
+	SourceTypeName: "grafana_dashboard",
+	Convert: func(ctx context.Context, src map[string]any) (MovedState, diag.Diagnostics) {
+		configJSON := legacyString(src, "config_json")
+		var spec map[string]any
+		if err := json.Unmarshal([]byte(configJSON), &spec); err != nil {
+			return MovedState{}, diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"failed to parse the config_json of the source dashboard",
+					"The dashboard can't be moved when the provider stores the SHA256 of the dashboards (store_dashboard_sha256): "+err.Error(),
+				),
+			}
+		}
+		// Like the dashboard resources, the numeric ID and the version are not part of the spec.
+		delete(spec, "id")
+		delete(spec, "version")
+		specJSON, err := json.Marshal(spec)
+		if err != nil {
+			return MovedState{}, diag.Diagnostics{
+				diag.NewErrorDiagnostic("failed to marshal dashboard spec", err.Error()),
+			}
+		}
+
+		obj, diags := types.ObjectValueFrom(ctx, map[string]attr.Type{
+			"json":  jsontypes.NormalizedType{},
+			"title": types.StringType,
+			"tags":  types.ListType{ElemType: types.StringType},
+		}, DashboardSpecModel{
+			JSON:  jsontypes.NewNormalizedValue(string(specJSON)),
+			Title: types.StringNull(),
+			Tags:  types.ListNull(types.StringType),
+		})
+
+		folderUID := legacyResourceUID(legacyString(src, "folder"))
+		if folderUID == "0" { // The General folder
+			folderUID = ""
+		}
+
+		return MovedState{
+			UID:       legacyString(src, "uid"),
+			FolderUID: folderUID,
+			Spec:      obj,
+		}, diags
+	},
+}
+
+// legacyPlaylistStateMover moves a `grafana_playlist` to a playlist of any version, their specs are the same.
+var legacyPlaylistStateMover = StateMover{
+	SourceTypeName: "grafana_playlist",
+	Convert: func(ctx context.Context, src map[string]any) (MovedState, diag.Diagnostics) {
+		items, _ := src["item"].([]any)
+		slices.SortStableFunc(items, func(a, b any) int {
+			return int(legacyNumber(a, "order") - legacyNumber(b, "order"))
+		})
+
+		models := make([]PlaylistItemModel, 0, len(items))
+		for _, item := range items {
+			models = append(models, PlaylistItemModel{
+				Type:  types.StringValue(legacyString(item, "type")),
+				Value: types.StringValue(legacyString(item, "value")),
+			})
+		}
+		itemsList, diags := types.ListValueFrom(ctx, PlaylistItemType, models)
+		if diags.HasError() {
+			return MovedState{}, diags
+		}
+
+		obj, diags := types.ObjectValueFrom(ctx, map[string]attr.Type{
+			"title":    types.StringType,
+			"interval": types.StringType,
+			"items":    types.ListType{ElemType: PlaylistItemType},
+		}, PlaylistSpecModel{
+			Title:    types.StringValue(legacyString(src, "name")),
+			Interval: types.StringValue(legacyString(src, "interval")),
+			Items:    itemsList,
+		})
+
+		return MovedState{
+			UID:  legacyResourceUID(legacyString(src, "id")),
+			Spec: obj,
+		}, diags
+	},
+}
+
+// legacyAlertRuleStateMover moves a `grafana_rule_group` with a single alert rule to an alert rule.
+var legacyAlertRuleStateMover = StateMover{
+	SourceTypeName: "grafana_rule_group",
+	Convert: func(ctx context.Context, src map[string]any) (MovedState, diag.Diagnostics) {
+		rule, diags := legacyRuleGroupRule(src, false)
+		if diags.HasError() {
+			return MovedState{}, diags
+		}
+
+		condition := legacyString(rule, "condition")
+		expressions, diags := legacyRuleExpressions(rule, condition)
+		if diags.HasError() {
+			return MovedState{}, diags
+		}
+
+		spec := AlertRuleSpecModel{
+			Title:                       types.StringValue(legacyString(rule, "name")),
+			Expressions:                 expressions,
+			Paused:                      types.BoolNull(),
+			NoDataState:                 types.StringValue(legacyString(rule, "no_data_state")),
+			ExecErrState:                types.StringValue(legacyString(rule, "exec_err_state")),
+			For:                         legacyDuration(rule, "for"),
+			KeepFiringFor:               legacyDuration(rule, "keep_firing_for"),
+			MissingSeriesEvalsToResolve: types.Int64Null(),
+			NotificationSettings:        types.ObjectNull(notificationSettingsType.AttrTypes),
+			PanelRef:                    types.MapNull(types.StringType),
+		}
+		// The legacy resource applies the same defaults on the server side.
+		if spec.NoDataState.ValueString() == "" {
+			spec.NoDataState = types.StringValue("NoData")
+		}
+		if spec.ExecErrState.ValueString() == "" {
+			spec.ExecErrState = types.StringValue("Alerting")
+		}
+		if paused, _ := rule["is_paused"].(bool); paused {
+			spec.Paused = types.BoolValue(true)
+		}
+		if evals := legacyNumber(rule, "missing_series_evals_to_resolve"); evals > 0 {
+			spec.MissingSeriesEvalsToResolve = types.Int64Value(evals)
+		}
+
+		spec.Trigger, diags = legacyRuleTrigger(ctx, src)
+		if diags.HasError() {
+			return MovedState{}, diags
+		}
+
+		// The panel of the rule is a pair of annotations in the legacy resource.
+		annotations := legacyStringMap(rule, "annotations")
+		if dashboardUID, panelID := annotations["__dashboardUid__"], annotations["__panelId__"]; dashboardUID != "" && panelID != "" {
+			spec.PanelRef = types.MapValueMust(types.StringType, map[string]attr.Value{
+				"dashboard_uid": types.StringValue(dashboardUID),
+				"panel_id":      types.StringValue(panelID),
+			})
+			delete(annotations, "__dashboardUid__")
+			delete(annotations, "__panelId__")
+		}
+		spec.Annotations = legacyStringMapValue(annotations)
+		spec.Labels = legacyStringMapValue(legacyStringMap(rule, "labels"))
+
+		if settings, ok := legacyBlock(rule, "notification_settings"); ok {
+			spec.NotificationSettings, diags = legacyNotificationSettings(ctx, settings)
+			if diags.HasError() {
+				return MovedState{}, diags
+			}
+		}
+
+		obj, diags := types.ObjectValueFrom(ctx, alertRuleSpecType.AttrTypes, spec)
+		return MovedState{
+			UID:       legacyString(rule, "uid"),
+			FolderUID: legacyString(src, "folder_uid"),
+			Spec:      obj,
+		}, diags
+	},
+}
+
+// legacyRecordingRuleStateMover moves a `grafana_rule_group` with a single recording rule to a recording rule.
+var legacyRecordingRuleStateMover = StateMover{
+	SourceTypeName: "grafana_rule_group",
+	Convert: func(ctx context.Context, src map[string]any) (MovedState, diag.Diagnostics) {
+		rule, diags := legacyRuleGroupRule(src, true)
+		if diags.HasError() {
+			return MovedState{}, diags
+		}
+
+		record, _ := legacyBlock(rule, "record")
+		expressions, diags := legacyRuleExpressions(rule, legacyString(record, "from"))
+		if diags.HasError() {
+			return MovedState{}, diags
+		}
+
+		spec := RecordingRuleSpecModel{
+			Title:               types.StringValue(legacyString(rule, "name")),
+			Expressions:         expressions,
+			Paused:              types.BoolNull(),
+			Metric:              types.StringValue(legacyString(record, "metric")),
+			Labels:              legacyStringMapValue(legacyStringMap(rule, "labels")),
+			TargetDatasourceUID: types.StringValue(legacyString(record, "target_datasource_uid")),
+		}
+		if paused, _ := rule["is_paused"].(bool); paused {
+			spec.Paused = types.BoolValue(true)
+		}
+
+		spec.Trigger, diags = legacyRuleTrigger(ctx, src)
+		if diags.HasError() {
+			return MovedState{}, diags
+		}
+
+		obj, diags := types.ObjectValueFrom(ctx, recordingRuleSpecType.AttrTypes, spec)
+		return MovedState{
+			UID:       legacyString(rule, "uid"),
+			FolderUID: legacyString(src, "folder_uid"),
+			Spec:      obj,
+		}, diags
+	},
+}
+
+// legacyRuleGroupRule returns the rule of a rule group, which must have a single rule of the expected kind:
+// each rule is a separate App Platform resource, and a `moved` block has a single target.
+func legacyRuleGroupRule(src map[string]any, recording bool) (map[string]any, diag.Diagnostics) {
+	name := legacyString(src, "name")
+	rules, _ := src["rule"].([]any)
+	if len(rules) != 1 {
+		return nil, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"failed to move the rule group",
+				fmt.Sprintf("The rule group %q has %d rules, only rule groups with a single rule can be moved. "+
+					"Split the rule group into groups of one rule first.", name, len(rules)),
+			),
+		}
+	}
+
+	rule, _ := rules[0].(map[string]any)
+	if _, isRecording := legacyBlock(rule, "record"); isRecording != recording {
+		kind := "an alert rule"
+		if isRecording {
+			kind = "a recording rule"
+		}
+		return nil, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"failed to move the rule group",
+				fmt.Sprintf("The rule of the rule group %q is %s, it can't be moved to this resource type.", name, kind),
+			),
+		}
+	}
+
+	return rule, nil
+}
+
+// legacyRuleExpressions converts the `data` blocks of a rule to the JSON expressions of the App Platform rules.
+// The source expression is the condition of alert rules and the `from` of recording rules.
+func legacyRuleExpressions(rule map[string]any, source string) (types.Map, diag.Diagnostics) {
+	data, _ := rule["data"].([]any)
+	expressions := make(map[string]attr.Value, len(data))
+	for _, query := range data {
+		var queryModel map[string]any
+		if err := json.Unmarshal([]byte(legacyString(query, "model")), &queryModel); err != nil {
+			return types.MapNull(types.StringType), diag.Diagnostics{
+				diag.NewErrorDiagnostic("Failed to parse expression JSON", err.Error()),
+			}
+		}
+
+		refID := legacyString(query, "ref_id")
+		expression := map[string]any{
+			"model":          queryModel,
+			"datasource_uid": legacyString(query, "datasource_uid"),
+		}
+		if queryType := legacyString(query, "query_type"); queryType != "" {
+			expression["query_type"] = queryType
+		}
+		if timeRange, ok := legacyBlock(query, "relative_time_range"); ok {
+			expression["relative_time_range"] = map[string]any{
+				"from": legacySeconds(legacyNumber(timeRange, "from")),
+				"to":   legacySeconds(legacyNumber(timeRange, "to")),
+			}
+		}
+		if refID == source {
+			expression["source"] = true
+		}
+
+		expressionJSON, err := json.Marshal(expression)
+		if err != nil {
+			return types.MapNull(types.StringType), diag.Diagnostics{
+				diag.NewErrorDiagnostic("Failed to marshal expression to JSON", err.Error()),
+			}
+		}
+		expressions[refID] = types.StringValue(string(expressionJSON))
+	}
+
+	return types.MapValue(types.StringType, expressions)
+}
+
+// legacyRuleTrigger converts the evaluation interval of a rule group, which applies to each rule of the App Platform.
+func legacyRuleTrigger(ctx context.Context, src map[string]any) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, ruleTriggerType.AttrTypes, RuleTriggerModel{
+		Interval: types.StringValue(legacySeconds(legacyNumber(src, "interval_seconds"))),
+	})
+}
+
+func legacyNotificationSettings(ctx context.Context, src map[string]any) (types.Object, diag.Diagnostics) {
+	routing := SimplifiedRoutingModel{
+		ContactPoint:   types.StringValue(legacyString(src, "contact_point")),
+		GroupBy:        legacyStringList(src, "group_by"),
+		MuteTimings:    legacyStringList(src, "mute_timings"),
+		ActiveTimings:  legacyStringList(src, "active_timings"),
+		GroupWait:      legacyOptionalString(src, "group_wait"),
+		GroupInterval:  legacyOptionalString(src, "group_interval"),
+		RepeatInterval: legacyOptionalString(src, "repeat_interval"),
+	}
+	simplifiedRouting, diags := types.ObjectValueFrom(ctx, simplifiedRoutingType.AttrTypes, routing)
+	if diags.HasError() {
+		return types.ObjectNull(notificationSettingsType.AttrTypes), diags
+	}
+
+	return types.ObjectValue(notificationSettingsType.AttrTypes, map[string]attr.Value{
+		"contact_point":      types.StringNull(),
+		"group_by":           types.ListNull(types.StringType),
+		"mute_timings":       types.ListNull(types.StringType),
+		"active_timings":     types.ListNull(types.StringType),
+		"group_wait":         types.StringNull(),
+		"group_interval":     types.StringNull(),
+		"repeat_interval":    types.StringNull(),
+		"simplified_routing": simplifiedRouting,
+		"named_routing_tree": types.ObjectNull(namedRoutingTreeType.AttrTypes),
+	})
+}
+
+// legacyResourceUID returns the UID of an `orgID:uid` ID.
+func legacyResourceUID(id string) string {
+	if _, uid, ok := strings.Cut(id, ":"); ok {
+		return uid
+	}
+	return id
+}
+
+func legacyString(src any, key string) string {
+	m, _ := src.(map[string]any)
+	s, _ := m[key].(string)
+	return s
+}
+
+func legacyOptionalString(src any, key string) types.String {
+	if s := legacyString(src, key); s != "" {
+		return types.StringValue(s)
+	}
+	return types.StringNull()
+}
+
+// legacyNumber returns an integer attribute, the raw state is decoded as JSON.
+func legacyNumber(src any, key string) int64 {
+	m, _ := src.(map[string]any)
+	n, _ := m[key].(float64)
+	return int64(n)
+}
+
+// legacyDuration returns a duration attribute, or null if it is unset or zero.
+func legacyDuration(src any, key string) types.String {
+	s := legacyString(src, key)
+	if d, err := model.ParseDuration(s); s == "" || err == nil && d == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func legacySeconds(seconds int64) string {
+	return model.Duration(time.Duration(seconds) * time.Second).String()
+}
+
+// legacyBlock returns the single element of a block with MaxItems 1.
+func legacyBlock(src any, key string) (map[string]any, bool) {
+	m, _ := src.(map[string]any)
+	blocks, _ := m[key].([]any)
+	if len(blocks) == 0 {
+		return nil, false
+	}
+	block, _ := blocks[0].(map[string]any)
+	return block, true
+}
+
+func legacyStringMap(src any, key string) map[string]string {
+	m, _ := src.(map[string]any)
+	values, _ := m[key].(map[string]any)
+	res := make(map[string]string, len(values))
+	for k, v := range values {
+		res[k], _ = v.(string)
+	}
+	return res
+}
+
+func legacyStringMapValue(values map[string]string) types.Map {
+	if len(values) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	elements := make(map[string]attr.Value, len(values))
+	for k, v := range values {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func legacyStringList(src any, key string) types.List {
+	m, _ := src.(map[string]any)
+	values, _ := m[key].([]any)
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		s, _ := v.(string)
+		elements = append(elements, types.StringValue(s))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
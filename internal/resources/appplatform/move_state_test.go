@@ -0,0 +1,223 @@
+package appplatform
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/require"
+)
+
+func legacyState(t *testing.T, state string) map[string]any {
+	t.Helper()
+
+	var src map[string]any
+	require.NoError(t, json.Unmarshal([]byte(state), &src))
+	return src
+}
+
+func TestLegacyDashboardStateMover(t *testing.T) {
+	ctx := context.Background()
+	src := legacyState(t, `{
+		"id": "1:my-dashboard",
+		"uid": "my-dashboard",
+		"org_id": "1",
+		"folder": "1:my-folder",
+		"config_json": "{\"id\":12,\"uid\":\"my-dashboard\",\"title\":\"My Dashboard\",\"version\":3}",
+		"version": 3
+	}`)
+
+	moved, diags := legacyDashboardStateMover.Convert(ctx, src)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "my-dashboard", moved.UID)
+	require.Equal(t, "my-folder", moved.FolderUID)
+
+	var spec DashboardSpecModel
+	require.False(t, moved.Spec.As(ctx, &spec, basetypes.ObjectAsOptions{}).HasError())
+	require.JSONEq(t, `{"uid":"my-dashboard","title":"My Dashboard"}`, spec.JSON.ValueString())
+	require.True(t, spec.Title.IsNull())
+	require.True(t, spec.Tags.IsNull())
+
+	src["config_json"] = "8f434346648f6b96df89dda901c5176b10a6d83961dd3c1ac88b59b2dc327aa4"
+	_, diags = legacyDashboardStateMover.Convert(ctx, src)
+	require.True(t, diags.HasError())
+}
+
+func TestLegacyPlaylistStateMover(t *testing.T) {
+	ctx := context.Background()
+	src := legacyState(t, `{
+		"id": "1:my-playlist",
+		"org_id": "1",
+		"name": "My Playlist",
+		"interval": "5m",
+		"item": [
+			{"id": "2", "order": 2, "type": "dashboard_by_tag", "value": "team-a"},
+			{"id": "1", "order": 1, "type": "dashboard_by_uid", "value": "my-dashboard"}
+		]
+	}`)
+
+	moved, diags := legacyPlaylistStateMover.Convert(ctx, src)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "my-playlist", moved.UID)
+	require.Empty(t, moved.FolderUID)
+
+	var spec PlaylistSpecModel
+	require.False(t, moved.Spec.As(ctx, &spec, basetypes.ObjectAsOptions{}).HasError())
+	require.Equal(t, "My Playlist", spec.Title.ValueString())
+	require.Equal(t, "5m", spec.Interval.ValueString())
+
+	var items []PlaylistItemModel
+	require.False(t, spec.Items.ElementsAs(ctx, &items, false).HasError())
+	require.Equal(t, []PlaylistItemModel{
+		{Type: types.StringValue("dashboard_by_uid"), Value: types.StringValue("my-dashboard")},
+		{Type: types.StringValue("dashboard_by_tag"), Value: types.StringValue("team-a")},
+	}, items)
+}
+
+const legacyRuleGroupState = `{
+	"id": "1:my-folder:my-group",
+	"org_id": "1",
+	"name": "my-group",
+	"folder_uid": "my-folder",
+	"interval_seconds": 60,
+	"rule": [{
+		"uid": "my-rule",
+		"name": "My Rule",
+		"for": "5m",
+		"keep_firing_for": "",
+		"missing_series_evals_to_resolve": 0,
+		"no_data_state": "",
+		"exec_err_state": "Error",
+		"condition": "B",
+		"is_paused": false,
+		"labels": {"team": "a"},
+		"annotations": {"summary": "High", "__dashboardUid__": "my-dashboard", "__panelId__": "4"},
+		"data": [
+			{
+				"ref_id": "A",
+				"datasource_uid": "prometheus",
+				"query_type": "",
+				"model": "{\"expr\":\"up\",\"refId\":\"A\"}",
+				"relative_time_range": [{"from": 600, "to": 0}]
+			},
+			{
+				"ref_id": "B",
+				"datasource_uid": "__expr__",
+				"query_type": "",
+				"model": "{\"type\":\"threshold\",\"expression\":\"A\",\"refId\":\"B\"}",
+				"relative_time_range": [{"from": 0, "to": 0}]
+			}
+		],
+		"notification_settings": [{
+			"contact_point": "my-contact-point",
+			"group_by": ["alertname", "grafana_folder"],
+			"mute_timings": [],
+			"active_timings": [],
+			"group_wait": "",
+			"group_interval": "",
+			"repeat_interval": "1h"
+		}],
+		"record": []
+	}]
+}`
+
+func TestLegacyAlertRuleStateMover(t *testing.T) {
+	ctx := context.Background()
+
+	moved, diags := legacyAlertRuleStateMover.Convert(ctx, legacyState(t, legacyRuleGroupState))
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "my-rule", moved.UID)
+	require.Equal(t, "my-folder", moved.FolderUID)
+
+	var spec AlertRuleSpecModel
+	require.False(t, moved.Spec.As(ctx, &spec, basetypes.ObjectAsOptions{}).HasError())
+	require.Equal(t, "My Rule", spec.Title.ValueString())
+	require.Equal(t, "5m", spec.For.ValueString())
+	require.True(t, spec.KeepFiringFor.IsNull())
+	require.True(t, spec.MissingSeriesEvalsToResolve.IsNull())
+	require.True(t, spec.Paused.IsNull())
+	require.Equal(t, "NoData", spec.NoDataState.ValueString())
+	require.Equal(t, "Error", spec.ExecErrState.ValueString())
+
+	var trigger RuleTriggerModel
+	require.False(t, spec.Trigger.As(ctx, &trigger, basetypes.ObjectAsOptions{}).HasError())
+	require.Equal(t, "1m", trigger.Interval.ValueString())
+
+	var annotations, labels, panelRef map[string]string
+	require.False(t, spec.Annotations.ElementsAs(ctx, &annotations, false).HasError())
+	require.False(t, spec.Labels.ElementsAs(ctx, &labels, false).HasError())
+	require.False(t, spec.PanelRef.ElementsAs(ctx, &panelRef, false).HasError())
+	require.Equal(t, map[string]string{"summary": "High"}, annotations)
+	require.Equal(t, map[string]string{"team": "a"}, labels)
+	require.Equal(t, map[string]string{"dashboard_uid": "my-dashboard", "panel_id": "4"}, panelRef)
+
+	var expressions map[string]string
+	require.False(t, spec.Expressions.ElementsAs(ctx, &expressions, false).HasError())
+	require.JSONEq(t, `{
+		"model": {"expr": "up", "refId": "A"},
+		"datasource_uid": "prometheus",
+		"relative_time_range": {"from": "10m", "to": "0s"}
+	}`, expressions["A"])
+	require.JSONEq(t, `{
+		"model": {"type": "threshold", "expression": "A", "refId": "B"},
+		"datasource_uid": "__expr__",
+		"relative_time_range": {"from": "0s", "to": "0s"},
+		"source": true
+	}`, expressions["B"])
+
+	var settings NotificationSettingsModel
+	require.False(t, spec.NotificationSettings.As(ctx, &settings, basetypes.ObjectAsOptions{}).HasError())
+	require.True(t, settings.ContactPoint.IsNull())
+	var routing SimplifiedRoutingModel
+	require.False(t, settings.SimplifiedRouting.As(ctx, &routing, basetypes.ObjectAsOptions{}).HasError())
+	require.Equal(t, "my-contact-point", routing.ContactPoint.ValueString())
+	require.Equal(t, "1h", routing.RepeatInterval.ValueString())
+	require.True(t, routing.GroupWait.IsNull())
+	require.True(t, routing.MuteTimings.IsNull())
+	require.Len(t, routing.GroupBy.Elements(), 2)
+
+	_, diags = legacyRecordingRuleStateMover.Convert(ctx, legacyState(t, legacyRuleGroupState))
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Detail(), "is an alert rule")
+}
+
+func TestLegacyRecordingRuleStateMover(t *testing.T) {
+	ctx := context.Background()
+	src := legacyState(t, legacyRuleGroupState)
+	rule := src["rule"].([]any)[0].(map[string]any)
+	rule["condition"] = ""
+	rule["is_paused"] = true
+	rule["notification_settings"] = []any{}
+	rule["record"] = []any{map[string]any{
+		"metric":                "my_metric",
+		"from":                  "A",
+		"target_datasource_uid": "prometheus",
+	}}
+
+	moved, diags := legacyRecordingRuleStateMover.Convert(ctx, src)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "my-rule", moved.UID)
+	require.Equal(t, "my-folder", moved.FolderUID)
+
+	var spec RecordingRuleSpecModel
+	require.False(t, moved.Spec.As(ctx, &spec, basetypes.ObjectAsOptions{}).HasError())
+	require.Equal(t, "My Rule", spec.Title.ValueString())
+	require.Equal(t, "my_metric", spec.Metric.ValueString())
+	require.Equal(t, "prometheus", spec.TargetDatasourceUID.ValueString())
+	require.True(t, spec.Paused.ValueBool())
+
+	var expressions map[string]string
+	require.False(t, spec.Expressions.ElementsAs(ctx, &expressions, false).HasError())
+	require.Contains(t, expressions["A"], `"source":true`)
+	require.NotContains(t, expressions["B"], `"source"`)
+
+	_, diags = legacyAlertRuleStateMover.Convert(ctx, src)
+	require.True(t, diags.HasError())
+
+	src["rule"] = append(src["rule"].([]any), rule)
+	_, diags = legacyRecordingRuleStateMover.Convert(ctx, src)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Detail(), "has 2 rules")
+}
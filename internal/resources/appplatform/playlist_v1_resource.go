@@ -24,6 +24,8 @@ func PlaylistV1() NamedResource {
 				MarkdownDescription: `
 Manages Grafana playlists using the new Grafana APIs.
 
+A ` + "`grafana_playlist`" + ` resource can be moved to this resource with a ` + "`moved`" + ` block (Terraform 1.8+), without recreating the playlist.
+
 * [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/create-manage-playlists/)
 * [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/apis/)
 `,
@@ -46,6 +48,7 @@ Manages Grafana playlists using the new Grafana APIs.
 					},
 				},
 			},
+			StateMovers: []StateMover{legacyPlaylistStateMover},
 			SpecParser: func(ctx context.Context, src types.Object, dst *playlistv1.Playlist) diag.Diagnostics {
 				var data PlaylistSpecModel
 				if diag := src.As(ctx, &data, basetypes.ObjectAsOptions{
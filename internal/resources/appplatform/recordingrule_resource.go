@@ -46,6 +46,8 @@ func RecordingRule() NamedResource {
 				Description: "Manages Grafana Recording Rules.",
 				MarkdownDescription: `
 Manages Grafana Recording Rules.
+
+A ` + "`grafana_rule_group`" + ` resource with a single recording rule can be moved to this resource with a ` + "`moved`" + ` block (Terraform 1.8+), without recreating the rule.
 `,
 				SpecAttributes: map[string]schema.Attribute{
 					"title": schema.StringAttribute{
@@ -56,6 +58,9 @@ Manages Grafana Recording Rules.
 						Required:    true,
 						ElementType: types.StringType,
 						Description: "A sequence of stages that describe the contents of the rule. Each value is a JSON string representing an expression object.",
+						Validators: []validator.Map{
+							ExpressionGraphValidator{},
+						},
 					},
 					"paused": schema.BoolAttribute{
 						Optional:    true,
@@ -90,8 +95,10 @@ Manages Grafana Recording Rules.
 					},
 				},
 			},
-			SpecParser: parseRecordingRuleSpec,
-			SpecSaver:  saveRecordingRuleSpec,
+			SpecParser:         parseRecordingRuleSpec,
+			SpecSaver:          saveRecordingRuleSpec,
+			StateMovers:        []StateMover{legacyRecordingRuleStateMover},
+			MapsDatasourceUIDs: true,
 		})
 }
 
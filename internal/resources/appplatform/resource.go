@@ -2,6 +2,7 @@ package appplatform
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"sort"
@@ -74,10 +75,11 @@ var (
 
 // ResourceModel is a Terraform model for a Grafana resource.
 type ResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Metadata types.Object `tfsdk:"metadata"`
-	Spec     types.Object `tfsdk:"spec"`
-	Options  types.Object `tfsdk:"options"`
+	ID        types.String `tfsdk:"id"`
+	Metadata  types.Object `tfsdk:"metadata"`
+	Spec      types.Object `tfsdk:"spec"`
+	Options   types.Object `tfsdk:"options"`
+	LabelsAll types.Map    `tfsdk:"labels_all"`
 }
 
 // ResourceMetadataModel is a Terraform model for the metadata of a Grafana resource.
@@ -98,8 +100,12 @@ type ResourceConfig[T sdkresource.Object] struct {
 	SpecSaver     SpecSaver[T]
 	SecureParser  SecureParser[T]
 	PlanModifier  ResourcePlanModifier
+	SpecValidator ResourceSpecValidator
 	UpdateDecider ResourceUpdateDecider
 	UseConfigSpec bool
+	StateMovers   []StateMover
+	// MapsDatasourceUIDs rewrites the datasource references of the spec with the `datasource_uid_map` provider attribute.
+	MapsDatasourceUIDs bool
 }
 
 // ResourceSpecSchema is the Terraform schema for a Grafana resource spec.
@@ -131,15 +137,37 @@ type SecureValueAttribute struct {
 // ResourcePlanModifier allows customizing the plan for a resource.
 type ResourcePlanModifier func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse)
 
+// ResourceSpecValidator checks the planned spec against the provider configuration.
+// Unlike config validators, it runs once the provider is configured, during plan.
+type ResourceSpecValidator func(ctx context.Context, client *common.Client, spec types.Object) diag.Diagnostics
+
 // ResourceUpdateDecider allows skipping updates when no server mutation is needed.
 type ResourceUpdateDecider func(ctx context.Context, req resource.UpdateRequest, plan ResourceModel, prior ResourceModel) (bool, diag.Diagnostics)
 
+// StateMover converts the state of a resource of another type managing the same Grafana object,
+// e.g. its legacy resource, so that a `moved` block migrates it without destroying and recreating it.
+type StateMover struct {
+	// SourceTypeName is the type of the source resource, e.g. `grafana_dashboard`.
+	SourceTypeName string
+	// Convert converts the raw state of the source resource.
+	Convert func(ctx context.Context, src map[string]any) (MovedState, diag.Diagnostics)
+}
+
+// MovedState is the state of a resource converted by a StateMover.
+// The spec is an object of the spec schema, which is parsed and saved like the spec of an imported resource.
+type MovedState struct {
+	UID       string
+	FolderUID string
+	Spec      types.Object
+}
+
 // Resource is a generic Terraform resource for a Grafana resource.
 type Resource[T sdkresource.Object, L sdkresource.ListObject] struct {
-	config       ResourceConfig[T]
-	client       *sdkresource.NamespacedClient[T, L]
-	clientID     string
-	resourceName string
+	config         ResourceConfig[T]
+	client         *sdkresource.NamespacedClient[T, L]
+	clientID       string
+	resourceName   string
+	providerClient *common.Client
 }
 
 // NamedResource is a Resource with a name and category.
@@ -147,6 +175,10 @@ type NamedResource struct {
 	Resource resource.Resource
 	Name     string
 	Category common.ResourceCategory
+
+	// Generation configuration
+	Kind        sdkresource.Kind // Unset for resources which are not backed by a single kind.
+	ListIDsFunc common.ResourceListIDsFunc
 }
 
 // NewNamedResource creates a new Terraform resource for a Grafana resource.
@@ -158,6 +190,9 @@ func NewNamedResource[T sdkresource.Object, L sdkresource.ListObject](
 		Resource: NewResource[T, L](cfg),
 		Name:     formatResourceType(cfg.Kind),
 		Category: category,
+
+		Kind:        cfg.Kind,
+		ListIDsFunc: NewLister[T, L](cfg.Kind),
 	}
 }
 
@@ -186,6 +221,12 @@ func (r *Resource[T, L]) Schema(ctx context.Context, req resource.SchemaRequest,
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"labels_all": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "All the labels of the resource: the `default_labels` of the provider. " +
+				"Changing the `default_labels` of the provider updates the resource.",
+		},
 	}
 	blocks := map[string]schema.Block{
 		"metadata": schema.SingleNestedBlock{
@@ -291,8 +332,29 @@ func (r *Resource[T, L]) ConfigValidators(ctx context.Context) []resource.Config
 	}
 }
 
-// ModifyPlan customizes the planned values for the resource when configured.
+// ModifyPlan plans the labels_all attribute from the default labels (see common.DefaultLabels),
+// and customizes the planned values for the resource when configured.
 func (r *Resource[T, L]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		planned := common.DefaultLabelsFromContext(ctx).Merge(map[string]string{})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_all"), planned)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if r.config.SpecValidator != nil && r.providerClient != nil && !req.Plan.Raw.IsNull() {
+		var spec types.Object
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("spec"), &spec)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(r.config.SpecValidator(ctx, r.providerClient, spec)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if r.config.PlanModifier == nil {
 		return
 	}
@@ -325,6 +387,7 @@ func (r *Resource[T, L]) Configure(ctx context.Context, req resource.ConfigureRe
 
 		return
 	}
+	r.providerClient = client
 
 	if client.GrafanaAppPlatformAPI == nil {
 		resp.Diagnostics.AddError(
@@ -508,6 +571,11 @@ func (r *Resource[T, L]) createModel(
 		return
 	}
 
+	if diag := r.mapDatasourceUIDs(obj, false); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
 	var opts ResourceOptions
 	if diag := ParseResourceOptionsFromModel(ctx, data, &opts); diag.HasError() {
 		resp.Diagnostics.Append(diag...)
@@ -518,6 +586,7 @@ func (r *Resource[T, L]) createModel(
 		resp.Diagnostics.AddError("failed to set manager properties", err.Error())
 		return
 	}
+	setDefaultLabels(ctx, obj)
 
 	if diag := r.applySecureValues(ctx, cfg, obj, false); diag.HasError() {
 		resp.Diagnostics.Append(diag...)
@@ -581,7 +650,8 @@ func (r *Resource[T, L]) Update(ctx context.Context, req resource.UpdateRequest,
 			resp.Diagnostics.Append(diag...)
 			return
 		}
-		if skip {
+		// The labels_all changes of the default labels are applied with an update
+		if skip && data.LabelsAll.Equal(prior.LabelsAll) {
 			resp.Diagnostics.Append(resp.State.Set(ctx, &prior)...)
 			return
 		}
@@ -625,6 +695,11 @@ func (r *Resource[T, L]) updateModel(
 		return
 	}
 
+	if diag := r.mapDatasourceUIDs(obj, false); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
 	var opts ResourceOptions
 	if diag := ParseResourceOptionsFromModel(ctx, data, &opts); diag.HasError() {
 		resp.Diagnostics.Append(diag...)
@@ -635,6 +710,7 @@ func (r *Resource[T, L]) updateModel(
 		resp.Diagnostics.AddError("failed to set manager properties", err.Error())
 		return
 	}
+	setDefaultLabels(ctx, obj)
 
 	if applySecure {
 		if diag := r.applySecureValues(ctx, cfg, obj, true); diag.HasError() {
@@ -762,13 +838,14 @@ func (r *Resource[T, L]) importStateModel(
 		return
 	}
 
-	if diag := r.config.SpecSaver(ctx, res, &data); diag.HasError() {
+	if diag := r.mapDatasourceUIDs(res, true); diag.HasError() {
 		resp.Diagnostics.Append(diag...)
 		return
 	}
 
-	optsMap := map[string]attr.Value{
-		"overwrite": types.BoolValue(true),
+	if diag := r.config.SpecSaver(ctx, res, &data); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
 	}
 
 	// Read manager properties from the live object for import.
@@ -777,31 +854,119 @@ func (r *Resource[T, L]) importStateModel(
 		resp.Diagnostics.AddError("failed to read manager properties on import", err.Error())
 		return
 	}
-	mgr, hasMgr := meta.GetManagerProperties()
+	mgr, _ := meta.GetManagerProperties()
+
+	opts, diag := r.importedOptions(mgr)
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	data.Options = opts
+
+	setState(data)
+}
+
+// importedOptions returns the options of a resource which is imported or moved into Terraform,
+// given the manager properties of the live object (empty if it has none).
+func (r *Resource[T, L]) importedOptions(mgr utils.ManagerProperties) (types.Object, diag.Diagnostics) {
+	optsMap := map[string]attr.Value{
+		"overwrite": types.BoolValue(true),
+	}
 
 	// Set manager_identity in the options if the object has a non-default identity.
-	if hasMgr && mgr.Identity != "" && mgr.Identity != r.clientID {
+	if mgr.Identity != "" && mgr.Identity != r.clientID {
 		optsMap["manager_identity"] = types.StringValue(mgr.Identity)
 	} else {
 		optsMap["manager_identity"] = types.StringNull()
 	}
 
 	if _, ok := r.config.Schema.OptionsAttributes["allow_ui_updates"]; ok {
-		allowUIUpdates := false
-		if hasMgr {
-			allowUIUpdates = mgr.AllowsEdits
-		}
-		optsMap["allow_ui_updates"] = types.BoolValue(allowUIUpdates)
+		optsMap["allow_ui_updates"] = types.BoolValue(mgr.AllowsEdits)
+	}
+
+	return types.ObjectValue(r.optionsTypeMap(), optsMap)
+}
+
+// MoveState returns the state movers of the Resource, which convert the state of the resources listed in StateMovers.
+func (r *Resource[T, L]) MoveState(ctx context.Context) []resource.StateMover {
+	movers := make([]resource.StateMover, 0, len(r.config.StateMovers))
+	for _, mover := range r.config.StateMovers {
+		movers = append(movers, resource.StateMover{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				r.moveState(ctx, mover, req, resp)
+			},
+		})
+	}
+
+	return movers
+}
+
+func (r *Resource[T, L]) moveState(ctx context.Context, mover StateMover, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	// Leaving the target state unset lets the framework try the next mover.
+	// The hostname of the provider address is ignored, so that states from mirrors are accepted.
+	if req.SourceTypeName != mover.SourceTypeName || !strings.HasSuffix(req.SourceProviderAddress, "/grafana/grafana") {
+		return
+	}
+
+	var src map[string]any
+	if err := json.Unmarshal(req.SourceRawState.JSON, &src); err != nil {
+		resp.Diagnostics.AddError("failed to parse the state of the source resource", err.Error())
+		return
+	}
+
+	moved, diags := mover.Convert(ctx, src)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	obj, ok := r.config.Kind.Schema.ZeroValue().(T)
+	if !ok {
+		var t T
+		resp.Diagnostics.AddError(
+			"failed to instantiate resource",
+			fmt.Sprintf("invalid type, expected: %T, got: %T", t, r.config.Kind.Schema.ZeroValue()),
+		)
+
+		return
 	}
 
-	opts, diag := types.ObjectValue(r.optionsTypeMap(), optsMap)
+	meta, err := utils.MetaAccessor(obj)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get metadata accessor", err.Error())
+		return
+	}
+	meta.SetName(moved.UID)
+	meta.SetFolder(moved.FolderUID)
+
+	// The spec goes through the parser and the saver, so that the moved state is the same as an imported one.
+	if diag := r.config.SpecParser(ctx, moved.Spec, obj); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	var data ResourceModel
+	if diag := SaveResourceToModel(ctx, obj, &data); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	if diag := r.config.SpecSaver(ctx, obj, &data); diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	// Objects managed by the legacy APIs have no manager properties, they are stamped on the next apply.
+	opts, diag := r.importedOptions(utils.ManagerProperties{})
 	if diag.HasError() {
 		resp.Diagnostics.Append(diag...)
 		return
 	}
 	data.Options = opts
 
-	setState(data)
+	resp.Diagnostics.Append(
+		r.setStateWithSecure(ctx, &resp.TargetState, data, r.nullSecureObject(), types.Int64Null())...,
+	)
 }
 
 // SpecParser is a function that parses a resource spec from a Terraform model.
@@ -870,6 +1035,8 @@ func SaveResourceToModel[T sdkresource.Object](
 	}
 
 	dst.ID = meta.UUID
+	// The labels aren't in the schema, all the labels are the default labels set on the resource.
+	dst.LabelsAll, diag = types.MapValueFrom(ctx, types.StringType, common.DefaultLabelsFromContext(ctx).All(src.GetLabels(), nil))
 
 	return diag
 }
@@ -1004,6 +1171,52 @@ func setManagerProperties(obj sdkresource.Object, clientID string, allowUIUpdate
 	return nil
 }
 
+// mapDatasourceUIDs rewrites the datasource references of the spec with the `datasource_uid_map` provider attribute,
+// or reverts them (reverse) for the resources read from the API. The spec is rewritten as JSON, whatever its type.
+func (r *Resource[T, L]) mapDatasourceUIDs(obj T, reverse bool) diag.Diagnostics {
+	if !r.config.MapsDatasourceUIDs || r.providerClient == nil || len(r.providerClient.DatasourceUIDMap) == 0 {
+		return nil
+	}
+
+	spec := obj.GetSpec()
+	specJSON, err := json.Marshal(spec)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("failed to marshal the spec to map the datasource UIDs", err.Error())}
+	}
+	var value any
+	if err := json.Unmarshal(specJSON, &value); err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("failed to unmarshal the spec to map the datasource UIDs", err.Error())}
+	}
+	if !reverse {
+		if err := r.providerClient.DatasourceUIDMap.CheckJSON(value); err != nil {
+			return diag.Diagnostics{diag.NewErrorDiagnostic("failed to map the datasource UIDs", err.Error())}
+		}
+	}
+	r.providerClient.DatasourceUIDMap.MapJSON(value, reverse)
+	if specJSON, err = json.Marshal(value); err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("failed to marshal the spec to map the datasource UIDs", err.Error())}
+	}
+
+	mapped := reflect.New(reflect.TypeOf(spec))
+	if err := json.Unmarshal(specJSON, mapped.Interface()); err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("failed to unmarshal the spec to map the datasource UIDs", err.Error())}
+	}
+	if err := obj.SetSpec(mapped.Elem().Interface()); err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("failed to set the spec with the mapped datasource UIDs", err.Error())}
+	}
+	return nil
+}
+
+// setDefaultLabels merges the default labels of the provider into the labels of the resource.
+// The labels aren't in the schema of the resources, so there's nothing to hide from their state.
+func setDefaultLabels(ctx context.Context, obj sdkresource.Object) {
+	defaultLabels := common.DefaultLabelsFromContext(ctx)
+	if len(defaultLabels) == 0 {
+		return
+	}
+	obj.SetLabels(defaultLabels.Merge(obj.GetLabels()))
+}
+
 // optionsSchemaAttributes returns the schema attributes for the options block,
 // merging base attributes with any per-resource OptionsAttributes.
 func (r *Resource[T, L]) optionsSchemaAttributes() map[string]schema.Attribute {
@@ -1779,6 +1992,7 @@ func getResourceModelFromData(ctx context.Context, src resourceData) (ResourceMo
 	diags.Append(src.GetAttribute(ctx, path.Root("metadata"), &data.Metadata)...)
 	diags.Append(src.GetAttribute(ctx, path.Root("spec"), &data.Spec)...)
 	diags.Append(src.GetAttribute(ctx, path.Root("options"), &data.Options)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("labels_all"), &data.LabelsAll)...)
 
 	return data, diags
 }
@@ -1921,6 +2135,7 @@ func setBaseState(ctx context.Context, state stateData, data ResourceModel) diag
 	diags.Append(state.SetAttribute(ctx, path.Root("metadata"), data.Metadata)...)
 	diags.Append(state.SetAttribute(ctx, path.Root("spec"), data.Spec)...)
 	diags.Append(state.SetAttribute(ctx, path.Root("options"), data.Options)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("labels_all"), data.LabelsAll)...)
 
 	return diags
 }
@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"math/big"
+	"slices"
 	"time"
 
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -107,6 +110,63 @@ func (v ExpressionMapValidator) ValidateMap(ctx context.Context, req validator.M
 	}
 }
 
+// ExpressionGraphValidator validates the server-side expressions of a rule and the references between its expressions,
+// with the same checks as the `grafana_rule_group` resource. The source expression is the condition of the rule.
+type ExpressionGraphValidator struct{}
+
+func (v ExpressionGraphValidator) Description(_ context.Context) string {
+	return "expressions must be valid and only reference the expressions of the rule, without cycles"
+}
+
+func (v ExpressionGraphValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ExpressionGraphValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var queries []common.AlertQuery
+	var sources []string
+	for _, ref := range slices.Sorted(maps.Keys(req.ConfigValue.Elements())) {
+		val, ok := req.ConfigValue.Elements()[ref].(types.String)
+		if !ok || val.IsUnknown() {
+			// The references can't be checked until all the expressions are known
+			return
+		}
+		if val.IsNull() {
+			continue
+		}
+
+		var expr struct {
+			DatasourceUID string          `json:"datasource_uid"`
+			Model         json.RawMessage `json:"model"`
+			Source        bool            `json:"source"`
+		}
+		if err := json.Unmarshal([]byte(val.ValueString()), &expr); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtMapKey(ref), "Invalid Expression", fmt.Sprintf("The expression is not valid JSON: %s", err))
+			return
+		}
+		modelJSON := "{}"
+		if len(expr.Model) > 0 {
+			modelJSON = string(expr.Model)
+		}
+		queries = append(queries, common.AlertQuery{RefID: ref, DatasourceUID: expr.DatasourceUID, Model: modelJSON})
+		if expr.Source {
+			sources = append(sources, ref)
+		}
+	}
+
+	condition := ""
+	if len(sources) == 1 {
+		condition = sources[0]
+	}
+	for _, err := range common.ValidateAlertQueries(queries, condition) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Expression", err.Error())
+	}
+}
+
 // PrometheusDurationValidator validates that a string is a valid Prometheus duration
 type PrometheusDurationValidator struct{}
 
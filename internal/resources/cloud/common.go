@@ -8,6 +8,7 @@ import (
 	"github.com/grafana/grafana-com-public-clients/go/gcom"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -118,6 +119,39 @@ func (r *basePluginFrameworkResource) Configure(ctx context.Context, req resourc
 	r.client = client.GrafanaCloudAPI
 }
 
+type basePluginFrameworkEphemeralResource struct {
+	client *gcom.APIClient
+}
+
+func (r *basePluginFrameworkEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Configure is called multiple times (sometimes when ProviderData is not yet available), we only want to configure once
+	if req.ProviderData == nil || r.client != nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *common.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if client.GrafanaCloudAPI == nil {
+		resp.Diagnostics.AddError(
+			"The Grafana Provider is missing a configuration for the Grafana Cloud API.",
+			"Please ensure that cloud_api_url and cloud_access_policy_token are set in the provider configuration.",
+		)
+
+		return
+	}
+
+	r.client = client.GrafanaCloudAPI
+}
+
 // Now returns the current time.
 // It can be overridden in tests to provide a different time.
 var Now = time.Now
@@ -0,0 +1,208 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/grafana/grafana-com-public-clients/go/gcom"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+const (
+	ephemeralAccessPolicyTokenName = "grafana_cloud_access_policy_token"
+
+	// Tokens minted by the ephemeral resource always expire, so that they do not outlive the run if Close is never called.
+	ephemeralAccessPolicyTokenDefaultTTL = time.Hour
+
+	ephemeralAccessPolicyTokenPrivateKey = "token"
+)
+
+var (
+	// Check interface
+	_ ephemeral.EphemeralResourceWithClose = (*accessPolicyTokenEphemeralResource)(nil)
+)
+
+func ephemeralAccessPolicyToken() *common.EphemeralResource {
+	return common.NewEphemeralResource(
+		common.CategoryCloud,
+		ephemeralAccessPolicyTokenName,
+		&accessPolicyTokenEphemeralResource{},
+	)
+}
+
+type accessPolicyTokenEphemeralModel struct {
+	ID             types.String `tfsdk:"id"`
+	AccessPolicyID types.String `tfsdk:"access_policy_id"`
+	Region         types.String `tfsdk:"region"`
+	Name           types.String `tfsdk:"name"`
+	DisplayName    types.String `tfsdk:"display_name"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	Token          types.String `tfsdk:"token"`
+}
+
+// accessPolicyTokenEphemeralPrivate is stored in the private data of the ephemeral resource
+// so that the token can be deleted in Close.
+type accessPolicyTokenEphemeralPrivate struct {
+	Region  string `json:"region"`
+	TokenID string `json:"token_id"`
+}
+
+type accessPolicyTokenEphemeralResource struct {
+	basePluginFrameworkEphemeralResource
+}
+
+func (r *accessPolicyTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = ephemeralAccessPolicyTokenName
+}
+
+func (r *accessPolicyTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Creates a short-lived access policy token which is never stored in the Terraform state. The token is deleted when Terraform no longer needs it.
+
+**Note:** This ephemeral resource requires Terraform 1.10+.
+
+* [Official documentation](https://grafana.com/docs/grafana-cloud/security-and-account-management/authentication-and-permissions/access-policies/)
+* [API documentation](https://grafana.com/docs/grafana-cloud/developer-resources/api-reference/cloud-api/#create-a-token)
+
+Required access policy scopes:
+
+* accesspolicies:read
+* accesspolicies:write
+* accesspolicies:delete
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the access policy token.",
+			},
+			"access_policy_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the access policy for which to create a token.",
+			},
+			"region": schema.StringAttribute{
+				Required:    true,
+				Description: "Region of the access policy. Should be set to the same region as the access policy.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the access policy token. Defaults to a generated unique name.",
+			},
+			"display_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Display name of the access policy token. Defaults to the name.",
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Expiration date of the access policy token, in RFC3339 format. Defaults to one hour from now. The token is deleted at the end of the run, the expiration only applies if Terraform is interrupted before it can do so.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The access policy token.",
+			},
+		},
+	}
+}
+
+func (r *accessPolicyTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("client not configured", "client not configured")
+		return
+	}
+
+	var data accessPolicyTokenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresAt := Now().Add(ephemeralAccessPolicyTokenDefaultTTL)
+	if !data.ExpiresAt.IsNull() {
+		t, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid expires_at", err.Error())
+			return
+		}
+		expiresAt = t
+	}
+	if data.Name.IsNull() {
+		data.Name = types.StringValue(fmt.Sprintf("terraform-ephemeral-%d", Now().UnixNano()))
+	}
+
+	tokenInput := gcom.PostTokensRequest{
+		AccessPolicyId: data.AccessPolicyID.ValueString(),
+		Name:           data.Name.ValueString(),
+		DisplayName:    data.DisplayName.ValueStringPointer(),
+		ExpiresAt:      &expiresAt,
+	}
+
+	region := data.Region.ValueString()
+	var result *gcom.AuthTokenWithSecret
+	if err := common.RetryRequest(ctx, "create policy token", func() (*http.Response, error) {
+		res, httpResp, err := r.client.TokensAPI.PostTokens(ctx).Region(region).XRequestId(ClientRequestID()).PostTokensRequest(tokenInput).Execute()
+		result = res
+		return httpResp, err
+	}); err != nil {
+		resp.Diagnostics.AddError("Failed to create access policy token", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(resourceAccessPolicyTokenID.Make(region, result.Id))
+	data.Token = types.StringValue(result.Token)
+	data.ExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+
+	private, err := json.Marshal(accessPolicyTokenEphemeralPrivate{
+		Region:  region,
+		TokenID: result.Id,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode private data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralAccessPolicyTokenPrivateKey, private)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *accessPolicyTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("client not configured", "client not configured")
+		return
+	}
+
+	privateBytes, diags := req.Private.GetKey(ctx, ephemeralAccessPolicyTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var private accessPolicyTokenEphemeralPrivate
+	if err := json.Unmarshal(privateBytes, &private); err != nil {
+		resp.Diagnostics.AddError("Failed to decode private data", err.Error())
+		return
+	}
+
+	// The token may already have expired and been cleaned up
+	cfg := common.DefaultHTTPRequestRetryConfig()
+	cfg.Operation = "delete policy token"
+	cfg.ErrorAnalyzer = common.AcceptNotFound
+	if err := common.RetryHTTPRequest(ctx, cfg, func() (*http.Response, error) {
+		_, httpResp, execErr := r.client.TokensAPI.DeleteToken(ctx, private.TokenID).Region(private.Region).XRequestId(ClientRequestID()).Execute()
+		return httpResp, execErr
+	}); err != nil {
+		resp.Diagnostics.AddError("Failed to delete access policy token", err.Error())
+	}
+}
@@ -0,0 +1,90 @@
+package cloud_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/grafana/grafana-com-public-clients/go/gcom"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/testutils"
+)
+
+func TestAccAccessPolicyTokenEphemeral_basic(t *testing.T) {
+	t.Parallel()
+	testutils.CheckCloudAPITestsEnabled(t)
+
+	var policy gcom.AuthAccessPolicy
+	name := fmt.Sprintf("ephemeral-%s", acctest.RandStringFromCharSet(6, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCloudAccessPolicyCheckDestroy("prod-us-east-0", &policy),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyTokenEphemeralConfig(name, "prod-us-east-0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudAccessPolicyCheckExists("grafana_cloud_access_policy.test", &policy),
+					// The token was used by the aliased provider to read the organization
+					resource.TestCheckResourceAttr("data.grafana_cloud_organization.ephemeral", "slug", os.Getenv("GRAFANA_CLOUD_ORG")),
+					// The token is deleted once Terraform is done with it
+					checkAccessPolicyHasNoTokens("prod-us-east-0", &policy),
+				),
+			},
+		},
+	})
+}
+
+func checkAccessPolicyHasNoTokens(region string, policy *gcom.AuthAccessPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testutils.Provider.Meta().(*common.Client).GrafanaCloudAPI
+		resp, _, err := client.TokensAPI.GetTokens(context.Background()).Region(region).AccessPolicyId(*policy.Id).Execute()
+		if err != nil {
+			return fmt.Errorf("error listing the tokens of the access policy: %s", err)
+		}
+		if len(resp.Items) > 0 {
+			return fmt.Errorf("expected access policy %s to have no tokens, got %d", *policy.Id, len(resp.Items))
+		}
+		return nil
+	}
+}
+
+func testAccAccessPolicyTokenEphemeralConfig(name, region string) string {
+	return fmt.Sprintf(`
+data "grafana_cloud_organization" "current" {
+	slug = "%[3]s"
+}
+
+resource "grafana_cloud_access_policy" "test" {
+	region = "%[2]s"
+	name   = "%[1]s"
+	scopes = ["orgs:read"]
+
+	realm {
+		type       = "org"
+		identifier = data.grafana_cloud_organization.current.id
+	}
+}
+
+ephemeral "grafana_cloud_access_policy_token" "test" {
+	region           = "%[2]s"
+	access_policy_id = grafana_cloud_access_policy.test.policy_id
+	name             = "%[1]s"
+}
+
+provider "grafana" {
+	alias                     = "ephemeral"
+	cloud_access_policy_token = ephemeral.grafana_cloud_access_policy_token.test.token
+}
+
+data "grafana_cloud_organization" "ephemeral" {
+	provider = grafana.ephemeral
+	slug     = "%[3]s"
+}
+`, name, region, os.Getenv("GRAFANA_CLOUD_ORG"))
+}
@@ -176,6 +176,7 @@ Required access policy scopes:
 					return nil, nil
 				},
 			},
+			"labels_all": common.LabelsAllSchema("labels"),
 			"delete_protection": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -320,6 +321,7 @@ Required access policy scopes:
 			customdiff.ComputedIf("prometheus_name", func(_ context.Context, diff *schema.ResourceDiff, meta any) bool {
 				return diff.HasChange("slug")
 			}),
+			common.DiffLabelsAll("labels"),
 		),
 	}
 
@@ -355,7 +357,7 @@ func createStack(ctx context.Context, d *schema.ResourceData, client *gcom.APICl
 		Url:         *gcom.NewNullableString(common.Ref(d.Get("url").(string))),
 		Region:      d.Get("region_slug").(string),
 		Description: *gcom.NewNullableString(common.Ref(d.Get("description").(string))),
-		Labels:      common.Ref(common.UnpackMap[string](d.Get("labels"))),
+		Labels:      common.Ref(common.DefaultLabelsFromContext(ctx).Merge(common.UnpackMap[string](d.Get("labels")))),
 		// we set delete protection to false on the creation, that allows deleting a tainted resource
 		// should the creation fail partially.
 		DeleteProtection: *gcom.NewNullableBool(&falsePtr),
@@ -519,7 +521,7 @@ func updateStack(ctx context.Context, d *schema.ResourceData, client *gcom.APICl
 		Slug:             *gcom.NewNullableString(common.Ref(d.Get("slug").(string))),
 		Description:      *gcom.NewNullableString(common.Ref(d.Get("description").(string))),
 		Url:              *gcom.NewNullableString(&url),
-		Labels:           common.Ref(common.UnpackMap[string](d.Get("labels"))),
+		Labels:           common.Ref(common.DefaultLabelsFromContext(ctx).Merge(common.UnpackMap[string](d.Get("labels")))),
 		DeleteProtection: *gcom.NewNullableBool(common.Ref(d.Get("delete_protection").(bool))),
 	}
 	if err := common.RetryRequest(ctx, "update stack", func() (*http.Response, error) {
@@ -611,7 +613,7 @@ func readStack(ctx context.Context, d *schema.ResourceData, client *gcom.APIClie
 	ipAllowListCNAMByTenantType := ipAllowListCNAMByTenantType(legacyConnections.GetPrivateConnectivityInfo().Tenants)
 	allowlistURLByTenantType := allowlistURLByTenantType(legacyConnections.GetPrivateConnectivityInfo().Tenants)
 
-	if err := flattenStack(d, stack, connections, ipAllowListCNAMByTenantType, allowlistURLByTenantType); err != nil {
+	if err := flattenStack(ctx, d, stack, connections, ipAllowListCNAMByTenantType, allowlistURLByTenantType); err != nil {
 		return diag.FromErr(err)
 	}
 	// Always set the wait attribute to true after creation
@@ -625,6 +627,7 @@ func readStack(ctx context.Context, d *schema.ResourceData, client *gcom.APIClie
 }
 
 func flattenStack(
+	ctx context.Context,
 	d *schema.ResourceData,
 	stack *gcom.FormattedApiInstance,
 	connections *gcom.StackConnectionsV1,
@@ -650,7 +653,10 @@ func flattenStack(
 	d.Set("cluster_slug", stack.ClusterSlug)
 	d.Set("cluster_name", stack.ClusterName)
 	d.Set("description", stack.Description)
-	d.Set("labels", stack.Labels)
+	// The default labels which aren't set on the stack are hidden from its state
+	defaultLabels := common.DefaultLabelsFromContext(ctx)
+	d.Set("labels", defaultLabels.Strip(stack.GetLabels(), common.UnpackMap[string](d.Get("labels"))))
+	d.Set("labels_all", defaultLabels.All(stack.GetLabels(), common.UnpackMap[string](d.Get("labels")))) // Not in the data source
 	d.Set("delete_protection", stack.DeleteProtection)
 
 	d.Set("org_id", stack.OrgId)
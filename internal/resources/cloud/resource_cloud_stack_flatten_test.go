@@ -1,6 +1,8 @@
 package cloud
 
 import (
+	"context"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -52,7 +54,7 @@ func TestUnitFlattenStack_BasicStackFields(t *testing.T) {
 	connections := gcom.NewStackConnectionsV1([]gcom.StackConnectionTenantV1{})
 
 	d := schema.TestResourceDataRaw(t, resourceStack().Schema.Schema, map[string]any{})
-	if err := flattenStack(d, stack, connections, nil, nil); err != nil {
+	if err := flattenStack(context.Background(), d, stack, connections, nil, nil); err != nil {
 		t.Fatalf("flattenStack: %v", err)
 	}
 
@@ -75,6 +77,49 @@ func TestUnitFlattenStack_BasicStackFields(t *testing.T) {
 	requireEmptyPrivateConnectivityListsInStateForAll(t, d, privateConnectivityPrefixes...)
 }
 
+func TestUnitFlattenStack_DefaultLabels(t *testing.T) {
+	t.Parallel()
+
+	stack := &gcom.FormattedApiInstance{
+		Id:   12345,
+		Slug: "my-stack",
+		Labels: map[string]string{
+			"managed-by": "terraform",
+			"env":        "prod",
+			"team":       "changed-outside-terraform",
+			"app":        "my-app",
+		},
+	}
+	connections := gcom.NewStackConnectionsV1([]gcom.StackConnectionTenantV1{})
+	ctx := common.ContextWithDefaultLabels(context.Background(), common.DefaultLabels{
+		"managed-by": "terraform",
+		"env":        "prod",
+		"team":       "my-team",
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceStack().Schema.Schema, map[string]any{
+		"labels": map[string]any{"env": "prod"},
+	})
+	if err := flattenStack(ctx, d, stack, connections, nil, nil); err != nil {
+		t.Fatalf("flattenStack: %v", err)
+	}
+
+	// The default labels are hidden, unless they're set on the stack or were changed outside of Terraform
+	want := map[string]any{
+		"env":  "prod",
+		"team": "changed-outside-terraform",
+		"app":  "my-app",
+	}
+	if got := d.Get("labels"); !reflect.DeepEqual(got, want) {
+		t.Fatalf("labels: got %v, want %v", got, want)
+	}
+	// labels_all has all the labels of the stack
+	want["managed-by"] = "terraform"
+	if got := d.Get("labels_all"); !reflect.DeepEqual(got, want) {
+		t.Fatalf("labels_all: got %v, want %v", got, want)
+	}
+}
+
 func TestUnitFlattenStack_StackConnectionsV1(t *testing.T) {
 	t.Parallel()
 
@@ -151,7 +196,7 @@ func TestUnitFlattenStack_StackConnectionsV1(t *testing.T) {
 	}
 
 	d := schema.TestResourceDataRaw(t, resourceStack().Schema.Schema, map[string]any{})
-	if err := flattenStack(d, stack, connections, ipAllowListCNAMByTenantType, allowlistURLByTenantType); err != nil {
+	if err := flattenStack(context.Background(), d, stack, connections, ipAllowListCNAMByTenantType, allowlistURLByTenantType); err != nil {
 		t.Fatalf("flattenStack: %v", err)
 	}
 
@@ -261,7 +306,7 @@ func TestUnitFlattenStack_PrivateConnectivityLists(t *testing.T) {
 	}
 
 	d := schema.TestResourceDataRaw(t, resourceStack().Schema.Schema, map[string]any{})
-	if err := flattenStack(d, stack, connections, nil, nil); err != nil {
+	if err := flattenStack(context.Background(), d, stack, connections, nil, nil); err != nil {
 		t.Fatalf("flattenStack: %v", err)
 	}
 
@@ -340,7 +385,7 @@ func TestUnitFlattenStack_StackConnectionsV1_MissingAgentManagement(t *testing.T
 	}
 
 	d := schema.TestResourceDataRaw(t, resourceStack().Schema.Schema, map[string]any{})
-	if err := flattenStack(d, stack, connections, ipAllowListCNAMByTenantType, allowlistURLByTenantType); err != nil {
+	if err := flattenStack(context.Background(), d, stack, connections, ipAllowListCNAMByTenantType, allowlistURLByTenantType); err != nil {
 		t.Fatalf("flattenStack: %v", err)
 	}
 
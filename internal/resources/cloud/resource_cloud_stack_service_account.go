@@ -2,6 +2,7 @@ package cloud
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -175,14 +176,25 @@ func deleteStackServiceAccount(ctx context.Context, d *schema.ResourceData, clou
 	return diag.FromErr(err)
 }
 
-func CreateTemporaryStackGrafanaClient(ctx context.Context, cloudClient *gcom.APIClient, stackSlug, tempSaPrefix string) (*goapi.GrafanaHTTPAPI, func() error, error) {
+// TemporaryStackServiceAccount is an Admin service account of a stack, created to authenticate to the stack with a short-lived token.
+type TemporaryStackServiceAccount struct {
+	Stack *gcom.FormattedApiInstance
+	Token string
+
+	cloudClient *gcom.APIClient
+	id          int64
+}
+
+// CreateTemporaryStackServiceAccount creates a service account in the stack, with a token expiring after the given duration.
+// The service account must be deleted with Delete once it's no longer used.
+func CreateTemporaryStackServiceAccount(ctx context.Context, cloudClient *gcom.APIClient, stackSlug, tempSaPrefix string, tokenTTL time.Duration) (*TemporaryStackServiceAccount, error) {
 	var stack *gcom.FormattedApiInstance
 	if err := common.RetryRequest(ctx, "get stack instance", func() (*http.Response, error) {
 		s, httpResp, execErr := cloudClient.InstancesAPI.GetInstance(ctx, stackSlug).Execute()
 		stack = s
 		return httpResp, execErr
 	}); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	name := fmt.Sprintf("%s%d", tempSaPrefix, time.Now().UnixNano())
@@ -201,41 +213,72 @@ func CreateTemporaryStackGrafanaClient(ctx context.Context, cloudClient *gcom.AP
 		sa = s
 		return httpResp, execErr
 	}); err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	tempSA := &TemporaryStackServiceAccount{
+		Stack:       stack,
+		cloudClient: cloudClient,
+		id:          *sa.Id,
 	}
 
 	tokenRequest := gcom.PostInstanceServiceAccountTokensRequest{
 		Name:          name,
-		SecondsToLive: common.Ref(int32(60)),
+		SecondsToLive: common.Ref(int32(tokenTTL.Seconds())),
 	}
 	var token *gcom.GrafanaNewApiKeyResult
 	if err := common.RetryRequest(ctx, "create temporary stack service account token", func() (*http.Response, error) {
-		t, httpResp, execErr := cloudClient.InstancesAPI.PostInstanceServiceAccountTokens(ctx, stackSlug, fmt.Sprintf("%d", int(*sa.Id))).
+		t, httpResp, execErr := cloudClient.InstancesAPI.PostInstanceServiceAccountTokens(ctx, stackSlug, strconv.FormatInt(tempSA.id, 10)).
 			PostInstanceServiceAccountTokensRequest(tokenRequest).
 			XRequestId(ClientRequestID()).
 			Execute()
 		token = t
 		return httpResp, execErr
 	}); err != nil {
-		return nil, nil, err
+		return nil, errors.Join(err, tempSA.Delete(ctx))
+	}
+	tempSA.Token = *token.Key
+
+	return tempSA, nil
+}
+
+// Delete deletes the service account and its token.
+func (sa *TemporaryStackServiceAccount) Delete(ctx context.Context) error {
+	var httpResp *http.Response
+	err := common.RetryRequest(ctx, "delete temporary stack service account", func() (*http.Response, error) {
+		hr, execErr := sa.cloudClient.InstancesAPI.DeleteInstanceServiceAccount(ctx, sa.Stack.Slug, strconv.FormatInt(sa.id, 10)).
+			XRequestId(ClientRequestID()).
+			Execute()
+		httpResp = hr
+		return hr, execErr
+	})
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil
 	}
+	return err
+}
 
-	stackURLParsed, err := url.Parse(stack.Url)
+func CreateTemporaryStackGrafanaClient(ctx context.Context, cloudClient *gcom.APIClient, stackSlug, tempSaPrefix string) (*goapi.GrafanaHTTPAPI, func() error, error) {
+	sa, err := CreateTemporaryStackServiceAccount(ctx, cloudClient, stackSlug, tempSaPrefix, time.Minute)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	stackURLParsed, err := url.Parse(sa.Stack.Url)
+	if err != nil {
+		return nil, nil, errors.Join(err, sa.Delete(ctx))
+	}
+
 	client := goapi.NewHTTPClientWithConfig(nil, &goapi.TransportConfig{
 		Host:         stackURLParsed.Host,
 		Schemes:      []string{stackURLParsed.Scheme},
 		BasePath:     "api",
-		APIKey:       *token.Key,
+		APIKey:       sa.Token,
 		NumRetries:   5,
 		RetryTimeout: 10 * time.Second,
 	})
 
 	cleanup := func() error {
-		_, err := client.ServiceAccounts.DeleteServiceAccount(*sa.Id)
+		_, err := client.ServiceAccounts.DeleteServiceAccount(sa.id)
 		return err
 	}
 
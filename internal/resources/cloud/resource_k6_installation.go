@@ -99,12 +99,6 @@ The provider's ` + "`cloud_access_policy_token`" + ` needs the following scopes
 func resourceK6InstallationCreate(ctx context.Context, d *schema.ResourceData, cloudClient *gcom.APIClient) diag.Diagnostics {
 	k6ApiURL := getk6ApiURL(d)
 
-	url := fmt.Sprintf("%s/v3/account/grafana-app/start", k6ApiURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, nil)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	stackID, ok := d.Get("stack_id").(string)
 	if !ok || len(stackID) == 0 {
 		return diag.Errorf("the grafana_k6_installation must have a valid stack_id")
@@ -120,14 +114,44 @@ func resourceK6InstallationCreate(ctx context.Context, d *schema.ResourceData, c
 		return diag.Errorf("the grafana_k6_installation must have a valid grafana_user")
 	}
 
+	token, organizationID, err := InstallK6App(ctx, cloudClient.GetConfig().HTTPClient, cloudClient.GetConfig().UserAgent, k6ApiURL, stackID, grafanaServiceAccountToken, grafanaUser)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(organizationID)
+
+	if err := d.Set("k6_api_url", k6ApiURL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("k6_access_token", token); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("k6_organization", organizationID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceK6InstallationRead(ctx, d, cloudClient)
+}
+
+// InstallK6App sets up the k6 App on the stack, or gets the existing installation, and returns the k6 API token and organization ID.
+func InstallK6App(ctx context.Context, httpClient *http.Client, userAgent, k6ApiURL, stackID, grafanaServiceAccountToken, grafanaUser string) (string, string, error) {
+	url := fmt.Sprintf("%s/v3/account/grafana-app/start", k6ApiURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, nil)
+	if err != nil {
+		return "", "", err
+	}
+
 	req.Header.Set("X-Stack-Id", stackID)
 	req.Header.Set("X-Grafana-Service-Token", grafanaServiceAccountToken)
 	req.Header.Set("X-Grafana-User", grafanaUser)
-	req.Header.Set("User-Agent", cloudClient.GetConfig().UserAgent)
+	req.Header.Set("User-Agent", userAgent)
 
-	resp, err := cloudClient.GetConfig().HTTPClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
-		return diag.FromErr(err)
+		return "", "", err
 	}
 	defer func() {
 		_ = resp.Body.Close()
@@ -135,7 +159,7 @@ func resourceK6InstallationCreate(ctx context.Context, d *schema.ResourceData, c
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
-		return diag.Errorf("failed to install the k6 App: %s returned %s: %s", url, resp.Status, string(body))
+		return "", "", fmt.Errorf("failed to install the k6 App: %s returned %s: %s", url, resp.Status, string(body))
 	}
 
 	var installationRes struct {
@@ -143,26 +167,10 @@ func resourceK6InstallationCreate(ctx context.Context, d *schema.ResourceData, c
 		OrganizationID string `json:"organization_id"`
 	}
 
-	err = json.NewDecoder(resp.Body).Decode(&installationRes)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	d.SetId(installationRes.OrganizationID)
-
-	if err := d.Set("k6_api_url", k6ApiURL); err != nil {
-		return diag.FromErr(err)
-	}
-
-	if err := d.Set("k6_access_token", installationRes.V3GrafanaToken); err != nil {
-		return diag.FromErr(err)
+	if err := json.NewDecoder(resp.Body).Decode(&installationRes); err != nil {
+		return "", "", err
 	}
-
-	if err := d.Set("k6_organization", installationRes.OrganizationID); err != nil {
-		return diag.FromErr(err)
-	}
-
-	return resourceK6InstallationRead(ctx, d, cloudClient)
+	return installationRes.V3GrafanaToken, installationRes.OrganizationID, nil
 }
 
 // Update hook only records attribute changes in terraform state
@@ -21,9 +21,14 @@ var smAPIURLsExceptions = map[string]string{
 	"us-azure":        "https://synthetic-monitoring-api-us-central-7.grafana.net",
 }
 
-// createSMClient creates a new SMAPI client with proper client-id and client-version settings
-func createSMClient(apiURL, accessToken string) *SMAPI.Client {
-	client := SMAPI.NewClient(apiURL, accessToken, nil)
+// createSMClient creates a new SMAPI client with proper client-id and client-version settings. It sends its requests with the
+// HTTP client of the provider, to apply its read-only mode and audit log.
+func createSMClient(apiURL, accessToken string, meta any) *SMAPI.Client {
+	var httpClient *http.Client
+	if client, ok := meta.(*common.Client); ok {
+		httpClient = client.HTTPClient
+	}
+	client := SMAPI.NewClient(apiURL, accessToken, httpClient)
 	client.SetCustomClientID("terraform")
 	client.SetCustomClientVersion("unknown") // TODO: see if we can get the provider version here
 	return client
@@ -46,7 +51,7 @@ Required access policy scopes:
 
 * stacks:read
 `,
-		CreateContext: withClient[schema.CreateContextFunc](resourceInstallationCreate),
+		CreateContext: resourceInstallationCreate,
 		ReadContext:   resourceInstallationRead,
 		DeleteContext: resourceInstallationDelete,
 
@@ -87,7 +92,12 @@ Required access policy scopes:
 	)
 }
 
-func resourceInstallationCreate(ctx context.Context, d *schema.ResourceData, cloudClient *gcom.APIClient) diag.Diagnostics {
+func resourceInstallationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	cloudClient := meta.(*common.Client).GrafanaCloudAPI
+	if cloudClient == nil {
+		return diag.Errorf("the Cloud API client is required for this resource. Set the cloud_access_policy_token provider attribute")
+	}
+
 	var stack *gcom.FormattedApiInstance
 	if err := common.RetryRequest(ctx, "get stack instance", func() (*http.Response, error) {
 		s, httpResp, execErr := cloudClient.InstancesAPI.GetInstance(ctx, d.Get("stack_id").(string)).Execute()
@@ -107,7 +117,7 @@ func resourceInstallationCreate(ctx context.Context, d *schema.ResourceData, clo
 		apiURL = fmt.Sprintf("https://synthetic-monitoring-api-%s.grafana.net", strings.TrimPrefix(stack.RegionSlug, "prod-"))
 	}
 
-	smClient := createSMClient(apiURL, "")
+	smClient := createSMClient(apiURL, "", meta)
 	stackID, metricsID, logsID := int64(stack.Id), int64(stack.HmInstancePromId), int64(stack.HlInstanceId)
 	resp, err := smClient.Install(ctx, stackID, metricsID, logsID, d.Get("metrics_publisher_key").(string))
 	if err != nil {
@@ -116,14 +126,14 @@ func resourceInstallationCreate(ctx context.Context, d *schema.ResourceData, clo
 	d.SetId(fmt.Sprintf("%s;%d", apiURL, stackID))
 	d.Set("sm_access_token", resp.AccessToken)
 	d.Set("stack_sm_api_url", apiURL)
-	return resourceInstallationRead(ctx, d, nil)
+	return resourceInstallationRead(ctx, d, meta)
 }
 
 // Management of the installation is a one-off operation. The state cannot be updated through a read operation.
 // This read function will only invalidate the state (forcing recreation) if the installation has been deleted.
 func resourceInstallationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	apiURL := strings.Split(d.Id(), ";")[0]
-	tempClient := createSMClient(apiURL, d.Get("sm_access_token").(string))
+	tempClient := createSMClient(apiURL, d.Get("sm_access_token").(string), meta)
 	if err := tempClient.ValidateToken(ctx); err != nil {
 		return common.WarnMissing("synthetic monitoring installation", d)
 	}
@@ -133,7 +143,7 @@ func resourceInstallationRead(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceInstallationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	apiURL := strings.Split(d.Id(), ";")[0]
-	tempClient := createSMClient(apiURL, d.Get("sm_access_token").(string))
+	tempClient := createSMClient(apiURL, d.Get("sm_access_token").(string), meta)
 	err := tempClient.DeleteToken(ctx)
 	return diag.FromErr(err)
 }
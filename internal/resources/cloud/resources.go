@@ -27,3 +27,7 @@ var Resources = []*common.Resource{
 	resourcePDCNetwork(),
 	resourcePDCNetworkToken(),
 }
+
+var EphemeralResources = []*common.EphemeralResource{
+	ephemeralAccessPolicyToken(),
+}
@@ -34,7 +34,14 @@ func resourceCloudIntegration() *common.Resource {
 		resourceCloudIntegrationName,
 		resourceCloudIntegrationID,
 		&cloudIntegrationResource{},
-	)
+	).WithLister(listCloudIntegrations)
+}
+
+func listCloudIntegrations(ctx context.Context, client *common.Client, data any) ([]string, error) {
+	if client.CloudIntegrationsAPIClient == nil {
+		return nil, errors.New("client not configured for the Cloud Integrations API")
+	}
+	return client.CloudIntegrationsAPIClient.ListInstalledIntegrations(ctx)
 }
 
 type cloudIntegrationResourceModel struct {
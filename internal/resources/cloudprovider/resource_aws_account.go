@@ -43,7 +43,20 @@ func makeResourceAWSAccount() *common.Resource {
 		resourceAWSAccountTerraformName,
 		resourceAWSAccountTerraformID,
 		&resourceAWSAccount{},
-	)
+	).WithLister(cloudProviderListerFunction(listAWSAccounts))
+}
+
+func listAWSAccounts(ctx context.Context, client *cloudproviderapi.Client, stackID string) ([]string, error) {
+	accounts, err := client.ListAWSAccounts(ctx, stackID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		ids = append(ids, resourceAWSAccountTerraformID.Make(stackID, account.ID))
+	}
+	return ids, nil
 }
 
 func (r *resourceAWSAccount) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -39,7 +39,20 @@ func makeResourceAWSCloudWatchScrapeJob() *common.Resource {
 		"grafana_cloud_provider_aws_cloudwatch_scrape_job",
 		resourceAWSCloudWatchScrapeJobTerraformID,
 		&resourceAWSCloudWatchScrapeJob{},
-	)
+	).WithLister(cloudProviderListerFunction(listAWSCloudWatchScrapeJobs))
+}
+
+func listAWSCloudWatchScrapeJobs(ctx context.Context, client *cloudproviderapi.Client, stackID string) ([]string, error) {
+	jobs, err := client.ListAWSCloudWatchScrapeJobs(ctx, stackID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, resourceAWSCloudWatchScrapeJobTerraformID.Make(stackID, job.Name))
+	}
+	return ids, nil
 }
 
 func (r *resourceAWSCloudWatchScrapeJob) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -38,7 +38,20 @@ func makeResourceAWSResourceMetadataScrapeJob() *common.Resource {
 		"grafana_cloud_provider_aws_resource_metadata_scrape_job",
 		resourceAWSResourceMetadataScrapeJobTerraformID,
 		&resourceAWSResourceMetadataScrapeJob{},
-	)
+	).WithLister(cloudProviderListerFunction(listAWSResourceMetadataScrapeJobs))
+}
+
+func listAWSResourceMetadataScrapeJobs(ctx context.Context, client *cloudproviderapi.Client, stackID string) ([]string, error) {
+	jobs, err := client.ListAWSResourceMetadataScrapeJobs(ctx, stackID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, resourceAWSResourceMetadataScrapeJobTerraformID.Make(stackID, job.Name))
+	}
+	return ids, nil
 }
 
 func (r *resourceAWSResourceMetadataScrapeJob) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
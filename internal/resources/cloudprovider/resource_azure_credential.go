@@ -109,7 +109,20 @@ func makeResourceAzureCredential() *common.Resource {
 		resourceAzureCredentialTerraformName,
 		resourceAzureCredentialTerraformID,
 		&resourceAzureCredential{},
-	)
+	).WithLister(cloudProviderListerFunction(listAzureCredentials))
+}
+
+func listAzureCredentials(ctx context.Context, client *cloudproviderapi.Client, stackID string) ([]string, error) {
+	credentials, err := client.ListAzureCredentials(ctx, stackID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(credentials))
+	for _, credential := range credentials {
+		ids = append(ids, resourceAzureCredentialTerraformID.Make(stackID, credential.ID))
+	}
+	return ids, nil
 }
 
 func (r *resourceAzureCredential) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -1,7 +1,9 @@
 package cloudprovider
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common/cloudproviderapi"
@@ -70,3 +72,17 @@ func withClientForDataSource(req datasource.ConfigureRequest, resp *datasource.C
 
 	return client.CloudProviderAPI, nil
 }
+
+// cloudProviderListerFunction is a helper function that wraps a lister function to be used more easily in Cloud Provider resources.
+// The stack is taken from the provider's stack_id attribute.
+func cloudProviderListerFunction(listerFunc func(ctx context.Context, client *cloudproviderapi.Client, stackID string) ([]string, error)) common.ResourceListIDsFunc {
+	return func(ctx context.Context, client *common.Client, data any) ([]string, error) {
+		if client.CloudProviderAPI == nil {
+			return nil, fmt.Errorf("client not configured for the Cloud Provider API")
+		}
+		if client.GrafanaStackID == 0 {
+			return nil, fmt.Errorf("stack_id must be set in provider configuration for Cloud Provider resources")
+		}
+		return listerFunc(ctx, client.CloudProviderAPI, strconv.FormatInt(client.GrafanaStackID, 10))
+	}
+}
@@ -2,6 +2,8 @@ package connections
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
@@ -39,7 +41,29 @@ func makeResourceMetricsEndpointScrapeJob() *common.Resource {
 		resourceMetricsEndpointScrapeJobTerraformName,
 		resourceMetricsEndpointScrapeJobTerraformID,
 		&resourceMetricsEndpointScrapeJob{},
-	)
+	).WithLister(listMetricsEndpointScrapeJobs)
+}
+
+// listMetricsEndpointScrapeJobs lists the scrape jobs of the stack set in the provider's stack_id attribute.
+func listMetricsEndpointScrapeJobs(ctx context.Context, client *common.Client, data any) ([]string, error) {
+	if client.ConnectionsAPIClient == nil {
+		return nil, fmt.Errorf("client not configured for the Connections API")
+	}
+	if client.GrafanaStackID == 0 {
+		return nil, fmt.Errorf("stack_id must be set in provider configuration for Connections resources")
+	}
+
+	stackID := strconv.FormatInt(client.GrafanaStackID, 10)
+	jobs, err := client.ConnectionsAPIClient.ListMetricsEndpointScrapeJobs(ctx, stackID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, resourceMetricsEndpointScrapeJobTerraformID.Make(stackID, job.Name))
+	}
+	return ids, nil
 }
 
 func (r *resourceMetricsEndpointScrapeJob) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -22,10 +22,11 @@ type collectorDataSourcesModel struct {
 }
 
 type collectorResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	RemoteAttributes types.Map    `tfsdk:"remote_attributes"`
-	Enabled          types.Bool   `tfsdk:"enabled"`
-	CollectorType    types.String `tfsdk:"collector_type"`
+	ID                  types.String `tfsdk:"id"`
+	RemoteAttributes    types.Map    `tfsdk:"remote_attributes"`
+	RemoteAttributesAll types.Map    `tfsdk:"remote_attributes_all"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	CollectorType       types.String `tfsdk:"collector_type"`
 }
 
 func collectorMessageToDataSourceModel(ctx context.Context, msg *collectorv1.Collector) (*collectorDataSourceModel, diag.Diagnostics) {
@@ -55,10 +56,11 @@ func collectorMessageToResourceModel(ctx context.Context, msg *collectorv1.Colle
 	}
 
 	return &collectorResourceModel{
-		ID:               types.StringValue(msg.Id),
-		RemoteAttributes: remoteAttributes,
-		Enabled:          types.BoolPointerValue(msg.Enabled),
-		CollectorType:    types.StringValue(collectorTypeToString(msg.CollectorType)),
+		ID:                  types.StringValue(msg.Id),
+		RemoteAttributes:    remoteAttributes,
+		RemoteAttributesAll: remoteAttributes,
+		Enabled:             types.BoolPointerValue(msg.Enabled),
+		CollectorType:       types.StringValue(collectorTypeToString(msg.CollectorType)),
 	}, nil
 }
 
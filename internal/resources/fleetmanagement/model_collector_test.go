@@ -69,17 +69,19 @@ func TestCollectorMessageToResourceModel(t *testing.T) {
 		CollectorType: collectorv1.CollectorType_COLLECTOR_TYPE_ALLOY,
 	}
 
+	remoteAttributes := types.MapValueMust(
+		types.StringType,
+		map[string]attr.Value{
+			"key1": types.StringValue("value1"),
+			"key2": types.StringValue("value2"),
+		},
+	)
 	expectedModel := &collectorResourceModel{
-		ID: types.StringValue(id),
-		RemoteAttributes: types.MapValueMust(
-			types.StringType,
-			map[string]attr.Value{
-				"key1": types.StringValue("value1"),
-				"key2": types.StringValue("value2"),
-			},
-		),
-		Enabled:       types.BoolPointerValue(&enabled),
-		CollectorType: types.StringValue("ALLOY"),
+		ID:                  types.StringValue(id),
+		RemoteAttributes:    remoteAttributes,
+		RemoteAttributesAll: remoteAttributes,
+		Enabled:             types.BoolPointerValue(&enabled),
+		CollectorType:       types.StringValue("ALLOY"),
 	}
 
 	ctx := context.Background()
@@ -2,6 +2,7 @@ package fleetmanagement
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"connectrpc.com/connect"
@@ -10,6 +11,8 @@ import (
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -34,6 +37,7 @@ var (
 	_ resource.Resource                = &collectorResource{}
 	_ resource.ResourceWithConfigure   = &collectorResource{}
 	_ resource.ResourceWithImportState = &collectorResource{}
+	_ resource.ResourceWithModifyPlan  = &collectorResource{}
 )
 
 type collectorResource struct {
@@ -52,7 +56,24 @@ func newCollectorResource() *common.Resource {
 		collectorTypeName,
 		collectorResourceID,
 		&collectorResource{},
-	)
+	).WithLister(listCollectors)
+}
+
+func listCollectors(ctx context.Context, client *common.Client, _ any) ([]string, error) {
+	if client.FleetManagementClient == nil {
+		return nil, errors.New("client not configured for Fleet Management API")
+	}
+
+	listResp, err := client.FleetManagementClient.CollectorServiceClient.ListCollectors(ctx, connect.NewRequest(&collectorv1.ListCollectorsRequest{}))
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, collector := range listResp.Msg.Collectors {
+		ids = append(ids, collector.GetId())
+	}
+	return ids, nil
 }
 
 func (r *collectorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -68,6 +89,51 @@ func (r *collectorResource) Configure(ctx context.Context, req resource.Configur
 	r.client = client.CollectorServiceClient
 }
 
+// collectorState returns the state of the collector, without the default labels which aren't in the configured remote attributes.
+// All the remote attributes are in remote_attributes_all.
+func (r *collectorResource) collectorState(ctx context.Context, collector *collectorv1.Collector, configured types.Map) (*collectorResourceModel, diag.Diagnostics) {
+	state, diags := collectorMessageToResourceModel(ctx, collector)
+	defaultLabels := common.DefaultLabelsFromContext(ctx)
+	if diags.HasError() || len(defaultLabels) == 0 {
+		return state, diags
+	}
+
+	configuredAttributes, diags := tfStringMapToNativeStringMap(ctx, configured)
+	if diags.HasError() {
+		return nil, diags
+	}
+	stripped := defaultLabels.Strip(collector.RemoteAttributes, configuredAttributes)
+	if state.RemoteAttributes, diags = nativeStringMapToTFStringMap(ctx, stripped); diags.HasError() {
+		return nil, diags
+	}
+	state.RemoteAttributesAll, diags = nativeStringMapToTFStringMap(ctx, defaultLabels.All(collector.RemoteAttributes, stripped))
+	return state, diags
+}
+
+// ModifyPlan plans the remote_attributes_all attribute from the remote attributes and the default labels, see common.DefaultLabels.
+func (r *collectorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var remoteAttributes types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("remote_attributes"), &remoteAttributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	known := !remoteAttributes.IsUnknown()
+	for _, value := range remoteAttributes.Elements() {
+		known = known && !value.IsUnknown()
+	}
+	planned := types.MapUnknown(types.StringType)
+	if known {
+		attributes, diags := tfStringMapToNativeStringMap(ctx, remoteAttributes)
+		resp.Diagnostics.Append(diags...)
+		planned, diags = nativeStringMapToTFStringMap(ctx, common.DefaultLabelsFromContext(ctx).Merge(attributes))
+		resp.Diagnostics.Append(diags...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("remote_attributes_all"), planned)...)
+}
+
 func (r *collectorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = collectorTypeName
 }
@@ -101,6 +167,12 @@ Required access policy scopes:
 				ElementType: types.StringType,
 				Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
 			},
+			"remote_attributes_all": schema.MapAttribute{
+				Description: "All the remote attributes of the collector: the `remote_attributes` and the `default_labels` of the provider. " +
+					"Changing the `default_labels` of the provider updates the collector.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"enabled": schema.BoolAttribute{
 				Description: "Whether remote configuration for the collector is enabled or not. If the collector is disabled, " +
 					"it will receive empty configurations from the Fleet Management service",
@@ -134,7 +206,7 @@ func (r *collectorResource) ImportState(ctx context.Context, req resource.Import
 	// Invalidate cache after import to ensure subsequent reads get fresh data
 	r.resetCache()
 
-	state, diags := collectorMessageToResourceModel(ctx, getResp.Msg)
+	state, diags := r.collectorState(ctx, getResp.Msg, types.MapNull(types.StringType))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -160,6 +232,7 @@ func (r *collectorResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	collector.RemoteAttributes = common.DefaultLabelsFromContext(ctx).Merge(collector.RemoteAttributes)
 
 	createReq := &collectorv1.CreateCollectorRequest{
 		Collector: collector,
@@ -182,7 +255,7 @@ func (r *collectorResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	state, diags := collectorMessageToResourceModel(ctx, getResp.Msg)
+	state, diags := r.collectorState(ctx, getResp.Msg, data.RemoteAttributes)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -237,7 +310,7 @@ func (r *collectorResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	state, diags := collectorMessageToResourceModel(ctx, collector)
+	state, diags := r.collectorState(ctx, collector, data.RemoteAttributes)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -260,6 +333,7 @@ func (r *collectorResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	collector.RemoteAttributes = common.DefaultLabelsFromContext(ctx).Merge(collector.RemoteAttributes)
 
 	updateReq := &collectorv1.UpdateCollectorRequest{
 		Collector: collector,
@@ -282,7 +356,7 @@ func (r *collectorResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	state, diags := collectorMessageToResourceModel(ctx, getResp.Msg)
+	state, diags := r.collectorState(ctx, getResp.Msg, data.RemoteAttributes)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -2,6 +2,7 @@ package fleetmanagement
 
 import (
 	"context"
+	"errors"
 
 	"connectrpc.com/connect"
 	pipelinev1 "github.com/grafana/fleet-management-api/api/gen/proto/go/pipeline/v1"
@@ -46,7 +47,25 @@ func newPipelineResource() *common.Resource {
 		pipelineTypeName,
 		pipelineResourceID,
 		&pipelineResource{},
-	)
+	).WithLister(listPipelines)
+}
+
+func listPipelines(ctx context.Context, client *common.Client, _ any) ([]string, error) {
+	if client.FleetManagementClient == nil {
+		return nil, errors.New("client not configured for Fleet Management API")
+	}
+
+	listResp, err := client.FleetManagementClient.PipelineServiceClient.ListPipelines(ctx, connect.NewRequest(&pipelinev1.ListPipelinesRequest{}))
+	if err != nil {
+		return nil, err
+	}
+
+	// Pipelines are imported by name
+	var ids []string
+	for _, pipeline := range listResp.Msg.Pipelines {
+		ids = append(ids, pipeline.GetName())
+	}
+	return ids, nil
 }
 
 func (r *pipelineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -23,11 +23,12 @@ import (
 	"github.com/grafana/grafana-com-public-clients/go/gcom"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common/frontendo11yapi"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/cloud"
 )
 
 var (
 	resourceFrontendO11yAppName        = "grafana_frontend_o11y_app"
-	resourceFrontendO11yAppTerraformID = common.NewResourceID(common.StringIDField("stack_id"), common.StringIDField("name"))
+	resourceFrontendO11yAppTerraformID = common.NewResourceID(common.StringIDField("stack_id"), common.IntIDField("id"))
 
 	// Check interface
 	_ resource.ResourceWithImportState = (*resourceFrontendO11yApp)(nil)
@@ -44,7 +45,52 @@ func makeResourceFrontendO11yApp() *common.Resource {
 		resourceFrontendO11yAppName,
 		resourceFrontendO11yAppTerraformID,
 		&resourceFrontendO11yApp{},
-	)
+	).WithLister(listFrontendO11yApps)
+}
+
+// listFrontendO11yApps lists the apps of the stacks of the organization when generating the Grafana Cloud resources,
+// or of the stack set in the provider's stack_id attribute otherwise.
+// The IDs are in the `stackID:appID` format expected by ImportState.
+func listFrontendO11yApps(ctx context.Context, client *common.Client, data any) ([]string, error) {
+	if client.FrontendO11yAPIClient == nil || client.GrafanaCloudAPI == nil {
+		return nil, errors.New("client not configured for the Frontend Observability API")
+	}
+
+	r := &resourceFrontendO11yApp{client: client.FrontendO11yAPIClient, gcomClient: client.GrafanaCloudAPI}
+	var stacks []gcom.FormattedApiInstance
+	if cloudData, ok := data.(*cloud.ListerData); ok {
+		var err error
+		if stacks, err = cloudData.Stacks(ctx, client.GrafanaCloudAPI); err != nil {
+			return nil, err
+		}
+	} else {
+		if client.GrafanaStackID == 0 {
+			return nil, errors.New("stack_id must be set in provider configuration for Frontend Observability resources")
+		}
+		stack, err := r.getStack(ctx, strconv.FormatInt(client.GrafanaStackID, 10))
+		if err != nil {
+			return nil, err
+		}
+		stacks = append(stacks, *stack)
+	}
+
+	var ids []string
+	for _, stack := range stacks {
+		createdAt, err := time.Parse(time.RFC3339, stack.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		apps, err := r.client.GetApps(ctx, r.client.FaroEndpointURL(stack.RegionSlug, createdAt), int64(stack.Id))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, app := range apps {
+			ids = append(ids, fmt.Sprintf("%d:%d", int64(stack.Id), app.ID))
+		}
+	}
+	return ids, nil
 }
 
 func (r *resourceFrontendO11yApp) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -15,10 +15,19 @@ import (
 type ListerData struct {
 	omitSingleOrgID bool
 	singleOrg       bool
+	filter          ListerFilter
 	orgIDs          []int64
 	orgsInit        sync.Once
 }
 
+// ListerFilter narrows the IDs returned by the listers which support it, with the filters of their API.
+// The listers may return more IDs: the ones which don't support a filter ignore it.
+type ListerFilter struct {
+	Name   string // Glob pattern of the names, see path.Match
+	Folder string // UID of the folder
+	Tag    string
+}
+
 func NewListerData(singleOrg, omitSingleOrgID bool) *ListerData {
 	return &ListerData{
 		singleOrg:       singleOrg,
@@ -26,6 +35,12 @@ func NewListerData(singleOrg, omitSingleOrgID bool) *ListerData {
 	}
 }
 
+// WithFilter sets the filter given to the listers which support it.
+func (ld *ListerData) WithFilter(filter ListerFilter) *ListerData {
+	ld.filter = filter
+	return ld
+}
+
 func (ld *ListerData) OrgIDs(client *goapi.GrafanaHTTPAPI) ([]int64, error) {
 	if ld.singleOrg {
 		return []int64{0}, nil
@@ -59,6 +74,7 @@ func (ld *ListerData) OrgIDs(client *goapi.GrafanaHTTPAPI) ([]int64, error) {
 
 type grafanaListerFunc func(ctx context.Context, client *goapi.GrafanaHTTPAPI, data *ListerData) ([]string, error)
 type grafanaOrgResourceListerFunc func(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error)
+type grafanaFilteredOrgResourceListerFunc func(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64, filter ListerFilter) ([]string, error)
 
 // listerFunction is a helper function that wraps a lister function be used more easily in grafana resources.
 func listerFunction(listerFunc grafanaListerFunc) common.ResourceListIDsFunc {
@@ -75,6 +91,13 @@ func listerFunction(listerFunc grafanaListerFunc) common.ResourceListIDsFunc {
 }
 
 func listerFunctionOrgResource(listerFunc grafanaOrgResourceListerFunc) common.ResourceListIDsFunc {
+	return listerFunctionFilteredOrgResource(func(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64, _ ListerFilter) ([]string, error) {
+		return listerFunc(ctx, client, orgID)
+	})
+}
+
+// listerFunctionFilteredOrgResource is listerFunctionOrgResource for the listers which support the filter of the ListerData.
+func listerFunctionFilteredOrgResource(listerFunc grafanaFilteredOrgResourceListerFunc) common.ResourceListIDsFunc {
 	return listerFunction(func(ctx context.Context, client *goapi.GrafanaHTTPAPI, data *ListerData) ([]string, error) {
 		orgIDs, err := data.OrgIDs(client)
 		if err != nil {
@@ -83,7 +106,7 @@ func listerFunctionOrgResource(listerFunc grafanaOrgResourceListerFunc) common.R
 
 		var ids []string
 		for _, orgID := range orgIDs {
-			idsInOrg, err := listerFunc(ctx, client.Clone().WithOrgID(orgID), orgID)
+			idsInOrg, err := listerFunc(ctx, client.Clone().WithOrgID(orgID), orgID, data.filter)
 			if err != nil {
 				return nil, err
 			}
@@ -101,3 +124,39 @@ func listerFunctionOrgResource(listerFunc grafanaOrgResourceListerFunc) common.R
 		return ids, nil
 	})
 }
+
+// globLiteral returns the longest part of the glob pattern without wildcards (see path.Match), which the matching names contain.
+// It's used as the query of the search APIs, which match the names containing it.
+func globLiteral(pattern string) string {
+	var longest, current strings.Builder
+	flush := func() {
+		if current.Len() > longest.Len() {
+			longest.Reset()
+			longest.WriteString(current.String())
+		}
+		current.Reset()
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*', '?':
+			flush()
+		case '[':
+			flush()
+			for i < len(pattern) && pattern[i] != ']' {
+				if pattern[i] == '\\' {
+					i++
+				}
+				i++
+			}
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+				current.WriteByte(pattern[i])
+			}
+		default:
+			current.WriteByte(c)
+		}
+	}
+	flush()
+	return longest.String()
+}
@@ -0,0 +1,69 @@
+package grafana
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+func TestGlobLiteral(t *testing.T) {
+	for pattern, expected := range map[string]string{
+		"":              "",
+		"prod":          "prod",
+		"prod-*":        "prod-",
+		"*-api-?":       "-api-",
+		"a*[prod]*bc":   "bc",
+		`team\*a*`:      "team*a",
+		"[a-z]*":        "",
+		`[\]x]prod*`:    "prod",
+		"dev-*-staging": "-staging",
+	} {
+		if got := globLiteral(pattern); got != expected {
+			t.Errorf("globLiteral(%q) = %q, want %q", pattern, got, expected)
+		}
+	}
+}
+
+// The filters of the list resources are given to the search API, which lists the dashboards and folders.
+func TestListerFilter(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/search" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		queries = append(queries, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"uid":"test"}]`))
+	}))
+	defer server.Close()
+
+	client := &common.Client{GrafanaAPI: testGrafanaClient(t, server.URL)}
+	filter := ListerFilter{Name: "prod-*", Folder: "f1", Tag: "team-a"}
+	for _, r := range []*common.Resource{resourceDashboard(), resourceFolder()} {
+		ids, err := r.ListIDsFunc(context.Background(), client, NewListerData(true, true).WithFilter(filter))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", r.Name, err)
+		}
+		if !slices.Equal(ids, []string{"test"}) {
+			t.Fatalf("%s: got IDs %v", r.Name, ids)
+		}
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("got %d searches, want 2", len(queries))
+	}
+	// The name of the dashboards is their ID, it isn't searched
+	if got := queries[0]; got.Get("type") != "dash-db" || got.Get("folderUIDs") != "f1" || got.Get("tag") != "team-a" || got.Has("query") {
+		t.Errorf("unexpected dashboard search: %v", got)
+	}
+	// The folders have no folder or tag filter
+	if got := queries[1]; got.Get("type") != "dash-folder" || got.Get("query") != "prod-" || got.Has("folderUIDs") || got.Has("tag") {
+		t.Errorf("unexpected folder search: %v", got)
+	}
+}
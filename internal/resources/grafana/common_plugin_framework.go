@@ -8,6 +8,7 @@ import (
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	frameworkSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -53,7 +54,7 @@ func (r *basePluginFrameworkDataSource) Configure(ctx context.Context, req datas
 
 // clientFromNewOrgResource creates an OpenAPI client from the `org_id` attribute of a resource
 // This client is meant to be used in `Create` functions when the ID hasn't already been baked into the resource ID
-func (r *basePluginFrameworkDataSource) clientFromNewOrgResource(orgIDStr string) (*goapi.GrafanaHTTPAPI, int64, error) {
+func (r *basePluginFrameworkDataSource) clientFromNewOrgResource(ctx context.Context, orgIDStr string) (*goapi.GrafanaHTTPAPI, int64, error) {
 	if r.client == nil {
 		return nil, 0, fmt.Errorf("client not configured")
 	}
@@ -65,7 +66,7 @@ func (r *basePluginFrameworkDataSource) clientFromNewOrgResource(orgIDStr string
 	} else if orgID > 0 {
 		client = client.WithOrgID(orgID)
 	}
-	return client, orgID, nil
+	return common.OAPIClientWithContext(ctx, client), orgID, nil
 }
 
 type basePluginFrameworkResource struct {
@@ -107,7 +108,7 @@ func (r *basePluginFrameworkResource) Configure(ctx context.Context, req resourc
 
 // clientFromExistingOrgResource creates a client from the ID of an org-scoped resource
 // Those IDs are in the <orgID>:<resourceID> format
-func (r *basePluginFrameworkResource) clientFromExistingOrgResource(idFormat *common.ResourceID, id string) (*goapi.GrafanaHTTPAPI, int64, []any, error) {
+func (r *basePluginFrameworkResource) clientFromExistingOrgResource(ctx context.Context, idFormat *common.ResourceID, id string) (*goapi.GrafanaHTTPAPI, int64, []any, error) {
 	if r.client == nil {
 		return nil, 0, nil, fmt.Errorf("client not configured")
 	}
@@ -125,12 +126,12 @@ func (r *basePluginFrameworkResource) clientFromExistingOrgResource(idFormat *co
 		split = split[1:]
 		client = client.WithOrgID(orgID)
 	}
-	return client, orgID, split, nil
+	return common.OAPIClientWithContext(ctx, client), orgID, split, nil
 }
 
 // clientFromNewOrgResource creates an OpenAPI client from the `org_id` attribute of a resource
 // This client is meant to be used in `Create` functions when the ID hasn't already been baked into the resource ID
-func (r *basePluginFrameworkResource) clientFromNewOrgResource(orgIDStr string) (*goapi.GrafanaHTTPAPI, int64, error) {
+func (r *basePluginFrameworkResource) clientFromNewOrgResource(ctx context.Context, orgIDStr string) (*goapi.GrafanaHTTPAPI, int64, error) {
 	if r.client == nil {
 		return nil, 0, fmt.Errorf("client not configured")
 	}
@@ -142,7 +143,42 @@ func (r *basePluginFrameworkResource) clientFromNewOrgResource(orgIDStr string)
 	} else if orgID > 0 {
 		client = client.WithOrgID(orgID)
 	}
-	return client, orgID, nil
+	return common.OAPIClientWithContext(ctx, client), orgID, nil
+}
+
+type basePluginFrameworkEphemeralResource struct {
+	client *goapi.GrafanaHTTPAPI
+	config *goapi.TransportConfig
+}
+
+func (r *basePluginFrameworkEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Configure is called multiple times (sometimes when ProviderData is not yet available), we only want to configure once
+	if req.ProviderData == nil || r.client != nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*common.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *common.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if client.GrafanaAPI == nil || client.GrafanaAPIConfig == nil {
+		resp.Diagnostics.AddError(
+			"The Grafana Provider is missing a configuration for the Grafana API.",
+			"Please ensure that url and auth are set in the provider configuration.",
+		)
+
+		return
+	}
+
+	r.client = client.GrafanaAPI
+	r.config = client.GrafanaAPIConfig
 }
 
 // To be used in non-org-scoped resources
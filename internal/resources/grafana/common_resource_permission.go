@@ -4,6 +4,7 @@
 package grafana
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
@@ -104,8 +105,8 @@ func (r *resourcePermissionBase) addInSchemaAttributes(attributes map[string]sch
 	return attributes
 }
 
-func (r *resourcePermissionBase) readItem(id string, checkExistsFunc func(client *client.GrafanaHTTPAPI, itemID string) error, getClientOpts []access_control.ClientOption) (*resourcePermissionItemBaseModel, diag.Diagnostics) {
-	client, orgID, splitID, err := r.clientFromExistingOrgResource(resourceFolderPermissionItemID, id)
+func (r *resourcePermissionBase) readItem(ctx context.Context, id string, checkExistsFunc func(client *client.GrafanaHTTPAPI, itemID string) error, getClientOpts []access_control.ClientOption) (*resourcePermissionItemBaseModel, diag.Diagnostics) {
+	client, orgID, splitID, err := r.clientFromExistingOrgResource(ctx, resourceFolderPermissionItemID, id)
 	if err != nil {
 		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Unable to parse resource ID", err.Error())}
 	}
@@ -166,8 +167,8 @@ func (r *resourcePermissionBase) readItem(id string, checkExistsFunc func(client
 	return nil, nil
 }
 
-func (r *resourcePermissionBase) writeItem(itemID string, data *resourcePermissionItemBaseModel, extraOpts ...access_control.ClientOption) diag.Diagnostics {
-	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+func (r *resourcePermissionBase) writeItem(ctx context.Context, itemID string, data *resourcePermissionItemBaseModel, extraOpts ...access_control.ClientOption) diag.Diagnostics {
+	client, orgID, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get client", err.Error())}
 	}
@@ -296,6 +297,35 @@ func bulkPermissionsSchemaAttribute(description string, permissionValues []strin
 	}
 }
 
+// hasCustomPermissions returns whether the given resource has managed permissions other than the ones Grafana grants by default:
+// the Editor and Viewer basic roles, and Admin for the user or service account that created the resource.
+// It is used by the listers of the permissions resources, so that a permissions resource is not generated for every single folder or dashboard.
+func hasCustomPermissions(client *client.GrafanaHTTPAPI, resourceType, resourceUID string, opts ...access_control.ClientOption) (bool, error) {
+	resp, err := client.AccessControl.GetResourcePermissions(resourceUID, resourceType, opts...)
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	creatorSeen := false
+	for _, perm := range resp.Payload {
+		if !perm.IsManaged || perm.IsInherited {
+			continue
+		}
+		switch {
+		case perm.BuiltInRole == "Editor" && (perm.Permission == "Edit" || perm.Permission == "Query"),
+			perm.BuiltInRole == "Viewer" && (perm.Permission == "View" || perm.Permission == "Query"):
+		case perm.UserID != 0 && perm.Permission == "Admin" && !creatorSeen:
+			creatorSeen = true
+		default:
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // readBulkPermissions fetches the current permissions from the API.
 // team_id and user_id are returned as plain local IDs (no org prefix); the
 // stripOrgScopedIDPlanModifier ensures plan values are normalized to the same format.
@@ -22,10 +22,10 @@ type resourcePermissionsHelper struct {
 
 	// Given the resource data, check the resource exists and return the correct ID for permissions.
 	// Ex: We support ID and UID for dashboards but the permissions are managed by UID.
-	getResource func(d *schema.ResourceData, meta any) (string, error)
+	getResource func(ctx context.Context, d *schema.ResourceData, meta any) (string, error)
 
 	// buildClientOptions, if set, returns extra ClientOptions for SetResourcePermissions (writes).
-	buildClientOptions func(d *schema.ResourceData, meta any) ([]access_control.ClientOption, error)
+	buildClientOptions func(ctx context.Context, d *schema.ResourceData, meta any) ([]access_control.ClientOption, error)
 
 	// listPermissionsClientOpts, if set, returns extra ClientOptions for GetResourcePermissions (list / read).
 	listPermissionsClientOpts func(d *schema.ResourceData) []access_control.ClientOption
@@ -106,9 +106,9 @@ func (h *resourcePermissionsHelper) addCommonSchemaAttributes(s map[string]*sche
 }
 
 func (h *resourcePermissionsHelper) updatePermissions(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
-	resourceID, err := h.getResource(d, meta)
+	resourceID, err := h.getResource(ctx, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -141,7 +141,7 @@ func (h *resourcePermissionsHelper) updatePermissions(ctx context.Context, d *sc
 	listOpts := h.listPermissionsQueryOpts(d)
 	var setOpts []access_control.ClientOption
 	if h.buildClientOptions != nil {
-		setOpts, err = h.buildClientOptions(d, meta)
+		setOpts, err = h.buildClientOptions(ctx, d, meta)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -157,10 +157,10 @@ func (h *resourcePermissionsHelper) updatePermissions(ctx context.Context, d *sc
 }
 
 func (h *resourcePermissionsHelper) readPermissions(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID, resourceID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, resourceID := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	// Check if the resource still exists
-	_, err := h.getResource(d, meta)
+	_, err := h.getResource(ctx, d, meta)
 	if err, shouldReturn := common.CheckReadError("resource", d, err); shouldReturn {
 		return err
 	}
@@ -199,12 +199,12 @@ func (h *resourcePermissionsHelper) deletePermissions(ctx context.Context, d *sc
 	// since permissions are tied to the resource, we can't really delete the permissions.
 	// we will simply remove all permissions, leaving a resource that only an admin can access.
 	// if for some reason the resource doesn't exist, we'll just ignore the error
-	client, _, resourceID := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, resourceID := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	listOpts := h.listPermissionsQueryOpts(d)
 	var setOpts []access_control.ClientOption
 	var err error
 	if h.buildClientOptions != nil {
-		setOpts, err = h.buildClientOptions(d, meta)
+		setOpts, err = h.buildClientOptions(ctx, d, meta)
 		if err != nil {
 			return diag.FromErr(err)
 		}
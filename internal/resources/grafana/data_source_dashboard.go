@@ -78,7 +78,7 @@ func datasourceDashboard() *common.DataSource {
 
 func dataSourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	metaClient := meta.(*common.Client)
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	// get UID from ID if specified
 	id := d.Get("dashboard_id").(int)
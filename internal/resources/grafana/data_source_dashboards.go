@@ -66,7 +66,7 @@ Datasource for retrieving all dashboards. Specify list of folder IDs to search i
 }
 
 func dataSourceReadDashboards(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	limit := int64(d.Get("limit").(int))
 	searchType := "dash-db"
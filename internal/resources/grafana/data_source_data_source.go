@@ -35,7 +35,7 @@ func datasourceDatasource() *common.DataSource {
 }
 
 func datasourceDatasourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _ := OAPIClientFromNewOrgResource(meta, d)
+	client, _ := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	var resp interface{ GetPayload() *models.DataSource }
 	var err error
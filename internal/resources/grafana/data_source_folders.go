@@ -85,7 +85,7 @@ func listAllFolders(client *goapi.GrafanaHTTPAPI) ([]*models.Hit, error) {
 
 func readFolders(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	metaClient := meta.(*common.Client)
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	folders, err := listAllFolders(client)
 	if err != nil {
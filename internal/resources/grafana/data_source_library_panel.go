@@ -30,7 +30,7 @@ func datasourceLibraryPanel() *common.DataSource {
 }
 
 func dataSourceLibraryPanelRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	uid := d.Get("uid").(string)
 
 	if uid == "" {
@@ -74,7 +74,7 @@ func (r *libraryPanelsDataSource) Read(ctx context.Context, req datasource.ReadR
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	// Read from API
-	client, _, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	client, _, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics = diag.Diagnostics{diag.NewErrorDiagnostic("Failed to create client", err.Error())}
 		return
@@ -54,7 +54,7 @@ func datasourceOrganization() *common.DataSource {
 }
 
 func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := OAPIGlobalClient(meta)
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -24,7 +24,7 @@ func datasourceOrganizationPreferences() *common.DataSource {
 }
 
 func dataSourceOrganizationPreferencesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	resp, err := client.Org.GetOrgPreferences()
 	if err != nil {
 		return diag.FromErr(err)
@@ -43,7 +43,7 @@ func datasourceOrganizationUser() *common.DataSource {
 }
 
 func dataSourceOrganizationUserRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	var resp interface {
 		GetPayload() []*models.OrgUserDTO
@@ -48,7 +48,7 @@ func datasourceServiceAccount() *common.DataSource {
 }
 
 func datasourceServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	name := d.Get("name").(string)
 	sa, err := findServiceAccountByName(client, name)
 	if err != nil {
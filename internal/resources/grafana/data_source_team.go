@@ -144,7 +144,7 @@ func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	client, orgID, err := d.clientFromNewOrgResource(data.OrgID.ValueString())
+	client, orgID, err := d.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -56,7 +56,7 @@ This data source is also not compatible with Grafana Cloud, as it does not allow
 }
 
 func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := OAPIGlobalClient(meta) // Users are global/org-agnostic
+	client, err := OAPIGlobalClient(ctx, meta) // Users are global/org-agnostic
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,190 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+const (
+	ephemeralServiceAccountTokenName = "grafana_service_account_token"
+
+	// Tokens minted by the ephemeral resource always expire, so that they do not outlive the run if Close is never called.
+	ephemeralServiceAccountTokenDefaultTTL = int64(time.Hour / time.Second)
+
+	ephemeralServiceAccountTokenPrivateKey = "token"
+)
+
+var (
+	// Check interface
+	_ ephemeral.EphemeralResourceWithClose = (*serviceAccountTokenEphemeralResource)(nil)
+)
+
+func ephemeralServiceAccountToken() *common.EphemeralResource {
+	return common.NewEphemeralResource(
+		common.CategoryGrafanaOSS,
+		ephemeralServiceAccountTokenName,
+		&serviceAccountTokenEphemeralResource{},
+	)
+}
+
+type serviceAccountTokenEphemeralModel struct {
+	ID               types.String `tfsdk:"id"`
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	Name             types.String `tfsdk:"name"`
+	SecondsToLive    types.Int64  `tfsdk:"seconds_to_live"`
+	Key              types.String `tfsdk:"key"`
+	Expiration       types.String `tfsdk:"expiration"`
+}
+
+// serviceAccountTokenEphemeralPrivate is stored in the private data of the ephemeral resource
+// so that the token can be deleted in Close.
+type serviceAccountTokenEphemeralPrivate struct {
+	OrgID            int64 `json:"org_id"`
+	ServiceAccountID int64 `json:"service_account_id"`
+	TokenID          int64 `json:"token_id"`
+}
+
+type serviceAccountTokenEphemeralResource struct {
+	basePluginFrameworkEphemeralResource
+}
+
+func (r *serviceAccountTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = ephemeralServiceAccountTokenName
+}
+
+func (r *serviceAccountTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Creates a short-lived service account token which is never stored in the Terraform state. The token is deleted when Terraform no longer needs it.
+
+**Note:** This ephemeral resource is available only with Grafana 9.1+ and Terraform 1.10+.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/administration/service-accounts/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developer-resources/api-reference/http-api/api-legacy/serviceaccount/#service-account-api)`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the service account token.",
+			},
+			"service_account_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service account to which the token belongs.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the service account token. Defaults to a generated unique name.",
+			},
+			"seconds_to_live": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The key expiration in seconds. Defaults to %d. The token is deleted at the end of the run, the expiration only applies if Terraform is interrupted before it can do so.", ephemeralServiceAccountTokenDefaultTTL),
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key of the service account token.",
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "The expiration date of the service account token.",
+			},
+		},
+	}
+}
+
+func (r *serviceAccountTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("client not configured", "client not configured")
+		return
+	}
+
+	var data serviceAccountTokenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID, serviceAccountIDStr := SplitOrgResourceID(data.ServiceAccountID.ValueString())
+	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid service_account_id", err.Error())
+		return
+	}
+	client := r.client.Clone()
+	if orgID == 0 {
+		orgID = client.OrgID()
+	} else {
+		client = client.WithOrgID(orgID)
+	}
+
+	if data.Name.IsNull() {
+		data.Name = types.StringValue(fmt.Sprintf("terraform-ephemeral-%d", time.Now().UnixNano()))
+	}
+	if data.SecondsToLive.IsNull() {
+		data.SecondsToLive = types.Int64Value(ephemeralServiceAccountTokenDefaultTTL)
+	}
+
+	params := service_accounts.NewCreateTokenParams().WithServiceAccountID(serviceAccountID).WithBody(&models.AddServiceAccountTokenCommand{
+		Name:          data.Name.ValueString(),
+		SecondsToLive: data.SecondsToLive.ValueInt64(),
+	})
+	createResp, err := client.ServiceAccounts.CreateToken(params)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create service account token", err.Error())
+		return
+	}
+	token := createResp.Payload
+
+	data.ID = types.StringValue(strconv.FormatInt(token.ID, 10))
+	data.Key = types.StringValue(token.Key)
+	data.Expiration = types.StringNull()
+	if ttl := data.SecondsToLive.ValueInt64(); ttl > 0 {
+		data.Expiration = types.StringValue(time.Now().Add(time.Duration(ttl) * time.Second).UTC().Format(time.RFC3339))
+	}
+
+	private, err := json.Marshal(serviceAccountTokenEphemeralPrivate{
+		OrgID:            orgID,
+		ServiceAccountID: serviceAccountID,
+		TokenID:          token.ID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode private data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralServiceAccountTokenPrivateKey, private)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *serviceAccountTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("client not configured", "client not configured")
+		return
+	}
+
+	privateBytes, diags := req.Private.GetKey(ctx, ephemeralServiceAccountTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var private serviceAccountTokenEphemeralPrivate
+	if err := json.Unmarshal(privateBytes, &private); err != nil {
+		resp.Diagnostics.AddError("Failed to decode private data", err.Error())
+		return
+	}
+
+	client := r.client.Clone().WithOrgID(private.OrgID)
+	if _, err := client.ServiceAccounts.DeleteToken(private.TokenID, private.ServiceAccountID); err != nil && !common.IsNotFoundError(err) {
+		resp.Diagnostics.AddError("Failed to delete service account token", err.Error())
+	}
+}
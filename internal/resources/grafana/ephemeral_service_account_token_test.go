@@ -0,0 +1,76 @@
+package grafana_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/testutils"
+)
+
+func TestAccServiceAccountTokenEphemeral_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=11.0.0")
+
+	name := acctest.RandString(10)
+	var sa models.ServiceAccountDTO
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             serviceAccountCheckExists.destroyed(&sa, nil),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountTokenEphemeralConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					serviceAccountCheckExists.exists("grafana_service_account.test", &sa),
+					// The token was used by the aliased provider to read the folders
+					resource.TestCheckResourceAttrSet("data.grafana_folders.test", "id"),
+					// The token is deleted once Terraform is done with it
+					checkServiceAccountHasNoTokens(&sa),
+				),
+			},
+		},
+	})
+}
+
+func checkServiceAccountHasNoTokens(sa *models.ServiceAccountDTO) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := grafanaTestClient().WithOrgID(sa.OrgID)
+		resp, err := client.ServiceAccounts.ListTokens(sa.ID)
+		if err != nil {
+			return err
+		}
+		if len(resp.Payload) > 0 {
+			return fmt.Errorf("expected service account %d to have no tokens, got %d", sa.ID, len(resp.Payload))
+		}
+		return nil
+	}
+}
+
+func testAccServiceAccountTokenEphemeralConfig(name string) string {
+	return fmt.Sprintf(`
+resource "grafana_service_account" "test" {
+	name = "%[1]s"
+	role = "Viewer"
+}
+
+ephemeral "grafana_service_account_token" "test" {
+	service_account_id = grafana_service_account.test.id
+	seconds_to_live    = 300
+}
+
+provider "grafana" {
+	alias = "ephemeral"
+	url   = "%[2]s"
+	auth  = ephemeral.grafana_service_account_token.test.key
+}
+
+data "grafana_folders" "test" {
+	provider = grafana.ephemeral
+}
+`, name, os.Getenv("GRAFANA_URL"))
+}
@@ -1,6 +1,7 @@
 package grafana
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -68,7 +69,8 @@ func SplitServiceAccountID(id string) (int64, string) {
 
 // OAPIClientFromExistingOrgResource creates a client from the ID of an org-scoped resource
 // Those IDs are in the <orgID>:<resourceID> format
-func OAPIClientFromExistingOrgResource(meta any, id string) (*goapi.GrafanaHTTPAPI, int64, string) {
+// The requests of the client are sent with ctx, which carries the resource type
+func OAPIClientFromExistingOrgResource(ctx context.Context, meta any, id string) (*goapi.GrafanaHTTPAPI, int64, string) {
 	orgID, restOfID := SplitOrgResourceID(id)
 	client := meta.(*common.Client).GrafanaAPI.Clone()
 	if orgID == 0 {
@@ -76,12 +78,12 @@ func OAPIClientFromExistingOrgResource(meta any, id string) (*goapi.GrafanaHTTPA
 	} else if orgID > 0 {
 		client = client.WithOrgID(orgID)
 	}
-	return client, orgID, restOfID
+	return common.OAPIClientWithContext(ctx, client), orgID, restOfID
 }
 
 // OAPIClientFromNewOrgResource creates an OpenAPI client from the `org_id` attribute of a resource
 // This client is meant to be used in `Create` functions when the ID hasn't already been baked into the resource ID
-func OAPIClientFromNewOrgResource(meta any, d *schema.ResourceData) (*goapi.GrafanaHTTPAPI, int64) {
+func OAPIClientFromNewOrgResource(ctx context.Context, meta any, d *schema.ResourceData) (*goapi.GrafanaHTTPAPI, int64) {
 	orgID := parseOrgID(d)
 	client := meta.(*common.Client).GrafanaAPI.Clone()
 	if orgID == 0 {
@@ -89,12 +91,12 @@ func OAPIClientFromNewOrgResource(meta any, d *schema.ResourceData) (*goapi.Graf
 	} else if orgID > 0 {
 		client = client.WithOrgID(orgID)
 	}
-	return client, orgID
+	return common.OAPIClientWithContext(ctx, client), orgID
 }
 
-func OAPIGlobalClient(meta any) (*goapi.GrafanaHTTPAPI, error) {
+func OAPIGlobalClient(ctx context.Context, meta any) (*goapi.GrafanaHTTPAPI, error) {
 	metaClient := meta.(*common.Client)
-	client := meta.(*common.Client).GrafanaAPI.Clone().WithOrgID(0)
+	client := common.OAPIClientWithContext(ctx, metaClient.GrafanaAPI.Clone().WithOrgID(0))
 	if metaClient.GrafanaAPIConfig.APIKey != "" {
 		return client, fmt.Errorf("global scope resources cannot be managed with an API key. Use basic auth instead")
 	}
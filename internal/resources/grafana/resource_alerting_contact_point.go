@@ -20,32 +20,23 @@ import (
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 )
 
+//go:generate go run ../../../tools/gennotifiers --snapshot=../../../tools/gennotifiers/notifiers.json --out=resource_alerting_contact_point_notifiers_gen.go
+
 var (
 	provenanceDisabled = "disabled"
-	notifiers          = []notifier{
-		alertmanagerNotifier{},
-		dingDingNotifier{},
-		discordNotifier{},
+	// The notifiers which can't be generated from Grafana's notifier metadata, see tools/gennotifiers.
+	notifiers = append([]notifier{
 		emailNotifier{},
-		googleChatNotifier{},
 		jiraNotifier{},
 		kafkaNotifier{},
-		lineNotifier{},
 		oncallNotifier{},
 		opsGenieNotifier{},
 		pagerDutyNotifier{},
-		pushoverNotifier{},
-		sensugoNotifier{},
 		slackNotifier{},
 		snsNotifier{},
-		teamsNotifier{},
-		telegramNotifier{},
-		threemaNotifier{},
-		victorOpsNotifier{},
-		webexNotifier{},
 		webhookNotifier{},
 		wecomNotifier{},
-	}
+	}, generatedNotifiers...)
 )
 
 func resourceContactPoint() *common.Resource {
@@ -118,6 +109,21 @@ func isProvisioningConflict(err error) bool {
 	return ok && status.IsCode(409)
 }
 
+// isProvisioningStatus reports whether err is an HTTP response of the alerting provisioning API with one of
+// the given status codes. Transport errors, such as the requests rejected in read-only mode, aren't responses.
+func isProvisioningStatus(err error, codes ...int) bool {
+	status, ok := err.(runtime.ClientResponseStatus)
+	if !ok {
+		return false
+	}
+	for _, code := range codes {
+		if status.IsCode(code) {
+			return true
+		}
+	}
+	return false
+}
+
 func listContactPoints(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
 	idMap := map[string]bool{}
 	// Retry if the API returns 500 because it may be that the alertmanager is not ready in the org yet.
@@ -125,7 +131,7 @@ func listContactPoints(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID
 	if err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		resp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams())
 		if err != nil {
-			if err.(runtime.ClientResponseStatus).IsCode(500) || err.(runtime.ClientResponseStatus).IsCode(403) {
+			if isProvisioningStatus(err, 500, 403) {
 				return retry.RetryableError(err)
 			}
 			return retry.NonRetryableError(err)
@@ -148,7 +154,7 @@ func listContactPoints(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID
 }
 
 func readContactPoint(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, orgID, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	resp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams())
 	if err != nil {
@@ -174,7 +180,7 @@ func readContactPoint(ctx context.Context, data *schema.ResourceData, meta any)
 }
 
 func updateContactPoint(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, data)
 
 	ps := unpackContactPoints(data)
 
@@ -218,7 +224,7 @@ func updateContactPoint(ctx context.Context, data *schema.ResourceData, meta any
 				}
 				resp, err := client.Provisioning.PostContactpoints(params)
 				if err != nil {
-					if err.(runtime.ClientResponseStatus).IsCode(500) || isProvisioningConflict(err) {
+					if isProvisioningStatus(err, 500) || isProvisioningConflict(err) {
 						return retry.RetryableError(err)
 					}
 					return retry.NonRetryableError(err)
@@ -264,7 +270,7 @@ func updateContactPoint(ctx context.Context, data *schema.ResourceData, meta any
 }
 
 func deleteContactPoint(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, _, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	resp, err := client.Provisioning.GetContactpoints(provisioning.NewGetContactpointsParams().WithName(&name))
 	if err, shouldReturn := common.CheckReadError("contact point", data, err); shouldReturn {
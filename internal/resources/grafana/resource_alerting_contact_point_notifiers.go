@@ -9,131 +9,6 @@ import (
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 )
 
-type alertmanagerNotifier struct{}
-
-var _ notifier = (*alertmanagerNotifier)(nil)
-
-func (a alertmanagerNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:   "alertmanager",
-		typeStr: "prometheus-alertmanager",
-		desc:    "A contact point that sends notifications to other Alertmanager instances.",
-		fieldMapper: map[string]fieldMapper{
-			"basic_auth_user":     newKeyMapper("basicAuthUser"),
-			"basic_auth_password": newKeyMapper("basicAuthPassword"),
-		},
-	}
-}
-
-func (a alertmanagerNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["url"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The URL of the Alertmanager instance.",
-	}
-	r.Schema["basic_auth_user"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The username component of the basic auth credentials to use.",
-	}
-	r.Schema["basic_auth_password"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Sensitive:   true,
-		Description: "The password component of the basic auth credentials to use.",
-	}
-	return r
-}
-
-type dingDingNotifier struct{}
-
-var _ notifier = (*dingDingNotifier)(nil)
-
-func (d dingDingNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:   "dingding",
-		typeStr: "dingding",
-		desc:    "A contact point that sends notifications to DingDing.",
-		fieldMapper: map[string]fieldMapper{
-			"message_type": newKeyMapper("msgType"),
-		},
-	}
-}
-
-func (d dingDingNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["url"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "The DingDing webhook URL.",
-	}
-	r.Schema["message_type"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The format of message to send - either 'link' or 'actionCard'",
-	}
-	r.Schema["message"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated content of the message.",
-	}
-	r.Schema["title"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated title of the message.",
-	}
-	return r
-}
-
-type discordNotifier struct{}
-
-var _ notifier = (*discordNotifier)(nil)
-
-func (d discordNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:       "discord",
-		typeStr:     "discord",
-		desc:        "A contact point that sends notifications as Discord messages",
-		fieldMapper: map[string]fieldMapper{},
-	}
-}
-
-func (d discordNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["url"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "The discord webhook URL.",
-	}
-	r.Schema["title"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated content of the title.",
-	}
-	r.Schema["message"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Default:     "",
-		Description: "The templated content of the message.",
-	}
-	r.Schema["avatar_url"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Default:     "",
-		Description: "The URL of a custom avatar image to use.",
-	}
-	r.Schema["use_discord_username"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Default:     false,
-		Description: "Whether to use the bot account's plain username instead of \"Grafana.\"",
-	}
-	return r
-}
-
 type emailNotifier struct{}
 
 var _ notifier = (*emailNotifier)(nil)
@@ -199,51 +74,6 @@ func unpackAddrs(addrs any) any {
 	return strings.Join(strs, string(addrSeparator))
 }
 
-type googleChatNotifier struct{}
-
-var _ notifier = (*googleChatNotifier)(nil)
-
-func (g googleChatNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:       "googlechat",
-		typeStr:     "googlechat",
-		desc:        "A contact point that sends notifications to Google Chat.",
-		fieldMapper: map[string]fieldMapper{},
-	}
-}
-
-func (g googleChatNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["url"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "The Google Chat webhook URL.",
-	}
-	r.Schema["title"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated content of the title.",
-	}
-	r.Schema["message"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated content of the message.",
-	}
-	r.Schema["hide_open_button"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Description: "Whether to hide the Open URL button in the message. This feature requires Grafana 12.4.0 or later.",
-	}
-	r.Schema["hide_version_info"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Description: "Whether to hide the version info in the message. This feature requires Grafana 12.4.0 or later.",
-		Default:     false,
-	}
-	return r
-}
-
 type kafkaNotifier struct{}
 
 var _ notifier = (*kafkaNotifier)(nil)
@@ -311,40 +141,6 @@ func (k kafkaNotifier) schema() *schema.Resource {
 	return r
 }
 
-type lineNotifier struct{}
-
-var _ notifier = (*lineNotifier)(nil)
-
-func (o lineNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:       "line",
-		typeStr:     "LINE",
-		desc:        "A contact point that sends notifications to LINE.me.",
-		fieldMapper: map[string]fieldMapper{},
-	}
-}
-
-func (o lineNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["token"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "The bearer token used to authorize the client.",
-	}
-	r.Schema["title"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated title of the message.",
-	}
-	r.Schema["description"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated description of the message.",
-	}
-	return r
-}
-
 type jiraNotifier struct{}
 
 var _ notifier = (*jiraNotifier)(nil)
@@ -703,152 +499,6 @@ func (n pagerDutyNotifier) schema() *schema.Resource {
 	return r
 }
 
-type pushoverNotifier struct{}
-
-var _ notifier = (*pushoverNotifier)(nil)
-
-func (n pushoverNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:   "pushover",
-		typeStr: "pushover",
-		desc:    "A contact point that sends notifications to Pushover.",
-		fieldMapper: map[string]fieldMapper{
-			"user_key":     newKeyMapper("userKey"),
-			"api_token":    newKeyMapper("apiToken"),
-			"ok_sound":     newKeyMapper("okSound"),
-			"upload_image": newKeyMapper("uploadImage"),
-			// For unclear legacy reasons, these are sent as a string to Grafana API.
-			"ok_priority": newFieldMapper("okPriority", valueAsInt, valueAsString),
-			"priority":    newFieldMapper("", valueAsInt, valueAsString),
-			"retry":       newFieldMapper("", valueAsInt, valueAsString),
-			"expire":      newFieldMapper("", valueAsInt, valueAsString),
-		},
-	}
-}
-
-func (n pushoverNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["user_key"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "The Pushover user key.",
-	}
-	r.Schema["api_token"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "The Pushover API token.",
-	}
-	r.Schema["priority"] = &schema.Schema{
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Description: "The priority level of the event.",
-	}
-	r.Schema["ok_priority"] = &schema.Schema{
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Description: "The priority level of the resolved event.",
-	}
-	r.Schema["retry"] = &schema.Schema{
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Description: "How often, in seconds, the Pushover servers will send the same notification to the user.",
-	}
-	r.Schema["expire"] = &schema.Schema{
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Description: "How many seconds for which the notification will continue to be retried by Pushover.",
-	}
-	r.Schema["device"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Comma-separated list of devices to which the event is associated.",
-	}
-	r.Schema["sound"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The sound associated with the notification.",
-	}
-	r.Schema["ok_sound"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The sound associated with the resolved notification.",
-	}
-	r.Schema["title"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated title of the message.",
-	}
-	r.Schema["message"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated notification message content.",
-	}
-	r.Schema["upload_image"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Description: "Whether to send images in the notification or not. Default is true. Requires Grafana to be configured to send images in notifications.",
-	}
-	return r
-}
-
-type sensugoNotifier struct{}
-
-var _ notifier = (*sensugoNotifier)(nil)
-
-func (s sensugoNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:   "sensugo",
-		typeStr: "sensugo",
-		desc:    "A contact point that sends notifications to SensuGo.",
-		fieldMapper: map[string]fieldMapper{
-			"api_key": newKeyMapper("apikey"),
-		},
-	}
-}
-
-func (s sensugoNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["url"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The SensuGo URL to send requests to.",
-	}
-	r.Schema["api_key"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "The SensuGo API key.",
-	}
-	r.Schema["entity"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The entity being monitored.",
-	}
-	r.Schema["check"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The SensuGo check to which the event should be routed.",
-	}
-	r.Schema["namespace"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The namespace in which the check resides.",
-	}
-	r.Schema["handler"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "A custom handler to execute in addition to the check.",
-	}
-	r.Schema["message"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Templated message content describing the alert.",
-	}
-	return r
-}
-
 type slackNotifier struct{}
 
 var _ notifier = (*slackNotifier)(nil)
@@ -1020,237 +670,6 @@ func (s snsNotifier) schema() *schema.Resource {
 	return r
 }
 
-type teamsNotifier struct{}
-
-var _ notifier = (*teamsNotifier)(nil)
-
-func (t teamsNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:   "teams",
-		typeStr: "teams",
-		desc:    "A contact point that sends notifications to Microsoft Teams.",
-		fieldMapper: map[string]fieldMapper{
-			"section_title": newKeyMapper("sectiontitle"),
-			"chat_id":       newKeyMapper("chatid"),
-		},
-	}
-}
-
-func (t teamsNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["url"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "A Teams webhook URL.",
-	}
-	r.Schema["message"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated message content to send.",
-	}
-	r.Schema["title"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated title of the message.",
-	}
-	r.Schema["section_title"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated subtitle for each message section.",
-	}
-	return r
-}
-
-type telegramNotifier struct{}
-
-var _ notifier = (*telegramNotifier)(nil)
-
-func (t telegramNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:   "telegram",
-		typeStr: "telegram",
-		desc:    "A contact point that sends notifications to Telegram.",
-		fieldMapper: map[string]fieldMapper{
-			"token":   newKeyMapper("bottoken"),
-			"chat_id": newKeyMapper("chatid"),
-		},
-	}
-}
-
-func (t telegramNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["token"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "The Telegram bot token.",
-	}
-	r.Schema["chat_id"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The chat ID to send messages to.",
-	}
-	r.Schema["message"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated content of the message.",
-	}
-	r.Schema["message_thread_id"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The ID of the message thread to send the message to.",
-	}
-	r.Schema["parse_mode"] = &schema.Schema{
-		Type:         schema.TypeString,
-		Optional:     true,
-		ValidateFunc: validation.StringInSlice([]string{"None", "Markdown", "MarkdownV2", "HTML"}, true),
-		Description:  "Mode for parsing entities in the message text. Supported: None, Markdown, MarkdownV2, and HTML. HTML is the default.",
-	}
-	r.Schema["disable_web_page_preview"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Description: "When set it disables link previews for links in the message.",
-	}
-	r.Schema["protect_content"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Description: "When set it protects the contents of the message from forwarding and saving.",
-	}
-	r.Schema["disable_notifications"] = &schema.Schema{
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Description: "When set users will receive a notification with no sound.",
-	}
-	return r
-}
-
-type threemaNotifier struct{}
-
-var _ notifier = (*threemaNotifier)(nil)
-
-func (t threemaNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:       "threema",
-		typeStr:     "threema",
-		desc:        "A contact point that sends notifications to Threema.",
-		fieldMapper: map[string]fieldMapper{},
-	}
-}
-
-func (t threemaNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["gateway_id"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The Threema gateway ID.",
-	}
-	r.Schema["recipient_id"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The ID of the recipient of the message.",
-	}
-	r.Schema["api_secret"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "The Threema API key.",
-	}
-	r.Schema["title"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated title of the message.",
-	}
-	r.Schema["description"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated description of the message.",
-	}
-	return r
-}
-
-type victorOpsNotifier struct{}
-
-var _ notifier = (*victorOpsNotifier)(nil)
-
-func (v victorOpsNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:   "victorops",
-		typeStr: "victorops",
-		desc:    "A contact point that sends notifications to VictorOps (now known as Splunk OnCall).",
-		fieldMapper: map[string]fieldMapper{
-			"message_type": newKeyMapper("messageType"),
-		},
-	}
-}
-
-func (v victorOpsNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["url"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "The VictorOps webhook URL.",
-	}
-	r.Schema["message_type"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The VictorOps alert state - typically either `CRITICAL` or `RECOVERY`.",
-	}
-	r.Schema["title"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Templated title to display.",
-	}
-	r.Schema["description"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Templated description of the message.",
-	}
-	return r
-}
-
-type webexNotifier struct{}
-
-var _ notifier = (*webexNotifier)(nil)
-
-func (w webexNotifier) meta() notifierMeta {
-	return notifierMeta{
-		field:   "webex",
-		typeStr: "webex",
-		desc:    "A contact point that sends notifications to Cisco Webex.",
-		fieldMapper: map[string]fieldMapper{
-			"token": newKeyMapper("bot_token"),
-		},
-	}
-}
-
-func (w webexNotifier) schema() *schema.Resource {
-	r := commonNotifierResource()
-	r.Schema["token"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Sensitive:   true,
-		Description: "The bearer token used to authorize the client.",
-	}
-	r.Schema["api_url"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The URL to send webhook requests to.",
-	}
-	r.Schema["message"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "The templated title of the message to send.",
-	}
-	r.Schema["room_id"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "ID of the Webex Teams room where to send the messages.",
-	}
-	return r
-}
-
 type webhookNotifier struct{}
 
 var _ notifier = (*webhookNotifier)(nil)
@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/go-openapi/runtime"
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -155,7 +154,7 @@ func (r *messageTemplateResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	client, orgID, err := r.clientFromNewOrgResource(plan.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, plan.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -177,7 +176,7 @@ func (r *messageTemplateResource) Create(ctx context.Context, req resource.Creat
 				params.SetXDisableProvenance(&provenanceDisabled)
 			}
 			if _, err := client.Provisioning.PutTemplate(params); err != nil {
-				if err.(runtime.ClientResponseStatus).IsCode(500) {
+				if isProvisioningStatus(err, 500) {
 					return retry.RetryableError(err)
 				}
 				return retry.NonRetryableError(err)
@@ -225,7 +224,7 @@ func (r *messageTemplateResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	client, orgID, split, err := r.clientFromExistingOrgResource(resourceMessageTemplateID, plan.ID.ValueString())
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, resourceMessageTemplateID, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
@@ -250,7 +249,7 @@ func (r *messageTemplateResource) Update(ctx context.Context, req resource.Updat
 				params.SetXDisableProvenance(&provenanceDisabled)
 			}
 			if _, err := client.Provisioning.PutTemplate(params); err != nil {
-				if err.(runtime.ClientResponseStatus).IsCode(500) {
+				if isProvisioningStatus(err, 500) {
 					return retry.RetryableError(err)
 				}
 				return retry.NonRetryableError(err)
@@ -278,7 +277,7 @@ func (r *messageTemplateResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	client, _, split, err := r.clientFromExistingOrgResource(resourceMessageTemplateID, state.ID.ValueString())
+	client, _, split, err := r.clientFromExistingOrgResource(ctx, resourceMessageTemplateID, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
@@ -301,7 +300,7 @@ func (r *messageTemplateResource) Delete(ctx context.Context, req resource.Delet
 
 func (r *messageTemplateResource) read(ctx context.Context, id string) (*messageTemplateModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	client, orgID, split, err := r.clientFromExistingOrgResource(resourceMessageTemplateID, id)
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, resourceMessageTemplateID, id)
 	if err != nil {
 		diags.AddError("Failed to parse resource ID", err.Error())
 		return nil, diags
@@ -344,7 +343,7 @@ func listMessageTemplate(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgI
 	if err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		resp, err := client.Provisioning.GetTemplates()
 		if err != nil {
-			if err.(runtime.ClientResponseStatus).IsCode(500) || err.(runtime.ClientResponseStatus).IsCode(403) {
+			if isProvisioningStatus(err, 500, 403) {
 				return retry.RetryableError(err)
 			}
 			return retry.NonRetryableError(err)
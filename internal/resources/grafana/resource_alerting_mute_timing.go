@@ -7,7 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/go-openapi/runtime"
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -143,7 +142,7 @@ func listMuteTimings(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID in
 	if err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		resp, err := client.Provisioning.GetMuteTimings()
 		if err != nil {
-			if err.(runtime.ClientResponseStatus).IsCode(500) || err.(runtime.ClientResponseStatus).IsCode(403) {
+			if isProvisioningStatus(err, 500, 403) {
 				return retry.RetryableError(err)
 			}
 			return retry.NonRetryableError(err)
@@ -161,7 +160,7 @@ func listMuteTimings(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID in
 }
 
 func readMuteTiming(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, orgID, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	resp, err := client.Provisioning.GetMuteTiming(name)
 	if err, shouldReturn := common.CheckReadError("mute timing", data, err); shouldReturn {
@@ -177,7 +176,7 @@ func readMuteTiming(ctx context.Context, data *schema.ResourceData, meta any) di
 }
 
 func createMuteTiming(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, data)
 
 	intervals := data.Get("intervals").([]any)
 	params := provisioning.NewPostMuteTimingParams().
@@ -195,7 +194,7 @@ func createMuteTiming(ctx context.Context, data *schema.ResourceData, meta any)
 		var postErr error
 		resp, postErr = client.Provisioning.PostMuteTiming(params)
 		if postErr != nil {
-			if postErr.(runtime.ClientResponseStatus).IsCode(500) || postErr.(runtime.ClientResponseStatus).IsCode(403) || isProvisioningConflict(postErr) {
+			if isProvisioningStatus(postErr, 500, 403) || isProvisioningConflict(postErr) {
 				return retry.RetryableError(postErr)
 			}
 			return retry.NonRetryableError(postErr)
@@ -211,7 +210,7 @@ func createMuteTiming(ctx context.Context, data *schema.ResourceData, meta any)
 }
 
 func updateMuteTiming(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, _, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	intervals := data.Get("intervals").([]any)
 	params := provisioning.NewPutMuteTimingParams().
@@ -242,7 +241,7 @@ func updateMuteTiming(ctx context.Context, data *schema.ResourceData, meta any)
 }
 
 func deleteMuteTiming(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, name := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, _, name := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	// Remove the mute timing from all notification policies
 	policyResp, err := client.Provisioning.GetPolicyTree()
@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/go-openapi/runtime"
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 	"github.com/grafana/grafana-openapi-client-go/models"
@@ -206,7 +205,7 @@ func listNotificationPolicies(ctx context.Context, client *goapi.GrafanaHTTPAPI,
 	if err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		_, err := client.Provisioning.GetPolicyTree()
 		if err != nil {
-			if err.(runtime.ClientResponseStatus).IsCode(500) || err.(runtime.ClientResponseStatus).IsCode(403) {
+			if isProvisioningStatus(err, 500, 403) {
 				return retry.RetryableError(err)
 			}
 			return retry.NonRetryableError(err)
@@ -223,7 +222,7 @@ func listNotificationPolicies(ctx context.Context, client *goapi.GrafanaHTTPAPI,
 }
 
 func readNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID, _ := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, orgID, _ := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	resp, err := client.Provisioning.GetPolicyTree()
 	if err != nil {
@@ -237,7 +236,7 @@ func readNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta
 }
 
 func putNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, data)
 
 	npt, err := unpackNotifPolicy(data)
 	if err != nil {
@@ -252,7 +251,7 @@ func putNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta
 	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		_, err := client.Provisioning.PutPolicyTree(putParams)
 		if err != nil {
-			if err.(runtime.ClientResponseStatus).IsCode(500) || err.(runtime.ClientResponseStatus).IsCode(404) || isProvisioningConflict(err) {
+			if isProvisioningStatus(err, 500, 404) || isProvisioningConflict(err) {
 				return retry.RetryableError(err)
 			}
 			return retry.NonRetryableError(err)
@@ -269,7 +268,7 @@ func putNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta
 }
 
 func deleteNotificationPolicy(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, _ := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, _, _ := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	// Retry on 409, since a concurrent request may have modified the alertmanager config.
 	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
@@ -0,0 +1,362 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/prometheus/alertmanager/matchers/parse"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+func resourceNotificationPolicyRoute() *common.Resource {
+	routeSchema := map[string]*schema.Schema{
+		"org_id": orgIDAttribute(),
+		"disable_provenance": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Allow modifying the notification policy tree from other sources than Terraform or the Grafana API. The provenance is shared by the whole tree, all the routes of an organization must use the same value.",
+		},
+		"position": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      -1,
+			ValidateFunc: validation.IntAtLeast(-1),
+			Description: "The position of the route among the top-level routes of the tree, starting at 0. Routes are evaluated in order, so routes without `continue` catch the alerts which match them first. " +
+				"If not set (or -1), the route is appended at the end. The position is applied when the route is created or when it changes, routes added or removed by other workspaces can shift it.",
+		},
+	}
+	for name, attribute := range policySchema(supportedPolicyTreeDepth).Schema {
+		routeSchema[name] = attribute
+	}
+	// The matchers identify the route in the tree, changing them is moving the alerts to another route
+	routeSchema["matcher"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Required:    true,
+		ForceNew:    true,
+		MinItems:    1,
+		Description: "Describes which labels this route should match. The matchers identify the route in the tree: two routes with the same matchers are a conflict, as are overlapping routes (the matchers of one route are a subset of the other's) unless the first one has `continue` set. Changing them replaces the route. When multiple matchers are supplied, an alert must match ALL matchers to be accepted by this route.",
+		Elem:        routeSchema["matcher"].Elem,
+	}
+
+	resource := &schema.Resource{
+		Description: `
+Manages a single top-level route of the notification policy tree, keyed by its matchers.
+
+Unlike ` + "`grafana_notification_policy`" + `, which manages the entire tree, this resource only inserts, updates and removes its own route, so that each team can manage its routes in its own workspace.
+The root policy and the routes managed by other workspaces (or in the UI) are left untouched.
+
+!> This resource can't be used together with ` + "`grafana_notification_policy`" + ` in the same organization, which overwrites the entire tree.
+
+~> The policy tree is updated with a read-modify-write of the whole tree. Updates from the same provider are serialized, but concurrent applies from different workspaces can overwrite each other's changes, which are detected as drift by the next plan.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/alerting/set-up/provision-alerting-resources/terraform-provisioning/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developer-resources/api-reference/http-api/api-legacy/alerting_provisioning/#notification-policies)
+
+This resource requires Grafana 9.1.0 or later.
+`,
+
+		CreateContext: common.WithAlertingMutex[schema.CreateContextFunc](createNotificationPolicyRoute),
+		ReadContext:   readNotificationPolicyRoute,
+		UpdateContext: common.WithAlertingMutex[schema.UpdateContextFunc](updateNotificationPolicyRoute),
+		DeleteContext: common.WithAlertingMutex[schema.DeleteContextFunc](deleteNotificationPolicyRoute),
+		Importer: &schema.ResourceImporter{
+			StateContext: importNotificationPolicyRoute,
+		},
+
+		SchemaVersion: 0,
+		Schema:        routeSchema,
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryAlerting,
+		"grafana_notification_policy_route",
+		orgResourceIDString("matchers"),
+		resource,
+	)
+}
+
+func readNotificationPolicyRoute(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	client, orgID, key := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
+
+	tree, err := getPolicyTree(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	index, err := findPolicyRoute(tree, key)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if index < 0 {
+		return common.WarnMissing("notification policy route", data)
+	}
+
+	packed := packSpecificPolicy(tree.Routes[index], supportedPolicyTreeDepth).(map[string]any)
+	for name := range policySchema(supportedPolicyTreeDepth).Schema {
+		if err := data.Set(name, packed[name]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	data.Set("disable_provenance", tree.Provenance == "")
+	data.Set("org_id", strconv.FormatInt(orgID, 10))
+
+	return nil
+}
+
+// importNotificationPolicyRoute leaves the route where it is in the tree: the position isn't read, and an imported route without position must not be moved.
+func importNotificationPolicyRoute(ctx context.Context, data *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := data.Set("position", -1); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{data}, nil
+}
+
+func createNotificationPolicyRoute(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, data)
+
+	route, err := unpackNotificationPolicyRoute(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	key := policyRouteKey(route.ObjectMatchers)
+
+	err = updatePolicyTree(ctx, client, data, func(tree *models.Route) error {
+		index, err := findPolicyRoute(tree, key)
+		if err != nil {
+			return err
+		}
+		if index >= 0 {
+			return fmt.Errorf("a route with the matchers %s already exists in the notification policy tree, it may be managed by another workspace. Import it with the ID %q to manage it here", key, MakeOrgResourceID(orgID, key))
+		}
+
+		tree.Routes = insertPolicyRoute(tree.Routes, route, data.Get("position").(int))
+		if overlaps := overlappingPolicyRoutes(tree, key); len(overlaps) > 0 {
+			return fmt.Errorf("the route with the matchers %s conflicts with other routes of the notification policy tree, which may be managed by another workspace. %s", key, strings.Join(overlaps, " "))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(MakeOrgResourceID(orgID, key))
+	return readNotificationPolicyRoute(ctx, data, meta)
+}
+
+func updateNotificationPolicyRoute(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	client, _, key := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
+
+	route, err := unpackNotificationPolicyRoute(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	err = updatePolicyTree(ctx, client, data, func(tree *models.Route) error {
+		index, err := findPolicyRoute(tree, key)
+		if err != nil {
+			return err
+		}
+		if index < 0 {
+			return fmt.Errorf("the route with the matchers %s no longer exists in the notification policy tree", key)
+		}
+
+		if data.HasChange("position") {
+			tree.Routes = append(tree.Routes[:index], tree.Routes[index+1:]...)
+			tree.Routes = insertPolicyRoute(tree.Routes, route, data.Get("position").(int))
+		} else {
+			tree.Routes[index] = route
+		}
+		// Other workspaces may have added overlapping routes since the creation, they are only reported
+		for _, overlap := range overlappingPolicyRoutes(tree, key) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Notification policy route overlaps another route",
+				Detail:   overlap,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(diags, readNotificationPolicyRoute(ctx, data, meta)...)
+}
+
+func deleteNotificationPolicyRoute(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	client, _, key := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
+
+	err := updatePolicyTree(ctx, client, data, func(tree *models.Route) error {
+		index, err := findPolicyRoute(tree, key)
+		if err != nil {
+			return err
+		}
+		if index >= 0 {
+			tree.Routes = append(tree.Routes[:index], tree.Routes[index+1:]...)
+		}
+		return nil
+	})
+	return diag.FromErr(err)
+}
+
+func getPolicyTree(client *goapi.GrafanaHTTPAPI) (*models.Route, error) {
+	resp, err := client.Provisioning.GetPolicyTree()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Payload, nil
+}
+
+// updatePolicyTree reads the tree right before modifying it and writing it back, to keep the changes of other workspaces.
+// It is retried as a whole on conflicts, since a concurrent request may have modified the alertmanager config.
+func updatePolicyTree(ctx context.Context, client *goapi.GrafanaHTTPAPI, data *schema.ResourceData, modify func(tree *models.Route) error) error {
+	return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		tree, err := getPolicyTree(client)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if err := modify(tree); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		putParams := provisioning.NewPutPolicyTreeParams().WithBody(tree)
+		if data.Get("disable_provenance").(bool) {
+			putParams.SetXDisableProvenance(&provenanceDisabled)
+		}
+		if _, err := client.Provisioning.PutPolicyTree(putParams); err != nil {
+			if isProvisioningStatus(err, 500) || isProvisioningConflict(err) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func unpackNotificationPolicyRoute(data *schema.ResourceData) (*models.Route, error) {
+	raw := map[string]any{}
+	for name := range policySchema(supportedPolicyTreeDepth).Schema {
+		raw[name] = data.Get(name)
+	}
+	route, err := unpackSpecificPolicy(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(route.GroupBy) == 0 {
+		route.GroupBy = nil
+	}
+	return route, nil
+}
+
+// insertPolicyRoute inserts a route at the given position, or at the end if the position is negative or past the end.
+func insertPolicyRoute(routes []*models.Route, route *models.Route, position int) []*models.Route {
+	if position < 0 || position >= len(routes) {
+		return append(routes, route)
+	}
+	return append(routes[:position], append([]*models.Route{route}, routes[position:]...)...)
+}
+
+// findPolicyRoute returns the index of the top-level route with the given matchers, or -1 if there is none.
+// Several routes with the same matchers are a conflict: the resource can't know which one it owns.
+func findPolicyRoute(tree *models.Route, key string) (int, error) {
+	want, err := parsePolicyRouteKey(key)
+	if err != nil {
+		return -1, err
+	}
+
+	index := -1
+	for i, route := range tree.Routes {
+		if policyRouteKey(route.ObjectMatchers) != want {
+			continue
+		}
+		if index >= 0 {
+			return -1, fmt.Errorf("routes %d and %d of the notification policy tree both have the matchers %s, only one of them can be managed by this resource", index, i, want)
+		}
+		index = i
+	}
+	return index, nil
+}
+
+// overlappingPolicyRoutes describes the routes which overlap the route with the given matchers: the matchers of one of the two routes
+// are a subset of the other's, so the first one evaluated catches the alerts matching both, unless it has continue set.
+// This happens when two workspaces claim overlapping matchers.
+func overlappingPolicyRoutes(tree *models.Route, key string) []string {
+	index, err := findPolicyRoute(tree, key)
+	if err != nil || index < 0 {
+		return nil
+	}
+
+	route := tree.Routes[index]
+	var overlaps []string
+	for i, other := range tree.Routes {
+		// The routes with the deprecated matchers (match, match_re, matchers) can't be compared
+		legacyMatchers := len(other.Match) > 0 || len(other.MatchRe) > 0 || len(other.Matchers) > 0
+		if i == index || legacyMatchers || !policyMatchersSubset(route.ObjectMatchers, other.ObjectMatchers) && !policyMatchersSubset(other.ObjectMatchers, route.ObjectMatchers) {
+			continue
+		}
+		first := other
+		if index < i {
+			first = route
+		}
+		if first.Continue {
+			continue
+		}
+		overlaps = append(overlaps, fmt.Sprintf("The route %s overlaps the route %d of the notification policy tree, with the matchers %s: the alerts matching both are only routed to the route %s, which is evaluated first and doesn't have continue set. "+
+			"Use matchers which don't overlap, or set continue on the first route.", key, i, policyRouteKey(other.ObjectMatchers), policyRouteKey(first.ObjectMatchers)))
+	}
+	return overlaps
+}
+
+// policyMatchersSubset returns whether all the matchers of a are matchers of b: the route with the matchers a matches all the alerts of the route with the matchers b.
+func policyMatchersSubset(a, b models.ObjectMatchers) bool {
+	matchers := map[string]bool{}
+	for _, m := range b {
+		matchers[policyMatcherString(m)] = true
+	}
+	for _, m := range a {
+		if !matchers[policyMatcherString(m)] {
+			return false
+		}
+	}
+	return true
+}
+
+// policyRouteKey is the canonical form of the matchers of a route, which identifies it in the tree: {label="value",...}, sorted.
+func policyRouteKey(matchers models.ObjectMatchers) string {
+	parts := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		parts = append(parts, policyMatcherString(m))
+	}
+	sort.Strings(parts)
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func policyMatcherString(m models.ObjectMatcher) string {
+	return m[0] + m[1] + strconv.Quote(m[2])
+}
+
+// parsePolicyRouteKey parses the matchers of an ID, which can be written by hand when importing, and returns their canonical form.
+func parsePolicyRouteKey(key string) (string, error) {
+	parsed, err := parse.Matchers(key)
+	if err != nil {
+		return "", fmt.Errorf("invalid notification policy route ID, the matchers %q can't be parsed: %w", key, err)
+	}
+	matchers := make(models.ObjectMatchers, 0, len(parsed))
+	for _, m := range parsed {
+		matchers = append(matchers, models.ObjectMatcher{m.Name, m.Type.String(), m.Value})
+	}
+	return policyRouteKey(matchers), nil
+}
@@ -0,0 +1,86 @@
+package grafana
+
+import (
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+)
+
+func TestOverlappingPolicyRoutes(t *testing.T) {
+	route := func(continueMatching bool, matchers ...string) *models.Route {
+		r := &models.Route{Continue: continueMatching}
+		for i := 0; i < len(matchers); i += 2 {
+			r.ObjectMatchers = append(r.ObjectMatchers, models.ObjectMatcher{matchers[i], "=", matchers[i+1]})
+		}
+		return r
+	}
+	const key = `{team="a"}`
+
+	for _, tc := range []struct {
+		name     string
+		routes   []*models.Route
+		overlaps int
+	}{
+		{
+			name:   "different matchers",
+			routes: []*models.Route{route(false, "team", "b"), route(false, "team", "a"), route(false, "env", "prod")},
+		},
+		{
+			name:     "shadowed by an earlier route",
+			routes:   []*models.Route{route(false), route(false, "team", "a")},
+			overlaps: 1,
+		},
+		{
+			name:     "shadowing a later route",
+			routes:   []*models.Route{route(false, "team", "a"), route(false, "team", "a", "severity", "critical")},
+			overlaps: 1,
+		},
+		{
+			name:     "more specific earlier route",
+			routes:   []*models.Route{route(false, "severity", "critical", "team", "a"), route(false, "team", "a")},
+			overlaps: 1,
+		},
+		{
+			name:   "earlier route with continue",
+			routes: []*models.Route{route(true, "team", "a", "severity", "critical"), route(false, "team", "a")},
+		},
+		{
+			name:   "route with continue",
+			routes: []*models.Route{route(true, "team", "a"), route(false, "team", "a", "severity", "critical")},
+		},
+		{
+			name:   "deprecated matchers",
+			routes: []*models.Route{{Match: map[string]string{"team": "a"}}, route(false, "team", "a")},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			overlaps := overlappingPolicyRoutes(&models.Route{Routes: tc.routes}, key)
+			if len(overlaps) != tc.overlaps {
+				t.Fatalf("expected %d overlapping routes, got %v", tc.overlaps, overlaps)
+			}
+		})
+	}
+}
+
+func TestInsertPolicyRoute(t *testing.T) {
+	a, b, c := &models.Route{Receiver: "a"}, &models.Route{Receiver: "b"}, &models.Route{Receiver: "c"}
+
+	for _, tc := range []struct {
+		position int
+		want     string
+	}{
+		{position: -1, want: "abc"},
+		{position: 0, want: "cab"},
+		{position: 1, want: "acb"},
+		{position: 5, want: "abc"},
+	} {
+		routes := insertPolicyRoute([]*models.Route{a, b}, c, tc.position)
+		got := ""
+		for _, r := range routes {
+			got += r.Receiver
+		}
+		if got != tc.want {
+			t.Fatalf("position %d: expected the routes %s, got %s", tc.position, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package grafana_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/testutils"
+)
+
+func TestAccNotificationPolicyRoute_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		// Implicitly tests deletion.
+		CheckDestroy: testAccNotificationPolicyRouteCheckTree(),
+		Steps: []resource.TestStep{
+			// Test creation.
+			{
+				Config: testutils.TestAccExample(t, "resources/grafana_notification_policy_route/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNotificationPolicyRouteCheckTree("my-team"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.team_route", "id", `1:{team="my-team"}`),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.team_route", "contact_point", "Team Contact Point"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.team_route", "group_by.0", "alertname"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.team_route", "mute_timings.0", "Team Mute Timing"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.team_route", "matcher.#", "1"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.team_route", "policy.#", "1"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.team_route", "policy.0.group_wait", "10s"),
+				),
+			},
+			// Test import.
+			{
+				ResourceName:            "grafana_notification_policy_route.team_route",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"position"},
+			},
+			// Test update.
+			{
+				Config: testutils.TestAccExampleWithReplace(t, "resources/grafana_notification_policy_route/resource.tf", map[string]string{
+					`group_wait = "10s"`: `group_wait = "20s"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNotificationPolicyRouteCheckTree("my-team"),
+					resource.TestCheckResourceAttr("grafana_notification_policy_route.team_route", "policy.0.group_wait", "20s"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationPolicyRoute_multipleRoutes(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=9.1.0")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccNotificationPolicyRouteCheckTree(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationPolicyRouteConfig(map[string]int{"a": -1, "b": -1}),
+				Check:  testAccNotificationPolicyRouteCheckTree("a", "b"),
+			},
+			// Move a route before the other one
+			{
+				Config: testAccNotificationPolicyRouteConfig(map[string]int{"a": -1, "b": 0}),
+				Check:  testAccNotificationPolicyRouteCheckTree("b", "a"),
+			},
+			// Removing a route keeps the other one
+			{
+				Config: testAccNotificationPolicyRouteConfig(map[string]int{"a": -1}),
+				Check:  testAccNotificationPolicyRouteCheckTree("a"),
+			},
+			// Two resources can't own the same route
+			{
+				Config: testAccNotificationPolicyRouteConfig(map[string]int{"a": -1}) + `
+resource "grafana_notification_policy_route" "duplicate" {
+	matcher {
+		label = "team"
+		match = "="
+		value = "a"
+	}
+	depends_on = [grafana_notification_policy_route.a]
+}`,
+				ExpectError: regexp.MustCompile(`a route with the matchers \{team="a"\} already exists`),
+			},
+			// A route can't be shadowed by an overlapping route
+			{
+				Config: testAccNotificationPolicyRouteConfig(map[string]int{"a": -1}) + `
+resource "grafana_notification_policy_route" "overlapping" {
+	matcher {
+		label = "team"
+		match = "="
+		value = "a"
+	}
+	matcher {
+		label = "severity"
+		match = "="
+		value = "critical"
+	}
+	depends_on = [grafana_notification_policy_route.a]
+}`,
+				ExpectError: regexp.MustCompile(`the route with the matchers \{severity="critical",team="a"\} conflicts with other routes`),
+			},
+		},
+	})
+}
+
+func testAccNotificationPolicyRouteConfig(routes map[string]int) string {
+	config := ""
+	for team, position := range routes {
+		positionAttr := ""
+		if position >= 0 {
+			positionAttr = fmt.Sprintf("position = %d", position)
+		}
+		config += fmt.Sprintf(`
+resource "grafana_notification_policy_route" "%[1]s" {
+	matcher {
+		label = "team"
+		match = "="
+		value = "%[1]s"
+	}
+	group_by = ["..."]
+	%[2]s
+}
+`, team, positionAttr)
+	}
+	return config
+}
+
+// testAccNotificationPolicyRouteCheckTree checks the values of the team matchers of the top-level routes, in order.
+func testAccNotificationPolicyRouteCheckTree(teams ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testutils.Provider.Meta().(*common.Client).GrafanaAPI.WithOrgID(1)
+		resp, err := client.Provisioning.GetPolicyTree()
+		if err != nil {
+			return err
+		}
+
+		var got []string
+		for _, route := range resp.Payload.Routes {
+			for _, m := range route.ObjectMatchers {
+				if m[0] == "team" {
+					got = append(got, m[2])
+				}
+			}
+		}
+		if fmt.Sprint(got) != fmt.Sprint(teams) {
+			return fmt.Errorf("expected the routes %v in the notification policy tree, got %v", teams, got)
+		}
+		return nil
+	}
+}
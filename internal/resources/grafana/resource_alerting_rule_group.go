@@ -3,6 +3,7 @@ package grafana
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
@@ -10,12 +11,12 @@ import (
 	"strings"
 	"time"
 
-	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/provisioning"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -46,6 +47,7 @@ This resource requires Grafana 9.1.0 or later.
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(validateRuleGroupQueries, diffRuleGroupLabelsAll),
 
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
@@ -309,6 +311,29 @@ This resource requires Grafana 9.1.0 or later.
 					},
 				},
 			},
+			"labels_all": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "All the labels of the rules: the `labels` of each rule and the `default_labels` of the provider. " +
+					"Changing the `default_labels` of the provider updates the rule group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the rule.",
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "All the labels of the rule.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -327,7 +352,7 @@ func listRuleGroups(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int
 	if err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		resp, err := client.Provisioning.GetAlertRules()
 		if err != nil {
-			if err.(runtime.ClientResponseStatus).IsCode(500) || err.(runtime.ClientResponseStatus).IsCode(403) {
+			if isProvisioningStatus(err, 500, 403) {
 				return retry.RetryableError(err)
 			}
 			return retry.NonRetryableError(err)
@@ -350,7 +375,7 @@ func listRuleGroups(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int
 }
 
 func readAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID, idWithoutOrg := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, orgID, idWithoutOrg := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	folderUID, title, found := strings.Cut(idWithoutOrg, common.ResourceIDSeparator)
 	if !found {
@@ -362,12 +387,22 @@ func readAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta any
 		return err
 	}
 
+	datasourceUIDMap := meta.(*common.Client).DatasourceUIDMap
+	// The default labels which aren't set on a rule are hidden from its state
+	defaultLabels := common.DefaultLabelsFromContext(ctx)
+	configuredLabels := map[string]map[string]string{}
+	for _, rule := range data.Get("rule").([]any) {
+		rule := rule.(map[string]any)
+		configuredLabels[rule["name"].(string)] = unpackMap(rule["labels"])
+	}
+
 	g := resp.Payload
 	data.Set("name", g.Title)
 	data.Set("folder_uid", g.FolderUID)
 	data.Set("interval_seconds", g.Interval)
 	disableProvenance := true
 	rules := make([]any, 0, len(g.Rules))
+	labelsAll := make([]any, 0, len(g.Rules))
 	for _, r := range g.Rules {
 		ruleResp, err := client.Provisioning.GetAlertRule(r.UID) // We need to get the rule through a separate API call to get the provenance.
 		if err != nil {
@@ -375,6 +410,10 @@ func readAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta any
 		}
 		r := ruleResp.Payload
 		data.Set("org_id", strconv.FormatInt(*r.OrgID, 10))
+		labels := r.Labels
+		r.Labels = defaultLabels.Strip(r.Labels, configuredLabels[*r.Title])
+		labelsAll = append(labelsAll, packRuleLabelsAll(*r.Title, defaultLabels.All(labels, r.Labels)))
+		mapAlertRuleDatasourceUIDs(r, datasourceUIDMap, true)
 		packed, err := packAlertRule(r)
 		if err != nil {
 			return diag.FromErr(err)
@@ -386,13 +425,14 @@ func readAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta any
 	}
 	data.Set("disable_provenance", disableProvenance)
 	data.Set("rule", rules)
+	data.Set("labels_all", labelsAll)
 	data.SetId(resourceRuleGroupID.Make(orgID, folderUID, title))
 
 	return nil
 }
 
 func putAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, data)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, data)
 
 	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		respAlertRules, err := client.Provisioning.GetAlertRules()
@@ -416,6 +456,8 @@ func putAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta any)
 		folder := data.Get("folder_uid").(string)
 		interval := data.Get("interval_seconds").(int)
 
+		defaultLabels := common.DefaultLabelsFromContext(ctx)
+		datasourceUIDMap := meta.(*common.Client).DatasourceUIDMap
 		packedRules := data.Get("rule").([]any)
 		rules := make([]*models.ProvisionedAlertRule, 0, len(packedRules))
 
@@ -424,6 +466,11 @@ func putAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta any)
 			if err != nil {
 				return retry.NonRetryableError(err)
 			}
+			ruleToApply.Labels = defaultLabels.Merge(ruleToApply.Labels)
+			if err := checkAlertRuleDatasourceUIDs(ruleToApply, datasourceUIDMap); err != nil {
+				return retry.NonRetryableError(err)
+			}
+			mapAlertRuleDatasourceUIDs(ruleToApply, datasourceUIDMap, false)
 
 			// Check if a rule with the same name or uid already exists within the same rule group
 			for _, r := range rules {
@@ -465,7 +512,7 @@ func putAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta any)
 }
 
 func deleteAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, idWithoutOrg := OAPIClientFromExistingOrgResource(meta, data.Id())
+	client, _, idWithoutOrg := OAPIClientFromExistingOrgResource(ctx, meta, data.Id())
 
 	folderUID, title, found := strings.Cut(idWithoutOrg, common.ResourceIDSeparator)
 	if !found {
@@ -489,6 +536,69 @@ func deleteAlertRuleGroup(ctx context.Context, data *schema.ResourceData, meta a
 	return nil
 }
 
+// validateRuleGroupQueries checks the queries and expressions of each rule at plan time, rather than letting the API reject them.
+// Rules with values which are not known yet are skipped.
+func validateRuleGroupQueries(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	var errs []error
+	for i, r := range d.Get("rule").([]any) {
+		rule := r.(map[string]any)
+		prefix := fmt.Sprintf("rule.%d.", i)
+		known := d.NewValueKnown(prefix+"name") && d.NewValueKnown(prefix+"condition") && d.NewValueKnown(prefix+"data") && d.NewValueKnown(prefix+"record")
+
+		var queries []common.AlertQuery
+		for j, q := range rule["data"].([]any) {
+			query := q.(map[string]any)
+			for _, key := range []string{"ref_id", "datasource_uid", "model"} {
+				known = known && d.NewValueKnown(fmt.Sprintf("%sdata.%d.%s", prefix, j, key))
+			}
+			queries = append(queries, common.AlertQuery{
+				RefID:         query["ref_id"].(string),
+				DatasourceUID: query["datasource_uid"].(string),
+				Model:         query["model"].(string),
+			})
+		}
+		if !known {
+			continue
+		}
+
+		condition := rule["condition"].(string)
+		if record, ok := rule["record"].([]any); ok && len(record) > 0 && record[0] != nil {
+			condition = record[0].(map[string]any)["from"].(string)
+		}
+		for _, err := range common.ValidateAlertQueries(queries, condition) {
+			errs = append(errs, fmt.Errorf("rule %q: %w", rule["name"], err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// diffRuleGroupLabelsAll plans the `labels_all` attribute from the labels of the rules and the default labels, see common.DefaultLabels.
+func diffRuleGroupLabelsAll(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	defaultLabels := common.DefaultLabelsFromContext(ctx)
+	rules := d.Get("rule").([]any)
+	planned := make([]any, 0, len(rules))
+	for i, r := range rules {
+		prefix := fmt.Sprintf("rule.%d.", i)
+		if !d.NewValueKnown(prefix+"name") || !d.NewValueKnown(prefix+"labels") {
+			return d.SetNewComputed("labels_all")
+		}
+		rule := r.(map[string]any)
+		planned = append(planned, packRuleLabelsAll(rule["name"].(string), defaultLabels.Merge(unpackMap(rule["labels"]))))
+	}
+	if reflect.DeepEqual(planned, d.Get("labels_all")) {
+		return nil
+	}
+	return d.SetNew("labels_all", planned)
+}
+
+func packRuleLabelsAll(name string, labels map[string]string) any {
+	packed := make(map[string]any, len(labels))
+	for key, value := range labels {
+		packed[key] = value
+	}
+	return map[string]any{"name": name, "labels": packed}
+}
+
 func diffSuppressJSON(k, oldValue, newValue string, data *schema.ResourceData) bool {
 	var o, n any
 	d := json.NewDecoder(strings.NewReader(oldValue))
@@ -646,6 +756,41 @@ func unpackAlertRule(raw any, groupName string, folderUID string, orgID int64) (
 	return &rule, nil
 }
 
+// mapAlertRuleDatasourceUIDs rewrites the datasource references of the queries and of the recording target
+// with the `datasource_uid_map` provider attribute, or reverts them for the rules read from the API.
+func mapAlertRuleDatasourceUIDs(rule *models.ProvisionedAlertRule, uids common.DatasourceUIDMap, reverse bool) {
+	for _, query := range rule.Data {
+		if query == nil {
+			continue
+		}
+		query.DatasourceUID = uids.MapUID(query.DatasourceUID, reverse)
+		uids.MapJSON(query.Model, reverse)
+	}
+	if rule.Record != nil && rule.Record.TargetDatasourceUID != "" {
+		rule.Record.TargetDatasourceUID = uids.MapUID(rule.Record.TargetDatasourceUID, reverse)
+	}
+}
+
+// checkAlertRuleDatasourceUIDs returns an error when the queries or the recording target of the rule reference a UID which another UID
+// is mapped to, see common.DatasourceUIDMap.CheckUID.
+func checkAlertRuleDatasourceUIDs(rule *models.ProvisionedAlertRule, uids common.DatasourceUIDMap) error {
+	for _, query := range rule.Data {
+		if query == nil {
+			continue
+		}
+		if err := uids.CheckUID(query.DatasourceUID); err != nil {
+			return err
+		}
+		if err := uids.CheckJSON(query.Model); err != nil {
+			return err
+		}
+	}
+	if rule.Record != nil && rule.Record.TargetDatasourceUID != "" {
+		return uids.CheckUID(rule.Record.TargetDatasourceUID)
+	}
+	return nil
+}
+
 func packRuleData(queries []*models.AlertQuery) (any, error) {
 	result := []any{}
 	for i := range queries {
@@ -0,0 +1,55 @@
+package grafana
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+func TestMapAlertRuleDatasourceUIDs(t *testing.T) {
+	uids := common.DatasourceUIDMap{"prom-staging": "prom-prod"}
+
+	newRule := func(queryUID, targetUID string) *models.ProvisionedAlertRule {
+		return &models.ProvisionedAlertRule{
+			Data: []*models.AlertQuery{{
+				RefID:         "A",
+				DatasourceUID: queryUID,
+				Model:         map[string]any{"datasource": map[string]any{"uid": queryUID}, "expr": "up"},
+			}},
+			Record: &models.Record{Metric: common.Ref("up_total"), From: common.Ref("A"), TargetDatasourceUID: targetUID},
+		}
+	}
+
+	t.Run("recording rule", func(t *testing.T) {
+		rule := newRule("prom-staging", "prom-staging")
+		mapAlertRuleDatasourceUIDs(rule, uids, false)
+		if want := newRule("prom-prod", "prom-prod"); !reflect.DeepEqual(rule, want) {
+			t.Fatalf("expected the queries and the recording target to be mapped, got %+v", rule.Record)
+		}
+
+		mapAlertRuleDatasourceUIDs(rule, uids, true)
+		if want := newRule("prom-staging", "prom-staging"); !reflect.DeepEqual(rule, want) {
+			t.Fatalf("expected the queries and the recording target to be reverted, got %+v", rule.Record)
+		}
+	})
+
+	t.Run("unmapped recording target", func(t *testing.T) {
+		rule := newRule("prom-staging", "other")
+		mapAlertRuleDatasourceUIDs(rule, uids, false)
+		if want := newRule("prom-prod", "other"); !reflect.DeepEqual(rule, want) {
+			t.Fatalf("expected only the queries to be mapped, got %+v", rule.Record)
+		}
+	})
+
+	t.Run("alert rule", func(t *testing.T) {
+		rule := newRule("prom-staging", "")
+		rule.Record = nil
+		mapAlertRuleDatasourceUIDs(rule, uids, false)
+		if rule.Data[0].DatasourceUID != "prom-prod" {
+			t.Fatalf("expected the query to be mapped, got %q", rule.Data[0].DatasourceUID)
+		}
+	})
+}
@@ -158,7 +158,7 @@ func (r *annotationResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -222,7 +222,7 @@ func (r *annotationResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	client, _, split, parseErr := r.clientFromExistingOrgResource(resourceAnnotationID, data.ID.ValueString())
+	client, _, split, parseErr := r.clientFromExistingOrgResource(ctx, resourceAnnotationID, data.ID.ValueString())
 	if parseErr != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", parseErr.Error())
 		return
@@ -272,7 +272,7 @@ func (r *annotationResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	client, _, split, parseErr := r.clientFromExistingOrgResource(resourceAnnotationID, data.ID.ValueString())
+	client, _, split, parseErr := r.clientFromExistingOrgResource(ctx, resourceAnnotationID, data.ID.ValueString())
 	if parseErr != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", parseErr.Error())
 		return
@@ -292,7 +292,7 @@ func (r *annotationResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 func (r *annotationResource) read(ctx context.Context, id string) (*resourceAnnotationModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	client, orgID, split, err := r.clientFromExistingOrgResource(resourceAnnotationID, id)
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, resourceAnnotationID, id)
 	if err != nil {
 		diags.AddError("Failed to parse resource ID", err.Error())
 		return nil, diags
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -52,7 +53,7 @@ Manages Grafana dashboards.
 			if oldUID != newUID {
 				d.ForceNew("config_json")
 			}
-			return nil
+			return diffDashboardTagsAll(ctx, d)
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -114,6 +115,13 @@ If you decide to use this legacy resource with a Kubernetes-style dashboard defi
 				Optional:    true,
 				Description: "Set a commit message for the version history.",
 			},
+			"tags_all": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "All the tags of the dashboard, sorted: the tags of `config_json` and the `default_labels` of the provider as `key:value` tags. " +
+					"Changing the `default_labels` of the provider updates the dashboard.",
+			},
 		},
 		SchemaVersion: 1, // The state upgrader was removed in v2. To upgrade, users can first upgrade to the last v1 release, apply, then upgrade to v2.
 	}
@@ -123,16 +131,26 @@ If you decide to use this legacy resource with a Kubernetes-style dashboard defi
 		"grafana_dashboard",
 		orgResourceIDString("uid"),
 		schema,
-	).WithLister(listerFunctionOrgResource(listDashboards))
+	).
+		WithLister(listerFunctionFilteredOrgResource(listDashboards)).
+		WithClientValidator(lintDashboardConfig)
 }
 
-func listDashboards(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
-	return listDashboardOrFolder(client, orgID, "dash-db")
+// listDashboards lists the dashboards in the folder and with the tag of the filter. The name of the dashboards is their ID.
+func listDashboards(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64, filter ListerFilter) ([]string, error) {
+	params := search.NewSearchParams().WithType(common.Ref("dash-db"))
+	if filter.Folder != "" {
+		params.SetFolderUIDs([]string{filter.Folder})
+	}
+	if filter.Tag != "" {
+		params.SetTag([]string{filter.Tag})
+	}
+	return listDashboardOrFolder(client, orgID, params)
 }
 
-func listDashboardOrFolder(client *goapi.GrafanaHTTPAPI, orgID int64, searchType string) ([]string, error) {
+func listDashboardOrFolder(client *goapi.GrafanaHTTPAPI, orgID int64, params *search.SearchParams) ([]string, error) {
 	uids := []string{}
-	resp, err := client.Search.Search(search.NewSearchParams().WithType(common.Ref(searchType)))
+	resp, err := client.Search.Search(params)
 	if err != nil {
 		return nil, err
 	}
@@ -145,12 +163,19 @@ func listDashboardOrFolder(client *goapi.GrafanaHTTPAPI, orgID int64, searchType
 }
 
 func CreateDashboard(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	dashboard, err := makeDashboard(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if dashboardJSON, ok := dashboard.Dashboard.(map[string]any); ok {
+		mergeDefaultDashboardTags(dashboardJSON, common.DefaultLabelsFromContext(ctx))
+		if err := meta.(*common.Client).DatasourceUIDMap.CheckJSON(dashboardJSON); err != nil {
+			return diag.FromErr(err)
+		}
+		meta.(*common.Client).DatasourceUIDMap.MapJSON(dashboardJSON, false)
+	}
 
 	if dashboardJSON, ok := dashboard.Dashboard.(map[string]any); ok && isKubernetesStyleDashboard(dashboardJSON) {
 		health, err := client.Health.GetHealth(nil)
@@ -179,7 +204,7 @@ func CreateDashboard(ctx context.Context, d *schema.ResourceData, meta any) diag
 
 func ReadDashboard(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	metaClient := meta.(*common.Client)
-	client, orgID, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	preferredAPIVersion := preferredDashboardAPIVersion(getDashboardReadConfigJSON(d))
 
@@ -207,7 +232,12 @@ func ReadDashboard(ctx context.Context, d *schema.ResourceData, meta any) diag.D
 		return diag.FromErr(err)
 	}
 
+	metaClient.DatasourceUIDMap.MapJSON(remoteDashJSON, true)
 	configJSON := d.Get("config_json").(string)
+	defaultLabels := common.DefaultLabelsFromContext(ctx)
+	remoteTags := dashboardTags(remoteDashJSON)
+	stripDefaultDashboardTags(remoteDashJSON, configJSON, defaultLabels)
+	d.Set("tags_all", defaultLabels.AllTags(remoteTags, dashboardTags(remoteDashJSON)))
 	configJSON, err = normalizeDashboardConfigJSONForState(configJSON, remoteDashJSON)
 	if err != nil {
 		return diag.FromErr(err)
@@ -218,12 +248,19 @@ func ReadDashboard(ctx context.Context, d *schema.ResourceData, meta any) diag.D
 }
 
 func UpdateDashboard(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	dashboard, err := makeDashboard(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if dashboardJSON, ok := dashboard.Dashboard.(map[string]any); ok {
+		mergeDefaultDashboardTags(dashboardJSON, common.DefaultLabelsFromContext(ctx))
+		if err := meta.(*common.Client).DatasourceUIDMap.CheckJSON(dashboardJSON); err != nil {
+			return diag.FromErr(err)
+		}
+		meta.(*common.Client).DatasourceUIDMap.MapJSON(dashboardJSON, false)
+	}
 	if dashboardJSON, ok := dashboard.Dashboard.(map[string]any); ok && !isKubernetesStyleDashboard(dashboardJSON) {
 		dashboardJSON["id"] = d.Get("dashboard_id").(int)
 	}
@@ -237,7 +274,7 @@ func UpdateDashboard(ctx context.Context, d *schema.ResourceData, meta any) diag
 }
 
 func DeleteDashboard(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	_, deleteErr := client.Dashboards.DeleteDashboardByUID(uid)
 	err, _ := common.CheckReadError("dashboard", d, deleteErr)
 	return err
@@ -261,6 +298,74 @@ func makeDashboard(d *schema.ResourceData) (models.SaveDashboardCommand, error)
 	return dashboard, nil
 }
 
+// mergeDefaultDashboardTags adds the default labels of the provider to the tags of the dashboard, as `key:value` tags.
+// Kubernetes-style dashboards are left as they are.
+func mergeDefaultDashboardTags(dashboardJSON map[string]any, defaultLabels common.DefaultLabels) {
+	if len(defaultLabels) == 0 || isKubernetesStyleDashboard(dashboardJSON) {
+		return
+	}
+	dashboardJSON["tags"] = defaultLabels.MergeTags(dashboardTags(dashboardJSON))
+}
+
+// stripDefaultDashboardTags removes the default tags which aren't in the configured `config_json` from the dashboard read from the API,
+// so that they don't show in the diffs.
+func stripDefaultDashboardTags(remoteDashJSON map[string]any, configJSON string, defaultLabels common.DefaultLabels) {
+	if _, ok := remoteDashJSON["tags"]; !ok || len(defaultLabels) == 0 {
+		return
+	}
+	var configuredTags []string
+	if configJSON != "" && !common.SHA256Regexp.MatchString(configJSON) {
+		configuredDashJSON, err := UnmarshalDashboardConfigJSON(configJSON)
+		if err != nil || isKubernetesStyleDashboard(configuredDashJSON) {
+			return
+		}
+		configuredTags = dashboardTags(configuredDashJSON)
+	}
+	remoteDashJSON["tags"] = defaultLabels.StripTags(dashboardTags(remoteDashJSON), configuredTags)
+}
+
+// diffDashboardTagsAll plans the `tags_all` attribute from the tags of the configured `config_json` and the default labels,
+// see common.DefaultLabels. Kubernetes-style dashboards don't get the default tags.
+func diffDashboardTagsAll(ctx context.Context, d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("config_json") {
+		return d.SetNewComputed("tags_all")
+	}
+	// The state of config_json may be its SHA256, the configuration has the dashboard.
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	configJSON := rawConfig.GetAttr("config_json")
+	if configJSON.IsNull() || !configJSON.IsKnown() {
+		return nil
+	}
+	dashboardJSON, err := UnmarshalDashboardConfigJSON(configJSON.AsString())
+	if err != nil {
+		return nil // Reported by the validation of config_json
+	}
+	planned := []string{}
+	if !isKubernetesStyleDashboard(dashboardJSON) {
+		planned = common.DefaultLabelsFromContext(ctx).MergeTags(dashboardTags(dashboardJSON))
+		slices.Sort(planned)
+		planned = slices.Compact(planned)
+	}
+	if slices.Equal(planned, common.ListToStringSlice(d.Get("tags_all").([]any))) {
+		return nil
+	}
+	return d.SetNew("tags_all", planned)
+}
+
+func dashboardTags(dashboardJSON map[string]any) []string {
+	tags := []string{}
+	rawTags, _ := dashboardJSON["tags"].([]any)
+	for _, tag := range rawTags {
+		if tag, ok := tag.(string); ok {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
 func isKubernetesStyleDashboard(dashboardJSON map[string]any) bool {
 	_, hasAPIVersion := dashboardJSON["apiVersion"].(string)
 	_, hasKind := dashboardJSON["kind"].(string)
@@ -435,6 +540,41 @@ func validateDashboardConfigJSON(config any, k string) ([]string, []error) {
 	return nil, nil
 }
 
+// lintDashboardConfig runs the rules of the `dashboard_lint` provider block on `config_json`.
+func lintDashboardConfig(_ context.Context, req schema.ValidateResourceConfigFuncRequest, client *common.Client) diag.Diagnostics {
+	if client == nil || !req.RawConfig.IsKnown() || req.RawConfig.IsNull() {
+		return nil
+	}
+	configJSON := req.RawConfig.GetAttr("config_json")
+	if !configJSON.IsKnown() || configJSON.IsNull() {
+		return nil
+	}
+	return lintDashboardConfigJSON(client.DashboardLint, configJSON.AsString(), cty.GetAttrPath("config_json"), "")
+}
+
+// lintDashboardConfigJSON runs the rules of the `dashboard_lint` provider block on a dashboard model.
+// The issues are reported on the attribute at attributePath, prefixed by the file of the dashboard if it's set.
+func lintDashboardConfigJSON(lint common.DashboardLintConfig, configJSON string, attributePath cty.Path, file string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, issue := range lint.LintDashboardJSON(configJSON) {
+		d := diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Dashboard lint warning",
+			Detail:        issue.String(),
+			AttributePath: attributePath,
+		}
+		if issue.Severity == common.DashboardLintError {
+			d.Severity = diag.Error
+			d.Summary = "Dashboard lint error"
+		}
+		if file != "" {
+			d.Detail = file + ": " + d.Detail
+		}
+		diags = append(diags, d)
+	}
+	return diags
+}
+
 // NormalizeDashboardConfigJSON is the StateFunc for the `config_json` field.
 //
 // It removes the following fields:
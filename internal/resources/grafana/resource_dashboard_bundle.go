@@ -0,0 +1,404 @@
+package grafana
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/folders"
+	"github.com/grafana/grafana-openapi-client-go/models"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDashboardBundle() *common.Resource {
+	schema := &schema.Resource{
+
+		Description: `
+Manages a tree of Grafana folders and dashboards from a local directory.
+
+Each directory containing dashboards is created as a folder, nested in the folder of its parent directory, and each ` + "`.json`" + ` file is upserted as a dashboard in the folder of its directory.
+Files and directories starting with a dot are ignored. Adding, changing or removing a file creates, updates or deletes its dashboard on the next apply.
+Changes made to the dashboards in Grafana are not detected, but deleted dashboards and folders are recreated.
+
+Nested directories require the ` + "`nestedFolders`" + ` feature flag, which is enabled by default since Grafana 11.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/dashboards/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developer-resources/api-reference/http-api/dashboard/)
+`,
+
+		CreateContext: common.WithFolderMutex(common.WithDashboardMutex[schema.CreateContextFunc](applyDashboardBundle)),
+		ReadContext:   readDashboardBundle,
+		UpdateContext: common.WithFolderMutex(common.WithDashboardMutex[schema.UpdateContextFunc](applyDashboardBundle)),
+		DeleteContext: common.WithFolderMutex(common.WithDashboardMutex[schema.DeleteContextFunc](deleteDashboardBundle)),
+
+		CustomizeDiff: diffDashboardBundle,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": orgIDAttribute(),
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The local directory containing the dashboard JSON files.",
+			},
+			"parent_folder_uid": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The UID of the folder in which the folders of the top-level directories are created, and the dashboards at the root of `path` are saved. " +
+					"If not set, the root folder is used.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Set a commit message for the version history of the updated dashboards.",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The SHA256 hash of each dashboard file, keyed by its path relative to `path`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"folder_uids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The UID of the folder of each directory, keyed by its path relative to `path`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"dashboard_uids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The UID of the dashboard of each file, keyed by its path relative to `path`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	return common.NewLegacySDKResource(
+		common.CategoryGrafanaOSS,
+		"grafana_dashboard_bundle",
+		orgResourceIDString("path"),
+		schema,
+	).WithClientValidator(lintDashboardBundle)
+}
+
+// lintDashboardBundle runs the rules of the `dashboard_lint` provider block on the dashboard files of the directory.
+func lintDashboardBundle(_ context.Context, req schema.ValidateResourceConfigFuncRequest, client *common.Client) diag.Diagnostics {
+	if client == nil || len(client.DashboardLint) == 0 || !req.RawConfig.IsKnown() || req.RawConfig.IsNull() {
+		return nil
+	}
+	dir := req.RawConfig.GetAttr("path")
+	if !dir.IsKnown() || dir.IsNull() {
+		return nil
+	}
+	contents, err := readDashboardBundleFiles(dir.AsString())
+	if err != nil {
+		return nil // Reported by the plan
+	}
+
+	var diags diag.Diagnostics
+	for _, file := range slices.Sorted(maps.Keys(contents)) {
+		diags = append(diags, lintDashboardConfigJSON(client.DashboardLint, string(contents[file]), cty.GetAttrPath("path"), file)...)
+	}
+	return diags
+}
+
+// diffDashboardBundle reads the directory at plan time, so that changed files show up as changes of the `files` attribute.
+func diffDashboardBundle(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("path") {
+		for _, key := range []string{"files", "folder_uids", "dashboard_uids"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	contents, err := readDashboardBundleFiles(d.Get("path").(string))
+	if err != nil {
+		return err
+	}
+
+	uids := map[string]string{}
+	for _, file := range slices.Sorted(maps.Keys(contents)) {
+		dashboardJSON, err := UnmarshalDashboardConfigJSON(string(contents[file]))
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		if uid, _ := dashboardJSON["uid"].(string); uid != "" {
+			if other, ok := uids[uid]; ok {
+				return fmt.Errorf("the dashboards %s and %s have the same uid %q", other, file, uid)
+			}
+			uids[uid] = file
+		}
+	}
+
+	oldFiles, _ := d.GetChange("files")
+	files := hashDashboardBundleFiles(contents)
+	if !maps.Equal(stringMapFromState(oldFiles), files) {
+		if err := d.SetNew("files", files); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("dashboard_uids"); err != nil {
+			return err
+		}
+	}
+
+	oldFolders, _ := d.GetChange("folder_uids")
+	if !slices.Equal(slices.Sorted(maps.Keys(stringMapFromState(oldFolders))), dashboardBundleFolders(files)) {
+		return d.SetNewComputed("folder_uids")
+	}
+	return nil
+}
+
+func applyDashboardBundle(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
+	d.SetId(MakeOrgResourceID(orgID, d.Get("path").(string)))
+
+	contents, err := readDashboardBundleFiles(d.Get("path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hashes := hashDashboardBundleFiles(contents)
+
+	// The state is updated as the folders and dashboards are applied, so that a failed apply is resumed by the next one
+	oldFiles, _ := d.GetChange("files")
+	oldFolders, _ := d.GetChange("folder_uids")
+	oldDashboards, _ := d.GetChange("dashboard_uids")
+	files := stringMapFromState(oldFiles)
+	folderUIDs := stringMapFromState(oldFolders)
+	dashboardUIDs := stringMapFromState(oldDashboards)
+	saveState := func(diags diag.Diagnostics) diag.Diagnostics {
+		d.Set("files", files)
+		d.Set("folder_uids", folderUIDs)
+		d.Set("dashboard_uids", dashboardUIDs)
+		return diags
+	}
+
+	parentFolderUID := d.Get("parent_folder_uid").(string)
+	folderUID := func(dir string) string {
+		if dir == "." {
+			return parentFolderUID
+		}
+		return folderUIDs[dir]
+	}
+
+	wantedFolders := dashboardBundleFolders(hashes)
+	for _, dir := range wantedFolders {
+		if _, ok := folderUIDs[dir]; ok {
+			continue
+		}
+		resp, err := client.Folders.CreateFolder(&models.CreateFolderCommand{
+			Title:     path.Base(dir),
+			ParentUID: folderUID(path.Dir(dir)),
+		})
+		if err != nil {
+			return saveState(diag.Errorf("failed to create the folder of %s: %s", dir, err))
+		}
+		folderUIDs[dir] = resp.GetPayload().UID
+	}
+
+	for _, file := range slices.Sorted(maps.Keys(contents)) {
+		if files[file] == hashes[file] && dashboardUIDs[file] != "" {
+			continue
+		}
+
+		dashboardJSON, err := UnmarshalDashboardConfigJSON(string(contents[file]))
+		if err != nil {
+			return saveState(diag.Errorf("%s: %s", file, err))
+		}
+		delete(dashboardJSON, "id")
+		resp, err := client.Dashboards.PostDashboard(&models.SaveDashboardCommand{
+			Dashboard: dashboardJSON,
+			FolderUID: folderUID(path.Dir(file)),
+			Message:   d.Get("message").(string),
+			Overwrite: true,
+		})
+		if err != nil {
+			return saveState(diag.Errorf("failed to save the dashboard of %s: %s", file, err))
+		}
+
+		// The uid of the dashboard was changed in the file
+		if oldUID := dashboardUIDs[file]; oldUID != "" && oldUID != *resp.Payload.UID {
+			if err := deleteBundleDashboard(client, oldUID); err != nil {
+				return saveState(diag.Errorf("failed to delete the previous dashboard of %s: %s", file, err))
+			}
+		}
+		files[file] = hashes[file]
+		dashboardUIDs[file] = *resp.Payload.UID
+	}
+
+	for _, file := range slices.Sorted(maps.Keys(dashboardUIDs)) {
+		if _, ok := contents[file]; ok {
+			continue
+		}
+		if err := deleteBundleDashboard(client, dashboardUIDs[file]); err != nil {
+			return saveState(diag.Errorf("failed to delete the dashboard of %s: %s", file, err))
+		}
+		delete(files, file)
+		delete(dashboardUIDs, file)
+	}
+
+	// Children first
+	for _, dir := range slices.Backward(slices.Sorted(maps.Keys(folderUIDs))) {
+		if slices.Contains(wantedFolders, dir) {
+			continue
+		}
+		if err := deleteBundleFolder(client, folderUIDs[dir]); err != nil {
+			return saveState(diag.Errorf("failed to delete the folder of %s: %s", dir, err))
+		}
+		delete(folderUIDs, dir)
+	}
+
+	if diags := saveState(nil); diags.HasError() {
+		return diags
+	}
+	return readDashboardBundle(ctx, d, meta)
+}
+
+// readDashboardBundle removes the folders and dashboards which were deleted in Grafana from the state, so that they are recreated.
+func readDashboardBundle(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, orgID, bundlePath := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+
+	files := stringMapFromState(d.Get("files"))
+	folderUIDs := stringMapFromState(d.Get("folder_uids"))
+	dashboardUIDs := stringMapFromState(d.Get("dashboard_uids"))
+
+	for dir, uid := range folderUIDs {
+		_, err := client.Folders.GetFolderByUID(uid)
+		if err != nil && !common.IsNotFoundError(err) {
+			return diag.Errorf("failed to read the folder of %s: %s", dir, err)
+		}
+		if err != nil {
+			delete(folderUIDs, dir)
+		}
+	}
+	for file, uid := range dashboardUIDs {
+		_, err := client.Dashboards.GetDashboardByUID(uid)
+		if err != nil && !common.IsNotFoundError(err) {
+			return diag.Errorf("failed to read the dashboard of %s: %s", file, err)
+		}
+		if err != nil {
+			delete(dashboardUIDs, file)
+			delete(files, file)
+		}
+	}
+
+	d.Set("org_id", strconv.FormatInt(orgID, 10))
+	d.Set("path", bundlePath)
+	d.Set("files", files)
+	d.Set("folder_uids", folderUIDs)
+	d.Set("dashboard_uids", dashboardUIDs)
+	return nil
+}
+
+func deleteDashboardBundle(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, _, _ := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
+
+	dashboardUIDs := stringMapFromState(d.Get("dashboard_uids"))
+	for _, file := range slices.Sorted(maps.Keys(dashboardUIDs)) {
+		if err := deleteBundleDashboard(client, dashboardUIDs[file]); err != nil {
+			return diag.Errorf("failed to delete the dashboard of %s: %s", file, err)
+		}
+	}
+
+	folderUIDs := stringMapFromState(d.Get("folder_uids"))
+	for _, dir := range slices.Backward(slices.Sorted(maps.Keys(folderUIDs))) {
+		if err := deleteBundleFolder(client, folderUIDs[dir]); err != nil {
+			return diag.Errorf("failed to delete the folder of %s: %s", dir, err)
+		}
+	}
+	return nil
+}
+
+func deleteBundleDashboard(client *goapi.GrafanaHTTPAPI, uid string) error {
+	if _, err := client.Dashboards.DeleteDashboardByUID(uid); err != nil && !common.IsNotFoundError(err) {
+		return err
+	}
+	return nil
+}
+
+func deleteBundleFolder(client *goapi.GrafanaHTTPAPI, uid string) error {
+	if _, err := client.Folders.DeleteFolder(folders.NewDeleteFolderParams().WithFolderUID(uid)); err != nil && !common.IsNotFoundError(err) {
+		return err
+	}
+	return nil
+}
+
+// readDashboardBundleFiles returns the content of each JSON file of the directory, keyed by its slash-separated path relative to the directory.
+func readDashboardBundleFiles(dir string) (map[string][]byte, error) {
+	contents := map[string][]byte{}
+	err := filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if filePath != dir && strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() || filepath.Ext(filePath) != ".json" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		contents[filepath.ToSlash(rel)] = content
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the dashboards of %s: %w", dir, err)
+	}
+	return contents, nil
+}
+
+func hashDashboardBundleFiles(contents map[string][]byte) map[string]string {
+	hashes := map[string]string{}
+	for file, content := range contents {
+		hash := sha256.Sum256(content)
+		hashes[file] = hex.EncodeToString(hash[:])
+	}
+	return hashes
+}
+
+// dashboardBundleFolders returns the directories which contain dashboards, directly or in their subdirectories.
+// Parent directories are sorted before their children.
+func dashboardBundleFolders(files map[string]string) []string {
+	dirs := map[string]bool{}
+	for file := range files {
+		for dir := path.Dir(file); dir != "."; dir = path.Dir(dir) {
+			dirs[dir] = true
+		}
+	}
+	return slices.Sorted(maps.Keys(dirs))
+}
+
+func stringMapFromState(value any) map[string]string {
+	m := map[string]string{}
+	raw, _ := value.(map[string]any)
+	for k, v := range raw {
+		m[k], _ = v.(string)
+	}
+	return m
+}
@@ -0,0 +1,45 @@
+package grafana
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadDashboardBundleFiles(t *testing.T) {
+	dir := t.TempDir()
+	for file, content := range map[string]string{
+		"root.json":             `{"title": "Root"}`,
+		"a/a.json":              `{"title": "A"}`,
+		"a/b/c/c.json":          `{"title": "C"}`,
+		"a/notes.txt":           "Not a dashboard",
+		".git/config.json":      "{}",
+		"d/.draft.json":         "{}",
+		"empty/.gitkeep":        "",
+		"empty/nested/.gitkeep": "",
+	} {
+		filePath := filepath.Join(dir, filepath.FromSlash(file))
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o750))
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0o600))
+	}
+
+	contents, err := readDashboardBundleFiles(dir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"root.json":    []byte(`{"title": "Root"}`),
+		"a/a.json":     []byte(`{"title": "A"}`),
+		"a/b/c/c.json": []byte(`{"title": "C"}`),
+	}, contents)
+
+	hashes := hashDashboardBundleFiles(contents)
+	assert.Equal(t, "3a36499271d77c7af37e98c5670a8b96c759f5c79b57d34cc9e0fc186e8d384f", hashes["root.json"])
+
+	// Directories without dashboards have no folder, parents are created first
+	assert.Equal(t, []string{"a", "a/b", "a/b/c"}, dashboardBundleFolders(hashes))
+
+	_, err = readDashboardBundleFiles(filepath.Join(dir, "missing"))
+	assert.ErrorContains(t, err, "failed to read the dashboards of")
+}
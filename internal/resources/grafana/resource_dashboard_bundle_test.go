@@ -0,0 +1,156 @@
+package grafana_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/testutils"
+)
+
+func TestAccDashboardBundle_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=11.0.0") // Nested folders are enabled by default since Grafana 11
+
+	var overview, api models.DashboardFullWithMeta
+	var servicesFolder models.Folder
+
+	dir := t.TempDir()
+	prefix := acctest.RandString(8)
+	writeDashboard := func(file, title string) {
+		filePath := filepath.Join(dir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		content := fmt.Sprintf(`{"title": %q, "uid": %q}`, title, prefix+"-"+strings.TrimSuffix(path.Base(file), ".json"))
+		if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	removeFile := func(file string) {
+		if err := os.RemoveAll(filepath.Join(dir, filepath.FromSlash(file))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// checkBundleDashboard checks that the dashboard of a file exists, with the given title, in the folder of its directory
+	checkBundleDashboard := func(file, title string, v *models.DashboardFullWithMeta) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			attributes := s.RootModule().Resources["grafana_dashboard_bundle.test"].Primary.Attributes
+			client := testutils.Provider.Meta().(*common.Client).GrafanaAPI.WithOrgID(1)
+			resp, err := client.Dashboards.GetDashboardByUID(attributes["dashboard_uids."+file])
+			if err != nil {
+				return fmt.Errorf("error getting the dashboard of %s: %w", file, err)
+			}
+			if got := resp.Payload.Dashboard.(map[string]any)["title"]; got != title {
+				return fmt.Errorf("expected the dashboard of %s to have the title %q, got %q", file, title, got)
+			}
+			expectedFolderUID := attributes["parent_folder_uid"]
+			if dir := filepath.ToSlash(filepath.Dir(file)); dir != "." {
+				expectedFolderUID = attributes["folder_uids."+dir]
+			}
+			if resp.Payload.Meta.FolderUID != expectedFolderUID {
+				return fmt.Errorf("expected the dashboard of %s to be in the folder %q, got %q", file, expectedFolderUID, resp.Payload.Meta.FolderUID)
+			}
+			*v = *resp.Payload
+			return nil
+		}
+	}
+
+	config := fmt.Sprintf(`
+resource "grafana_folder" "bundle" {
+  title = "%[1]s"
+}
+
+resource "grafana_dashboard_bundle" "test" {
+  path              = "%[2]s"
+  parent_folder_uid = grafana_folder.bundle.uid
+}
+`, prefix, filepath.ToSlash(dir))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testutils.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			dashboardCheckExists.destroyed(&api, nil),
+			folderCheckExists.destroyed(&servicesFolder, nil),
+		),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeDashboard("overview.json", "Overview")
+					writeDashboard("team/team.json", "Team")
+					writeDashboard("team/services/api.json", "API")
+					writeDashboard(".hidden/ignored.json", "Ignored")
+					if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("Not a dashboard"), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_dashboard_bundle.test", "files.%", "3"),
+					resource.TestCheckResourceAttr("grafana_dashboard_bundle.test", "folder_uids.%", "2"),
+					resource.TestCheckResourceAttr("grafana_dashboard_bundle.test", "dashboard_uids.%", "3"),
+					resource.TestCheckResourceAttr("grafana_dashboard_bundle.test", "dashboard_uids.team/services/api.json", prefix+"-api"),
+					checkBundleDashboard("overview.json", "Overview", &overview),
+					checkBundleDashboard("team/team.json", "Team", &models.DashboardFullWithMeta{}),
+					checkBundleDashboard("team/services/api.json", "API", &api),
+					folderCheckExists.exists("grafana_folder.bundle", &models.Folder{}),
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources["grafana_dashboard_bundle.test"].Primary.Attributes
+						client := testutils.Provider.Meta().(*common.Client).GrafanaAPI.WithOrgID(1)
+						resp, err := client.Folders.GetFolderByUID(attributes["folder_uids.team/services"])
+						if err != nil {
+							return err
+						}
+						if resp.Payload.Title != "services" || resp.Payload.ParentUID != attributes["folder_uids.team"] {
+							return fmt.Errorf("expected the services folder to be nested in the team folder, got %+v", resp.Payload)
+						}
+						servicesFolder = *resp.Payload
+						return nil
+					},
+				),
+			},
+			// No changes
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			// Update, add and remove files
+			{
+				PreConfig: func() {
+					writeDashboard("team/team.json", "Team (updated)")
+					writeDashboard("team/oncall.json", "On-call")
+					removeFile("overview.json")
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.destroyed(&overview, nil),
+					resource.TestCheckResourceAttr("grafana_dashboard_bundle.test", "files.%", "3"),
+					resource.TestCheckNoResourceAttr("grafana_dashboard_bundle.test", "dashboard_uids.overview.json"),
+					checkBundleDashboard("team/team.json", "Team (updated)", &models.DashboardFullWithMeta{}),
+					checkBundleDashboard("team/oncall.json", "On-call", &models.DashboardFullWithMeta{}),
+				),
+			},
+			// Removing a directory deletes its folder
+			{
+				PreConfig: func() {
+					removeFile("team/services")
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					dashboardCheckExists.destroyed(&api, nil),
+					folderCheckExists.destroyed(&servicesFolder, nil),
+					resource.TestCheckResourceAttr("grafana_dashboard_bundle.test", "folder_uids.%", "1"),
+				),
+			},
+		},
+	})
+}
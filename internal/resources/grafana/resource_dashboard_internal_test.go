@@ -4,11 +4,17 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 )
 
 func TestIsKubernetesStyleDashboard(t *testing.T) {
@@ -89,6 +95,75 @@ func TestNormalizeDashboardConfigJSONForState(t *testing.T) {
 	})
 }
 
+func TestDefaultDashboardTags(t *testing.T) {
+	defaultLabels := common.DefaultLabels{"managed-by": "terraform", "team": "default-team"}
+
+	t.Run("merges the default labels as tags", func(t *testing.T) {
+		dashboardJSON := map[string]any{"title": "legacy dashboard", "tags": []any{"team:my-team", "prod"}}
+		mergeDefaultDashboardTags(dashboardJSON, defaultLabels)
+		want := []string{"team:my-team", "prod", "managed-by:terraform"}
+		if got := dashboardJSON["tags"]; !reflect.DeepEqual(got, want) {
+			t.Fatalf("expected tags %v, got %v", want, got)
+		}
+	})
+
+	t.Run("leaves kubernetes dashboards as they are", func(t *testing.T) {
+		dashboardJSON := map[string]any{"apiVersion": "dashboard.grafana.app/v2beta1", "kind": "Dashboard", "spec": map[string]any{}}
+		mergeDefaultDashboardTags(dashboardJSON, defaultLabels)
+		if _, ok := dashboardJSON["tags"]; ok {
+			t.Fatal("expected no tags to be added to a kubernetes dashboard")
+		}
+	})
+
+	t.Run("hides the default tags which aren't configured", func(t *testing.T) {
+		remoteDashJSON := map[string]any{"title": "legacy dashboard", "tags": []any{"team:default-team", "managed-by:terraform", "prod"}}
+		stripDefaultDashboardTags(remoteDashJSON, `{"title":"legacy dashboard","tags":["team:default-team","prod"]}`, defaultLabels)
+		want := []string{"team:default-team", "prod"}
+		if got := remoteDashJSON["tags"]; !reflect.DeepEqual(got, want) {
+			t.Fatalf("expected tags %v, got %v", want, got)
+		}
+	})
+}
+
+func TestLintDashboardConfig(t *testing.T) {
+	client := &common.Client{DashboardLint: common.DashboardLintConfig{
+		"no_hardcoded_id":  common.DashboardLintWarning,
+		"unique_panel_ids": common.DashboardLintError,
+	}}
+	config := func(configJSON cty.Value) schema.ValidateResourceConfigFuncRequest {
+		return schema.ValidateResourceConfigFuncRequest{RawConfig: cty.ObjectVal(map[string]cty.Value{"config_json": configJSON})}
+	}
+	dashboardJSON := cty.StringVal(`{"id": 1, "panels": [{"id": 1}, {"id": 1}]}`)
+
+	t.Run("returns the warnings and errors of the rules", func(t *testing.T) {
+		diags := lintDashboardConfig(context.Background(), config(dashboardJSON), client)
+		if len(diags) != 2 {
+			t.Fatalf("expected 2 diagnostics, got %v", diags)
+		}
+		if diags[0].Severity != diag.Warning || diags[0].Detail != "no_hardcoded_id: the dashboard sets the `id` field (1), remove it to let Grafana assign it" {
+			t.Fatalf("unexpected warning %+v", diags[0])
+		}
+		if diags[1].Severity != diag.Error || diags[1].Summary != "Dashboard lint error" {
+			t.Fatalf("unexpected error %+v", diags[1])
+		}
+		if !diags[1].AttributePath.Equals(cty.GetAttrPath("config_json")) {
+			t.Fatalf("expected the error on config_json, got %v", diags[1].AttributePath)
+		}
+	})
+
+	t.Run("skips the validation without provider", func(t *testing.T) {
+		if diags := lintDashboardConfig(context.Background(), config(dashboardJSON), nil); len(diags) > 0 {
+			t.Fatalf("expected no diagnostics, got %v", diags)
+		}
+	})
+
+	t.Run("skips unknown dashboards", func(t *testing.T) {
+		if diags := lintDashboardConfig(context.Background(), config(cty.UnknownVal(cty.String)), client); len(diags) > 0 {
+			t.Fatalf("expected no diagnostics, got %v", diags)
+		}
+	})
+}
+
 func TestPreferredDashboardAPIVersion(t *testing.T) {
 	t.Run("extracts version from kubernetes dashboard config", func(t *testing.T) {
 		configJSON := `{"apiVersion":"dashboard.grafana.app/v2beta1","kind":"Dashboard","metadata":{"name":"test-dashboard"},"spec":{"title":"test dashboard"}}`
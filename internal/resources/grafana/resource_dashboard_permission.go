@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,7 +31,27 @@ func makeResourceDashboardPermission() *common.Resource {
 				resourceType: dashboardsPermissionsType,
 			},
 		},
-	)
+	).WithLister(listerFunctionOrgResource(listDashboardPermissions))
+}
+
+func listDashboardPermissions(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
+	dashboardIDs, err := listDashboards(ctx, client, orgID, ListerFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, id := range dashboardIDs {
+		_, dashboardUID := SplitOrgResourceID(id)
+		custom, err := hasCustomPermissions(client, dashboardsPermissionsType, dashboardUID)
+		if err != nil {
+			return nil, err
+		}
+		if custom {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
 }
 
 type resourceDashboardPermissionModel struct {
@@ -77,7 +98,7 @@ func (r *resourceDashboardPermission) Schema(ctx context.Context, req resource.S
 }
 
 func (r *resourceDashboardPermission) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	client, orgID, split, err := r.clientFromExistingOrgResource(resourceDashboardPermissionID, req.ID)
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, resourceDashboardPermissionID, req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse import ID", err.Error())
 		return
@@ -113,7 +134,7 @@ func (r *resourceDashboardPermission) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -153,7 +174,7 @@ func (r *resourceDashboardPermission) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	client, orgID, split, err := r.clientFromExistingOrgResource(resourceDashboardPermissionID, data.ID.ValueString())
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, resourceDashboardPermissionID, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
@@ -192,7 +213,7 @@ func (r *resourceDashboardPermission) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -224,7 +245,7 @@ func (r *resourceDashboardPermission) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	client, _, split, err := r.clientFromExistingOrgResource(resourceDashboardPermissionID, data.ID.ValueString())
+	client, _, split, err := r.clientFromExistingOrgResource(ctx, resourceDashboardPermissionID, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
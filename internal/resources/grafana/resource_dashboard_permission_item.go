@@ -88,7 +88,7 @@ func (r *resourceDashboardPermissionItem) Schema(ctx context.Context, req resour
 }
 
 func (r *resourceDashboardPermissionItem) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	readData, diags := r.readItem(req.ID, r.dashboardQuery, nil)
+	readData, diags := r.readItem(ctx, req.ID, r.dashboardQuery, nil)
 	if diags != nil {
 		resp.Diagnostics = diags
 		return
@@ -111,7 +111,7 @@ func (r *resourceDashboardPermissionItem) Create(ctx context.Context, req resour
 		return
 	}
 	base := data.ToBase()
-	if diags := r.writeItem(data.DashboardUID.ValueString(), base); diags != nil {
+	if diags := r.writeItem(ctx, data.DashboardUID.ValueString(), base); diags != nil {
 		resp.Diagnostics = diags
 		return
 	}
@@ -126,7 +126,7 @@ func (r *resourceDashboardPermissionItem) Read(ctx context.Context, req resource
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	// Read from API
-	readData, diags := r.readItem(data.ID.ValueString(), r.dashboardQuery, nil)
+	readData, diags := r.readItem(ctx, data.ID.ValueString(), r.dashboardQuery, nil)
 	if diags != nil {
 		resp.Diagnostics = diags
 		return
@@ -149,7 +149,7 @@ func (r *resourceDashboardPermissionItem) Update(ctx context.Context, req resour
 		return
 	}
 	base := data.ToBase()
-	if diags := r.writeItem(data.DashboardUID.ValueString(), base); diags != nil {
+	if diags := r.writeItem(ctx, data.DashboardUID.ValueString(), base); diags != nil {
 		resp.Diagnostics = diags
 		return
 	}
@@ -164,7 +164,7 @@ func (r *resourceDashboardPermissionItem) Delete(ctx context.Context, req resour
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	data.Permission = types.StringValue("")
 
-	if diags := r.writeItem(data.DashboardUID.ValueString(), data.ToBase()); diags != nil {
+	if diags := r.writeItem(ctx, data.DashboardUID.ValueString(), data.ToBase()); diags != nil {
 		resp.Diagnostics = diags
 	}
 }
@@ -153,7 +153,7 @@ func (r *publicDashboardResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -204,7 +204,7 @@ func (r *publicDashboardResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	client, orgID, split, err := r.clientFromExistingOrgResource(resourcePublicDashboardID, data.ID.ValueString())
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, resourcePublicDashboardID, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
@@ -240,7 +240,7 @@ func (r *publicDashboardResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	client, _, split, err := r.clientFromExistingOrgResource(resourcePublicDashboardID, data.ID.ValueString())
+	client, _, split, err := r.clientFromExistingOrgResource(ctx, resourcePublicDashboardID, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
@@ -254,10 +254,10 @@ func (r *publicDashboardResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
-func (r *publicDashboardResource) read(_ context.Context, id string) (*resourcePublicDashboardModel, diag.Diagnostics) {
+func (r *publicDashboardResource) read(ctx context.Context, id string) (*resourcePublicDashboardModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	client, orgID, split, err := r.clientFromExistingOrgResource(resourcePublicDashboardID, id)
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, resourcePublicDashboardID, id)
 	if err != nil {
 		diags.AddError("Failed to parse resource ID", err.Error())
 		return nil, diags
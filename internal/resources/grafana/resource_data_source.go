@@ -38,7 +38,7 @@ source selected (via the 'type' argument).
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-				client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+				client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 				resp, err := client.Datasources.GetDataSourceByUID(idStr)
 				if err != nil {
@@ -238,7 +238,7 @@ func listDatasources(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID in
 
 // CreateDataSource creates a Grafana datasource
 func CreateDataSource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	dataSource, err := stateToDatasource(d)
 	if err != nil {
@@ -259,7 +259,7 @@ func CreateDataSource(ctx context.Context, d *schema.ResourceData, meta any) dia
 
 // UpdateDataSource updates a Grafana datasource
 func UpdateDataSource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	dataSource, err := stateToDatasource(d)
 	if err != nil {
@@ -294,7 +294,7 @@ func UpdateDataSource(ctx context.Context, d *schema.ResourceData, meta any) dia
 
 // ReadDataSource reads a Grafana datasource
 func ReadDataSource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	resp, err := client.Datasources.GetDataSourceByUID(idStr)
 	if err, shouldReturn := common.CheckReadError("datasource", d, err); shouldReturn {
@@ -306,7 +306,7 @@ func ReadDataSource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 // DeleteDataSource deletes a Grafana datasource
 func DeleteDataSource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	_, err := client.Datasources.DeleteDataSourceByUID(idStr)
 	diag, _ := common.CheckReadError("datasource", d, err)
@@ -86,7 +86,7 @@ Deleting this resource will cause the cache to be disabled for the target data s
 }
 
 func CreateOrUpdateDataSourceCacheConfig(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _ := OAPIClientFromNewOrgResource(meta, d)
+	client, _ := OAPIClientFromNewOrgResource(ctx, meta, d)
 	dsUID := d.Get("datasource_uid").(string)
 
 	// If enabled is explicitly set to false, disable via dedicated endpoint to avoid omitempty issues.
@@ -134,7 +134,7 @@ func CreateOrUpdateDataSourceCacheConfig(ctx context.Context, d *schema.Resource
 }
 
 func ReadDataSourceCacheConfig(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	// idStr is datasource UID
 	resp, err := client.Enterprise.GetDataSourceCacheConfig(enterprise.NewGetDataSourceCacheConfigParams().WithDataSourceUID(idStr))
@@ -163,7 +163,7 @@ func ReadDataSourceCacheConfig(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func DeleteDataSourceCacheConfig(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	_, err := client.Enterprise.DisableDataSourceCache(enterprise.NewDisableDataSourceCacheParams().WithDataSourceUID(idStr))
 	if err != nil {
 		return diag.FromErr(err)
@@ -53,7 +53,7 @@ func resourceDataSourceConfig() *common.Resource {
 }
 
 func UpdateDataSourceConfig(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _ := OAPIClientFromNewOrgResource(meta, d)
+	client, _ := OAPIClientFromNewOrgResource(ctx, meta, d)
 	if diag := updateGrafanaDataSourceConfig(d, d.Get("uid").(string), client); diag.HasError() {
 		return diag
 	}
@@ -61,7 +61,7 @@ func UpdateDataSourceConfig(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 func ReadDataSourceConfig(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	resp, err := client.Datasources.GetDataSourceByUID(idStr)
 	if err, shouldReturn := common.CheckReadError("datasource", d, err); shouldReturn {
@@ -74,7 +74,7 @@ func ReadDataSourceConfig(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func DeleteDataSourceConfig(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	d.Set("json_data_encoded", "")
 	return updateGrafanaDataSourceConfig(d, idStr, client)
 }
@@ -1,6 +1,9 @@
 package grafana
 
 import (
+	"context"
+
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/client/access_control"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +24,8 @@ func resourceDatasourcePermission() *common.Resource {
 		roleAttribute:             "built_in_role",
 		getResource:               resourceDatasourcePermissionGet,
 		listPermissionsClientOpts: datasourcePermissionListClientOptsFromState,
-		buildClientOptions: func(d *schema.ResourceData, meta any) ([]access_control.ClientOption, error) {
-			dsType, err := resourceDatasourcePermissionGetType(d, meta)
+		buildClientOptions: func(ctx context.Context, d *schema.ResourceData, meta any) ([]access_control.ClientOption, error) {
+			dsType, err := resourceDatasourcePermissionGetType(ctx, d, meta)
 			if err != nil {
 				return nil, err
 			}
@@ -69,14 +72,33 @@ Manages the entire set of permissions for a datasource. Permissions that aren't
 		"grafana_data_source_permission",
 		orgResourceIDInt("datasourceID"),
 		schema,
-	)
+	).WithLister(listerFunctionOrgResource(listDatasourcePermissions))
+}
+
+func listDatasourcePermissions(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
+	resp, err := client.Datasources.GetDataSources()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, ds := range resp.Payload {
+		custom, err := hasCustomPermissions(client, datasourcesPermissionsType, ds.UID, withQueryParam("ds_type", ds.Type))
+		if err != nil {
+			return nil, err
+		}
+		if custom {
+			ids = append(ids, MakeOrgResourceID(orgID, ds.UID))
+		}
+	}
+	return ids, nil
 }
 
-func resourceDatasourcePermissionGet(d *schema.ResourceData, meta any) (string, error) {
-	client, _ := OAPIClientFromNewOrgResource(meta, d)
+func resourceDatasourcePermissionGet(ctx context.Context, d *schema.ResourceData, meta any) (string, error) {
+	client, _ := OAPIClientFromNewOrgResource(ctx, meta, d)
 	_, id := SplitOrgResourceID(d.Get("datasource_uid").(string))
 	if d.Id() != "" {
-		client, _, id = OAPIClientFromExistingOrgResource(meta, d.Id())
+		client, _, id = OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	}
 	if id == "*" {
 		return "*", nil
@@ -90,14 +112,14 @@ func resourceDatasourcePermissionGet(d *schema.ResourceData, meta any) (string,
 	return datasource.UID, nil
 }
 
-func resourceDatasourcePermissionGetType(d *schema.ResourceData, meta any) (string, error) {
+func resourceDatasourcePermissionGetType(ctx context.Context, d *schema.ResourceData, meta any) (string, error) {
 	if t, ok := d.GetOk("datasource_type"); ok {
 		return t.(string), nil
 	}
-	client, _ := OAPIClientFromNewOrgResource(meta, d)
+	client, _ := OAPIClientFromNewOrgResource(ctx, meta, d)
 	_, id := SplitOrgResourceID(d.Get("datasource_uid").(string))
 	if d.Id() != "" {
-		client, _, id = OAPIClientFromExistingOrgResource(meta, d.Id())
+		client, _, id = OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	}
 	if id == "*" {
 		return "", nil
@@ -99,7 +99,7 @@ func (r *resourceDatasourcePermissionItem) Schema(ctx context.Context, req resou
 }
 
 func (r *resourceDatasourcePermissionItem) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	readData, diags := r.readItem(req.ID, r.datasourceQuery, nil)
+	readData, diags := r.readItem(ctx, req.ID, r.datasourceQuery, nil)
 	if diags != nil {
 		resp.Diagnostics = diags
 		return
@@ -122,13 +122,13 @@ func (r *resourceDatasourcePermissionItem) Create(ctx context.Context, req resou
 		return
 	}
 	_, dsUID := SplitOrgResourceID(data.DatasourceUID.ValueString())
-	writeOpts, err := r.resolveWriteOpts(data.OrgID.ValueString(), dsUID, data.DatasourceType)
+	writeOpts, err := r.resolveWriteOpts(ctx, data.OrgID.ValueString(), dsUID, data.DatasourceType)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to resolve datasource type", err.Error())
 		return
 	}
 	base := data.ToBase()
-	if diags := r.writeItem(data.DatasourceUID.ValueString(), base, writeOpts...); diags != nil {
+	if diags := r.writeItem(ctx, data.DatasourceUID.ValueString(), base, writeOpts...); diags != nil {
 		resp.Diagnostics = diags
 		return
 	}
@@ -148,7 +148,7 @@ func (r *resourceDatasourcePermissionItem) Read(ctx context.Context, req resourc
 	}
 
 	// Read from API
-	readData, diags := r.readItem(data.ID.ValueString(), r.datasourceQuery, getOpts)
+	readData, diags := r.readItem(ctx, data.ID.ValueString(), r.datasourceQuery, getOpts)
 	if diags != nil {
 		resp.Diagnostics = diags
 		return
@@ -171,13 +171,13 @@ func (r *resourceDatasourcePermissionItem) Update(ctx context.Context, req resou
 		return
 	}
 	_, dsUID := SplitOrgResourceID(data.DatasourceUID.ValueString())
-	writeOpts, err := r.resolveWriteOpts(data.OrgID.ValueString(), dsUID, data.DatasourceType)
+	writeOpts, err := r.resolveWriteOpts(ctx, data.OrgID.ValueString(), dsUID, data.DatasourceType)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to resolve datasource type", err.Error())
 		return
 	}
 	base := data.ToBase()
-	if diags := r.writeItem(data.DatasourceUID.ValueString(), base, writeOpts...); diags != nil {
+	if diags := r.writeItem(ctx, data.DatasourceUID.ValueString(), base, writeOpts...); diags != nil {
 		resp.Diagnostics = diags
 		return
 	}
@@ -193,12 +193,12 @@ func (r *resourceDatasourcePermissionItem) Delete(ctx context.Context, req resou
 	data.Permission = types.StringValue("")
 
 	_, dsUID := SplitOrgResourceID(data.DatasourceUID.ValueString())
-	writeOpts, err := r.resolveWriteOpts(data.OrgID.ValueString(), dsUID, data.DatasourceType)
+	writeOpts, err := r.resolveWriteOpts(ctx, data.OrgID.ValueString(), dsUID, data.DatasourceType)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to resolve datasource type", err.Error())
 		return
 	}
-	if diags := r.writeItem(data.DatasourceUID.ValueString(), data.ToBase(), writeOpts...); diags != nil {
+	if diags := r.writeItem(ctx, data.DatasourceUID.ValueString(), data.ToBase(), writeOpts...); diags != nil {
 		resp.Diagnostics = diags
 	}
 }
@@ -214,14 +214,14 @@ func (r *resourceDatasourcePermissionItem) datasourceQuery(client *client.Grafan
 // resolveWriteOpts returns the ds_type client option for write operations.
 // if dsType is provided it is used directly; otherwise GetDataSourceByUID is called to look it up.
 // Wildcard UIDs ("*") skip the lookup entirely since they represent all datasources.
-func (r *resourceDatasourcePermissionItem) resolveWriteOpts(orgIDStr, dsUID string, dsType types.String) ([]access_control.ClientOption, error) {
+func (r *resourceDatasourcePermissionItem) resolveWriteOpts(ctx context.Context, orgIDStr, dsUID string, dsType types.String) ([]access_control.ClientOption, error) {
 	if !dsType.IsNull() && dsType.ValueString() != "" {
 		return []access_control.ClientOption{withQueryParam("ds_type", dsType.ValueString())}, nil
 	}
 	if dsUID == "*" {
 		return nil, nil
 	}
-	c, _, err := r.clientFromNewOrgResource(orgIDStr)
+	c, _, err := r.clientFromNewOrgResource(ctx, orgIDStr)
 	if err != nil {
 		return nil, err
 	}
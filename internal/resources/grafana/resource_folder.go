@@ -80,15 +80,20 @@ func resourceFolder() *common.Resource {
 		"grafana_folder",
 		orgResourceIDString("uid"),
 		schema,
-	).WithLister(listerFunctionOrgResource(listFolders))
+	).WithLister(listerFunctionFilteredOrgResource(listFolders))
 }
 
-func listFolders(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
-	return listDashboardOrFolder(client, orgID, "dash-folder")
+// listFolders lists the folders whose title contains the literal part of the name of the filter.
+func listFolders(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64, filter ListerFilter) ([]string, error) {
+	params := search.NewSearchParams().WithType(common.Ref("dash-folder"))
+	if query := globLiteral(filter.Name); query != "" {
+		params.SetQuery(&query)
+	}
+	return listDashboardOrFolder(client, orgID, params)
 }
 
 func CreateFolder(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	var body models.CreateFolderCommand
 	if title := d.Get("title").(string); title != "" {
@@ -127,7 +132,7 @@ func CreateFolder(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 }
 
 func UpdateFolder(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	idStr, err := migrateFolderNumericID(client, d, orgID, idStr)
 	if err != nil {
@@ -181,7 +186,7 @@ func UpdateFolder(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 
 func ReadFolder(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	metaClient := meta.(*common.Client)
-	client, orgID, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	idStr, err := migrateFolderNumericID(client, d, orgID, idStr)
 	if err != nil {
@@ -205,7 +210,7 @@ func ReadFolder(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 }
 
 func DeleteFolder(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	uid, err := migrateFolderNumericID(client, d, orgID, uid)
 	if err != nil {
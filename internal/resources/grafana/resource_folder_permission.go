@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strconv"
 
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -33,7 +34,27 @@ func makeResourceFolderPermission() *common.Resource {
 				resourceType: foldersPermissionsType,
 			},
 		},
-	)
+	).WithLister(listerFunctionOrgResource(listFolderPermissions))
+}
+
+func listFolderPermissions(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
+	folderIDs, err := listFolders(ctx, client, orgID, ListerFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, id := range folderIDs {
+		_, folderUID := SplitOrgResourceID(id)
+		custom, err := hasCustomPermissions(client, foldersPermissionsType, folderUID)
+		if err != nil {
+			return nil, err
+		}
+		if custom {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
 }
 
 type resourceFolderPermissionModel struct {
@@ -83,7 +104,7 @@ func (r *resourceFolderPermission) Schema(ctx context.Context, req resource.Sche
 }
 
 func (r *resourceFolderPermission) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	client, orgID, split, err := r.clientFromExistingOrgResource(resourceFolderPermissionID, req.ID)
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, resourceFolderPermissionID, req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse import ID", err.Error())
 		return
@@ -119,7 +140,7 @@ func (r *resourceFolderPermission) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -158,7 +179,7 @@ func (r *resourceFolderPermission) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	client, orgID, split, err := r.clientFromExistingOrgResource(resourceFolderPermissionID, data.ID.ValueString())
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, resourceFolderPermissionID, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
@@ -197,7 +218,7 @@ func (r *resourceFolderPermission) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -229,7 +250,7 @@ func (r *resourceFolderPermission) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	client, _, split, err := r.clientFromExistingOrgResource(resourceFolderPermissionID, data.ID.ValueString())
+	client, _, split, err := r.clientFromExistingOrgResource(ctx, resourceFolderPermissionID, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
@@ -90,7 +90,7 @@ func (r *resourceFolderPermissionItem) Schema(ctx context.Context, req resource.
 }
 
 func (r *resourceFolderPermissionItem) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	readData, diags := r.readItem(req.ID, r.folderQuery, nil)
+	readData, diags := r.readItem(ctx, req.ID, r.folderQuery, nil)
 	if diags != nil {
 		resp.Diagnostics = diags
 		return
@@ -113,7 +113,7 @@ func (r *resourceFolderPermissionItem) Create(ctx context.Context, req resource.
 		return
 	}
 	base := data.ToBase()
-	if diags := r.writeItem(data.FolderUID.ValueString(), base); diags != nil {
+	if diags := r.writeItem(ctx, data.FolderUID.ValueString(), base); diags != nil {
 		resp.Diagnostics = diags
 		return
 	}
@@ -128,7 +128,7 @@ func (r *resourceFolderPermissionItem) Read(ctx context.Context, req resource.Re
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	// Read from API
-	readData, diags := r.readItem(data.ID.ValueString(), r.folderQuery, nil)
+	readData, diags := r.readItem(ctx, data.ID.ValueString(), r.folderQuery, nil)
 	if diags != nil {
 		resp.Diagnostics = diags
 		return
@@ -151,7 +151,7 @@ func (r *resourceFolderPermissionItem) Update(ctx context.Context, req resource.
 		return
 	}
 	base := data.ToBase()
-	if diags := r.writeItem(data.FolderUID.ValueString(), base); diags != nil {
+	if diags := r.writeItem(ctx, data.FolderUID.ValueString(), base); diags != nil {
 		resp.Diagnostics = diags
 		return
 	}
@@ -166,7 +166,7 @@ func (r *resourceFolderPermissionItem) Delete(ctx context.Context, req resource.
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	data.Permission = types.StringValue("")
 
-	if diags := r.writeItem(data.FolderUID.ValueString(), data.ToBase()); diags != nil {
+	if diags := r.writeItem(ctx, data.FolderUID.ValueString(), data.ToBase()); diags != nil {
 		resp.Diagnostics = diags
 	}
 }
@@ -135,9 +135,13 @@ func listLibraryPanels(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID
 }
 
 func createLibraryPanel(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _ := OAPIClientFromNewOrgResource(meta, d)
+	client, _ := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	panel := makeLibraryPanel(d)
+	if err := meta.(*common.Client).DatasourceUIDMap.CheckJSON(panel.Model); err != nil {
+		return diag.FromErr(err)
+	}
+	meta.(*common.Client).DatasourceUIDMap.MapJSON(panel.Model, false)
 	resp, err := client.LibraryElements.CreateLibraryElement(&panel)
 	if err != nil {
 		return diag.FromErr(err)
@@ -148,7 +152,7 @@ func createLibraryPanel(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func readLibraryPanel(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	resp, err := client.LibraryElements.GetLibraryElementByUID(uid)
 	if err, shouldReturn := common.CheckReadError("library panel", d, err); shouldReturn {
@@ -164,6 +168,7 @@ func readLibraryPanel(ctx context.Context, d *schema.ResourceData, meta any) dia
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	meta.(*common.Client).DatasourceUIDMap.MapJSON(remotePanelJSON, true)
 	modelJSON := normalizeLibraryPanelModelJSON(remotePanelJSON)
 
 	d.SetId(MakeOrgResourceID(orgID, uid))
@@ -196,10 +201,14 @@ func readLibraryPanel(ctx context.Context, d *schema.ResourceData, meta any) dia
 }
 
 func updateLibraryPanel(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	modelJSON := d.Get("model_json").(string)
 	panelJSON, _ := unmarshalLibraryPanelModelJSON(modelJSON)
+	if err := meta.(*common.Client).DatasourceUIDMap.CheckJSON(panelJSON); err != nil {
+		return diag.FromErr(err)
+	}
+	meta.(*common.Client).DatasourceUIDMap.MapJSON(panelJSON, false)
 
 	body := models.PatchLibraryElementCommand{
 		Name:    d.Get("name").(string),
@@ -219,7 +228,7 @@ func updateLibraryPanel(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func deleteLibraryPanel(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	_, err := client.LibraryElements.DeleteLibraryElementByUID(uid)
 	diag, _ := common.CheckReadError("library panel", d, err)
 	return diag
@@ -176,7 +176,7 @@ func listOrganizations(ctx context.Context, client *goapi.GrafanaHTTPAPI, data *
 }
 
 func CreateOrganization(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := OAPIGlobalClient(meta)
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -190,7 +190,7 @@ func CreateOrganization(ctx context.Context, d *schema.ResourceData, meta any) d
 		return diag.FromErr(err)
 	}
 	d.SetId(strconv.FormatInt(*resp.Payload.OrgID, 10))
-	if err = UpdateUsers(d, meta); err != nil {
+	if err = UpdateUsers(ctx, d, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -198,7 +198,7 @@ func CreateOrganization(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func ReadOrganization(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := OAPIGlobalClient(meta)
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -212,14 +212,14 @@ func ReadOrganization(ctx context.Context, d *schema.ResourceData, meta any) dia
 	org := resp.Payload
 	d.Set("org_id", org.ID)
 	d.Set("name", org.Name)
-	if err := ReadUsers(d, meta); err != nil {
+	if err := ReadUsers(ctx, d, meta); err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
 func UpdateOrganization(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := OAPIGlobalClient(meta)
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -230,7 +230,7 @@ func UpdateOrganization(ctx context.Context, d *schema.ResourceData, meta any) d
 			return diag.FromErr(err)
 		}
 	}
-	if err := UpdateUsers(d, meta); err != nil {
+	if err := UpdateUsers(ctx, d, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -238,7 +238,7 @@ func UpdateOrganization(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func DeleteOrganization(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := OAPIGlobalClient(meta)
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -248,8 +248,8 @@ func DeleteOrganization(ctx context.Context, d *schema.ResourceData, meta any) d
 	return diag
 }
 
-func ReadUsers(d *schema.ResourceData, meta any) error {
-	client, err := OAPIGlobalClient(meta)
+func ReadUsers(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return err
 	}
@@ -271,18 +271,18 @@ func ReadUsers(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func UpdateUsers(d *schema.ResourceData, meta any) error {
+func UpdateUsers(ctx context.Context, d *schema.ResourceData, meta any) error {
 	stateUsers, configUsers, err := collectUsers(d)
 	if err != nil {
 		return err
 	}
 	changes := changes(stateUsers, configUsers)
 	orgID, _ := strconv.ParseInt(d.Id(), 10, 64)
-	changes, err = addIdsToChanges(d, meta, changes)
+	changes, err = addIdsToChanges(ctx, d, meta, changes)
 	if err != nil {
 		return err
 	}
-	return applyChanges(meta, orgID, changes)
+	return applyChanges(ctx, meta, orgID, changes)
 }
 
 func collectUsers(d *schema.ResourceData) (map[string]OrgUser, map[string]OrgUser, error) {
@@ -337,8 +337,8 @@ func changes(stateUsers, configUsers map[string]OrgUser) []UserChange {
 	return changes
 }
 
-func addIdsToChanges(d *schema.ResourceData, meta any, changes []UserChange) ([]UserChange, error) {
-	client, err := OAPIGlobalClient(meta)
+func addIdsToChanges(ctx context.Context, d *schema.ResourceData, meta any, changes []UserChange) ([]UserChange, error) {
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -362,7 +362,7 @@ func addIdsToChanges(d *schema.ResourceData, meta any, changes []UserChange) ([]
 			return nil, fmt.Errorf("error adding user %s. User does not exist in Grafana", change.User.Email)
 		}
 		if !ok && create {
-			id, err = createUser(meta, change.User.Email)
+			id, err = createUser(ctx, meta, change.User.Email)
 			if err != nil {
 				return nil, err
 			}
@@ -373,8 +373,8 @@ func addIdsToChanges(d *schema.ResourceData, meta any, changes []UserChange) ([]
 	return output, nil
 }
 
-func createUser(meta any, user string) (int64, error) {
-	client, err := OAPIGlobalClient(meta)
+func createUser(ctx context.Context, meta any, user string) (int64, error) {
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return 0, err
 	}
@@ -397,8 +397,8 @@ func createUser(meta any, user string) (int64, error) {
 	return resp.Payload.ID, err
 }
 
-func applyChanges(meta any, orgID int64, changes []UserChange) error {
-	client, err := OAPIGlobalClient(meta)
+func applyChanges(ctx context.Context, meta any, orgID int64, changes []UserChange) error {
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return err
 	}
@@ -70,7 +70,7 @@ func listOrganizationPreferences(ctx context.Context, client *goapi.GrafanaHTTPA
 }
 
 func CreateOrganizationPreferences(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 
 	_, err := client.Org.UpdateOrgPreferences(&models.UpdatePrefsCmd{
 		Theme:            d.Get("theme").(string),
@@ -89,7 +89,7 @@ func CreateOrganizationPreferences(ctx context.Context, d *schema.ResourceData,
 
 func ReadOrganizationPreferences(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	id := d.Id() + ":" // Ensure the ID is in the <orgID>:<resourceID> format. A bit hacky but won't survive the migration to plugin framework
-	client, _, _ := OAPIClientFromExistingOrgResource(meta, id)
+	client, _, _ := OAPIClientFromExistingOrgResource(ctx, meta, id)
 
 	resp, err := client.Org.GetOrgPreferences()
 	if err, shouldReturn := common.CheckReadError("organization preferences", d, err); shouldReturn {
@@ -112,7 +112,7 @@ func UpdateOrganizationPreferences(ctx context.Context, d *schema.ResourceData,
 
 func DeleteOrganizationPreferences(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	id := d.Id() + ":" // Ensure the ID is in the <orgID>:<resourceID> format. A bit hacky but won't survive the migration to plugin framework
-	client, _, _ := OAPIClientFromExistingOrgResource(meta, id)
+	client, _, _ := OAPIClientFromExistingOrgResource(ctx, meta, id)
 
 	if _, err := client.Org.UpdateOrgPreferences(&models.UpdatePrefsCmd{}); err != nil {
 		return diag.FromErr(err)
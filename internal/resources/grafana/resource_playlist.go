@@ -155,7 +155,7 @@ func (r *playlistResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	client, orgID, err := r.clientFromNewOrgResource(plan.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, plan.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -220,9 +220,9 @@ func (r *playlistResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // clientOrgAndPlaylistUID parses the Terraform resource id and returns a Grafana client
 // scoped to the resource org and the playlist UID.
-func (r *playlistResource) clientOrgAndPlaylistUID(id string) (*goapi.GrafanaHTTPAPI, int64, string, diag.Diagnostics) {
+func (r *playlistResource) clientOrgAndPlaylistUID(ctx context.Context, id string) (*goapi.GrafanaHTTPAPI, int64, string, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	client, orgID, split, parseErr := r.clientFromExistingOrgResource(resourcePlaylistID, id)
+	client, orgID, split, parseErr := r.clientFromExistingOrgResource(ctx, resourcePlaylistID, id)
 	if parseErr != nil {
 		diags.AddError("Failed to parse resource ID", parseErr.Error())
 		return nil, 0, "", diags
@@ -246,7 +246,7 @@ func (r *playlistResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	client, _, uid, diags := r.clientOrgAndPlaylistUID(plan.ID.ValueString())
+	client, _, uid, diags := r.clientOrgAndPlaylistUID(ctx, plan.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -286,7 +286,7 @@ func (r *playlistResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	client, _, uid, diags := r.clientOrgAndPlaylistUID(state.ID.ValueString())
+	client, _, uid, diags := r.clientOrgAndPlaylistUID(ctx, state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -314,7 +314,7 @@ func (r *playlistResource) ImportState(ctx context.Context, req resource.ImportS
 }
 
 func (r *playlistResource) read(ctx context.Context, id string) (*playlistResourceModel, diag.Diagnostics) {
-	client, orgID, uid, diags := r.clientOrgAndPlaylistUID(id)
+	client, orgID, uid, diags := r.clientOrgAndPlaylistUID(ctx, id)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -1,6 +1,7 @@
 package grafana_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -193,7 +194,7 @@ func testAccPlaylistDisappears() resource.TestCheckFunc {
 			return fmt.Errorf("resource id not set")
 		}
 
-		client, _, playlistID := grafana.OAPIClientFromExistingOrgResource(testutils.Provider.Meta(), rs.Primary.ID)
+		client, _, playlistID := grafana.OAPIClientFromExistingOrgResource(context.Background(), testutils.Provider.Meta(), rs.Primary.ID)
 		_, err := client.Playlists.DeletePlaylist(playlistID)
 		return err
 	}
@@ -363,7 +363,7 @@ func (r *reportResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -423,7 +423,7 @@ func (r *reportResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	client, orgID, split, err := r.clientFromExistingOrgResource(orgResourceIDInt("id"), data.ID.ValueString())
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, orgResourceIDInt("id"), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
@@ -459,7 +459,7 @@ func (r *reportResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	client, _, split, err := r.clientFromExistingOrgResource(orgResourceIDInt("id"), data.ID.ValueString())
+	client, _, split, err := r.clientFromExistingOrgResource(ctx, orgResourceIDInt("id"), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
@@ -475,7 +475,7 @@ func (r *reportResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *reportResource) read(ctx context.Context, id string, preserveFormats bool) (*resourceReportModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	client, orgID, split, err := r.clientFromExistingOrgResource(orgResourceIDInt("id"), id)
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, orgResourceIDInt("id"), id)
 	if err != nil {
 		diags.AddError("Failed to parse resource ID", err.Error())
 		return nil, diags
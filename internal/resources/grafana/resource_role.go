@@ -124,11 +124,56 @@ func resourceRole() *common.Resource {
 		"grafana_role",
 		orgResourceIDString("uid"),
 		schema,
-	)
+	).WithLister(listerFunctionOrgResource(listRoles))
+}
+
+// fixedRolePrefixes are the name prefixes of the roles managed by Grafana itself. These can't be managed with Terraform.
+var fixedRolePrefixes = []string{"fixed:", "basic:", "managed:", "plugins:"}
+
+func listRoles(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
+	roles, err := listCustomRoles(client)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, role := range roles {
+		ids = append(ids, MakeOrgResourceID(orgID, *role.UID))
+	}
+	return ids, nil
+}
+
+// listCustomRoles returns the roles that were created by users, excluding the ones managed by Grafana.
+func listCustomRoles(client *goapi.GrafanaHTTPAPI) ([]*models.RoleDTO, error) {
+	resp, err := client.AccessControl.ListRoles(access_control.NewListRolesParams())
+	if err != nil && common.IsNotFoundError(err) {
+		return nil, nil // Roles are only available in Grafana Enterprise
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []*models.RoleDTO
+	for _, role := range resp.Payload {
+		if role.UID == nil || role.Name == nil || isFixedRole(*role.Name) {
+			continue
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+func isFixedRole(name string) bool {
+	for _, prefix := range fixedRolePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
 func CreateRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	if d.Get("global").(bool) {
 		orgID = 0
 		client = client.WithOrgID(orgID)
@@ -173,7 +218,7 @@ func permissions(d *schema.ResourceData) []*models.Permission {
 }
 
 func ReadRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	if d.Get("global").(bool) {
 		var orgID int64 = 0
 		client = client.WithOrgID(orgID)
@@ -237,7 +282,7 @@ func readRoleFromUID(client *goapi.GrafanaHTTPAPI, uid string, d *schema.Resourc
 }
 
 func UpdateRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	if d.Get("global").(bool) {
 		var orgID int64 = 0
 		client = client.WithOrgID(orgID)
@@ -267,7 +312,7 @@ func UpdateRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 }
 
 func DeleteRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	global := d.Get("global").(bool)
 	if global {
 		var orgID int64 = 0
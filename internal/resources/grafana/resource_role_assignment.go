@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -78,11 +79,32 @@ Manages the entire set of assignments for a role. Assignments that aren't specif
 		"grafana_role_assignment",
 		orgResourceIDString("roleUID"),
 		schema,
-	)
+	).WithLister(listerFunctionOrgResource(listRoleAssignments))
+}
+
+func listRoleAssignments(ctx context.Context, client *goapi.GrafanaHTTPAPI, orgID int64) ([]string, error) {
+	roles, err := listCustomRoles(client)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, role := range roles {
+		resp, err := client.AccessControl.GetRoleAssignments(*role.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only generate assignments for roles that are assigned to something
+		if a := resp.Payload; len(a.Users) > 0 || len(a.Teams) > 0 || len(a.ServiceAccounts) > 0 {
+			ids = append(ids, MakeOrgResourceID(orgID, *role.UID))
+		}
+	}
+	return ids, nil
 }
 
 func ReadRoleAssignments(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	resp, err := client.AccessControl.GetRoleAssignments(uid)
 	if err, shouldReturn := common.CheckReadError("role assignments", d, err); shouldReturn {
 		return err
@@ -96,7 +118,7 @@ func UpdateRoleAssignments(ctx context.Context, d *schema.ResourceData, meta any
 		return nil
 	}
 
-	client, orgID := OAPIClientFromNewOrgResource(meta, d)
+	client, orgID := OAPIClientFromNewOrgResource(ctx, meta, d)
 	uid := d.Get("role_uid").(string)
 
 	ra := models.SetRoleAssignmentsCommand{
@@ -113,7 +135,7 @@ func UpdateRoleAssignments(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func DeleteRoleAssignments(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, uid := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, uid := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	_, err := client.AccessControl.SetRoleAssignments(uid, &models.SetRoleAssignmentsCommand{
 		ServiceAccounts: []int64{},
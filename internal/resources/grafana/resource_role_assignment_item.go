@@ -117,7 +117,7 @@ func (r *resourceRoleAssignmentItem) Schema(ctx context.Context, req resource.Sc
 func (r *resourceRoleAssignmentItem) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resourceRoleAssignmentMutex.RLock()
 	defer resourceRoleAssignmentMutex.RUnlock()
-	data, diags := r.read(req.ID)
+	data, diags := r.read(ctx, req.ID)
 	if diags != nil {
 		resp.Diagnostics = diags
 		return
@@ -138,7 +138,7 @@ func (r *resourceRoleAssignmentItem) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -224,7 +224,7 @@ func (r *resourceRoleAssignmentItem) Read(ctx context.Context, req resource.Read
 	// Read from API
 	resourceRoleAssignmentMutex.RLock()
 	defer resourceRoleAssignmentMutex.RUnlock()
-	readData, diags := r.read(data.ID.ValueString())
+	readData, diags := r.read(ctx, data.ID.ValueString())
 	if diags != nil {
 		resp.Diagnostics = diags
 		return
@@ -248,7 +248,7 @@ func (r *resourceRoleAssignmentItem) Delete(ctx context.Context, req resource.De
 	var data resourceRoleAssignmentItemModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-	client, _, idFields, err := r.clientFromExistingOrgResource(resourceRoleAssignmentItemID, data.ID.ValueString())
+	client, _, idFields, err := r.clientFromExistingOrgResource(ctx, resourceRoleAssignmentItemID, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -305,8 +305,8 @@ func (r *resourceRoleAssignmentItem) Delete(ctx context.Context, req resource.De
 	}
 }
 
-func (r *resourceRoleAssignmentItem) read(id string) (*resourceRoleAssignmentItemModel, diag.Diagnostics) {
-	client, orgID, idFields, err := r.clientFromExistingOrgResource(resourceRoleAssignmentItemID, id)
+func (r *resourceRoleAssignmentItem) read(ctx context.Context, id string) (*resourceRoleAssignmentItemModel, diag.Diagnostics) {
+	client, orgID, idFields, err := r.clientFromExistingOrgResource(ctx, resourceRoleAssignmentItemID, id)
 	if err != nil {
 		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Failed to get client", err.Error())}
 	}
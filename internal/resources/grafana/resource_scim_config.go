@@ -127,7 +127,7 @@ func (r *scimConfigResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	_, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	_, orgID, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -235,7 +235,7 @@ func (r *scimConfigResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	setAuthHeaders(httpReq, transportConfig)
 
-	httpClient := &http.Client{}
+	httpClient := r.commonClient.GrafanaHTTPClient
 	httpResp, err := httpClient.Do(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete SCIM config", err.Error())
@@ -318,7 +318,7 @@ func (r *scimConfigResource) createOrUpdate(ctx context.Context, data *resourceS
 	httpReq.Header.Set("Content-Type", "application/json")
 	setAuthHeaders(httpReq, transportConfig)
 
-	httpClient := &http.Client{}
+	httpClient := r.commonClient.GrafanaHTTPClient
 	httpResp, err := httpClient.Do(httpReq)
 	if err != nil {
 		diags.AddError("Failed to create or update SCIM config", err.Error())
@@ -365,7 +365,7 @@ func (r *scimConfigResource) read(ctx context.Context, id string) (*resourceSCIM
 
 	setAuthHeaders(httpReq, transportConfig)
 
-	httpClient := &http.Client{}
+	httpClient := r.commonClient.GrafanaHTTPClient
 	httpResp, err := httpClient.Do(httpReq)
 	if err != nil {
 		diags.AddError("Failed to read SCIM config", err.Error())
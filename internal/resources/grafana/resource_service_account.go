@@ -150,7 +150,7 @@ func (r *serviceAccountResource) Create(ctx context.Context, req resource.Create
 	serviceAccountCreateMutex.Lock()
 	defer serviceAccountCreateMutex.Unlock()
 
-	client, orgID, err := r.clientFromNewOrgResource(plan.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, plan.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -221,9 +221,9 @@ func (r *serviceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 // serviceAccountClientAndID parses the Terraform composite id and returns an org-scoped client, org ID, and Grafana service account id.
-func (r *serviceAccountResource) serviceAccountClientAndID(id string) (*goapi.GrafanaHTTPAPI, int64, int64, diag.Diagnostics) {
+func (r *serviceAccountResource) serviceAccountClientAndID(ctx context.Context, id string) (*goapi.GrafanaHTTPAPI, int64, int64, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	client, orgID, split, err := r.clientFromExistingOrgResource(resourceServiceAccountID, id)
+	client, orgID, split, err := r.clientFromExistingOrgResource(ctx, resourceServiceAccountID, id)
 	if err != nil {
 		diags.AddError("Failed to parse resource ID", err.Error())
 		return nil, 0, 0, diags
@@ -247,7 +247,7 @@ func (r *serviceAccountResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	client, _, idInt, diags := r.serviceAccountClientAndID(plan.ID.ValueString())
+	client, _, idInt, diags := r.serviceAccountClientAndID(ctx, plan.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -283,7 +283,7 @@ func (r *serviceAccountResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	client, _, idInt, diags := r.serviceAccountClientAndID(state.ID.ValueString())
+	client, _, idInt, diags := r.serviceAccountClientAndID(ctx, state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -311,7 +311,7 @@ func (r *serviceAccountResource) ImportState(ctx context.Context, req resource.I
 }
 
 func (r *serviceAccountResource) read(ctx context.Context, id string) (*serviceAccountResourceModel, diag.Diagnostics) {
-	client, orgID, idInt, diags := r.serviceAccountClientAndID(id)
+	client, orgID, idInt, diags := r.serviceAccountClientAndID(ctx, id)
 	if diags.HasError() {
 		return nil, diags
 	}
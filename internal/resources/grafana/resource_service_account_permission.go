@@ -1,6 +1,7 @@
 package grafana
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
@@ -53,11 +54,11 @@ Manages the entire set of permissions for a service account. Permissions that ar
 	)
 }
 
-func resourceServiceAccountPermissionGet(d *schema.ResourceData, meta any) (string, error) {
-	client, _ := OAPIClientFromNewOrgResource(meta, d)
+func resourceServiceAccountPermissionGet(ctx context.Context, d *schema.ResourceData, meta any) (string, error) {
+	client, _ := OAPIClientFromNewOrgResource(ctx, meta, d)
 	_, id := SplitServiceAccountID(d.Get("service_account_id").(string))
 	if d.Id() != "" {
-		client, _, id = OAPIClientFromExistingOrgResource(meta, d.Id())
+		client, _, id = OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	}
 	idInt, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
@@ -105,7 +105,7 @@ func (r *resourceServiceAccountPermissionItem) Schema(ctx context.Context, req r
 }
 
 func (r *resourceServiceAccountPermissionItem) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	readData, diags := r.readItem(req.ID, r.serviceAccountQuery, nil)
+	readData, diags := r.readItem(ctx, req.ID, r.serviceAccountQuery, nil)
 	if diags != nil {
 		resp.Diagnostics = diags
 		return
@@ -128,7 +128,7 @@ func (r *resourceServiceAccountPermissionItem) Create(ctx context.Context, req r
 		return
 	}
 	base := data.ToBase()
-	if diags := r.writeItem(data.ServiceAccountID.ValueString(), base); diags != nil {
+	if diags := r.writeItem(ctx, data.ServiceAccountID.ValueString(), base); diags != nil {
 		resp.Diagnostics = diags
 		return
 	}
@@ -144,7 +144,7 @@ func (r *resourceServiceAccountPermissionItem) Read(ctx context.Context, req res
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	// Read from API
-	readData, diags := r.readItem(data.ID.ValueString(), r.serviceAccountQuery, nil)
+	readData, diags := r.readItem(ctx, data.ID.ValueString(), r.serviceAccountQuery, nil)
 	if diags != nil {
 		resp.Diagnostics = diags
 		return
@@ -168,7 +168,7 @@ func (r *resourceServiceAccountPermissionItem) Update(ctx context.Context, req r
 		return
 	}
 	base := data.ToBase()
-	if diags := r.writeItem(data.ServiceAccountID.ValueString(), base); diags != nil {
+	if diags := r.writeItem(ctx, data.ServiceAccountID.ValueString(), base); diags != nil {
 		resp.Diagnostics = diags
 		return
 	}
@@ -184,7 +184,7 @@ func (r *resourceServiceAccountPermissionItem) Delete(ctx context.Context, req r
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	data.Permission = types.StringValue("")
 
-	if diags := r.writeItem(data.ServiceAccountID.ValueString(), data.ToBase()); diags != nil {
+	if diags := r.writeItem(ctx, data.ServiceAccountID.ValueString(), data.ToBase()); diags != nil {
 		resp.Diagnostics = diags
 	}
 }
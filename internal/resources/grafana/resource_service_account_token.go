@@ -60,7 +60,7 @@ func serviceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, m an
 
 func serviceAccountTokenCreateHelper(ctx context.Context, d *schema.ResourceData, m any, name string) error {
 	orgID, serviceAccountIDStr := SplitOrgResourceID(d.Get("service_account_id").(string))
-	c := m.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
+	c := common.OAPIClientWithContext(ctx, m.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID))
 	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
 	if err != nil {
 		return err
@@ -89,7 +89,7 @@ func serviceAccountTokenCreateHelper(ctx context.Context, d *schema.ResourceData
 
 func serviceAccountTokenRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	orgID, serviceAccountIDStr := SplitOrgResourceID(d.Get("service_account_id").(string))
-	c := m.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
+	c := common.OAPIClientWithContext(ctx, m.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID))
 	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -128,7 +128,7 @@ func serviceAccountTokenRead(ctx context.Context, d *schema.ResourceData, m any)
 
 func serviceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	orgID, serviceAccountIDStr := SplitOrgResourceID(d.Get("service_account_id").(string))
-	c := m.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID)
+	c := common.OAPIClientWithContext(ctx, m.(*common.Client).GrafanaAPI.Clone().WithOrgID(orgID))
 	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	goapi "github.com/grafana/grafana-openapi-client-go/client"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 )
@@ -84,7 +85,27 @@ Manages Grafana SSO Settings for OAuth2, SAML and LDAP. Support for LDAP is curr
 		"grafana_sso_settings",
 		orgResourceIDString("provider"),
 		schema,
-	)
+	).WithLister(listerFunction(listSSOSettings))
+}
+
+func listSSOSettings(ctx context.Context, client *goapi.GrafanaHTTPAPI, data *ListerData) ([]string, error) {
+	resp, err := client.Clone().WithOrgID(0).SsoSettings.ListAllProvidersSettings()
+	if err != nil && common.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, settings := range resp.Payload {
+		// Settings coming from the Grafana configuration file (source "system") can't be managed with Terraform
+		if settings.Source != "database" {
+			continue
+		}
+		ids = append(ids, settings.Provider)
+	}
+	return ids, nil
 }
 
 var oauth2SettingsSchema = &schema.Resource{
@@ -703,7 +724,7 @@ var ldapSettingsSchema = &schema.Resource{
 }
 
 func ReadSSOSettings(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _ := OAPIGlobalClient(meta) // TODO: Check error. This resource works with a token. Is it org-scoped?
+	client, _ := OAPIGlobalClient(ctx, meta) // TODO: Check error. This resource works with a token. Is it org-scoped?
 
 	provider := d.Id()
 
@@ -789,7 +810,7 @@ func ReadSSOSettings(ctx context.Context, d *schema.ResourceData, meta any) diag
 }
 
 func UpdateSSOSettings(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _ := OAPIGlobalClient(meta) // TODO: Check error. This resource works with a token. Is it org-scoped?
+	client, _ := OAPIGlobalClient(ctx, meta) // TODO: Check error. This resource works with a token. Is it org-scoped?
 
 	provider := d.Get(providerKey).(string)
 
@@ -835,7 +856,7 @@ func UpdateSSOSettings(ctx context.Context, d *schema.ResourceData, meta any) di
 }
 
 func DeleteSSOSettings(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _ := OAPIGlobalClient(meta) // TODO: Check error. This resource works with a token. Is it org-scoped?
+	client, _ := OAPIGlobalClient(ctx, meta) // TODO: Check error. This resource works with a token. Is it org-scoped?
 
 	provider := d.Get(providerKey).(string)
 
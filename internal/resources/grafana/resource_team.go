@@ -448,7 +448,7 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	client, orgID, err := r.clientFromNewOrgResource(data.OrgID.ValueString())
+	client, orgID, err := r.clientFromNewOrgResource(ctx, data.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get client", err.Error())
 		return
@@ -570,7 +570,7 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	client, _, split, err := r.clientFromExistingOrgResource(resourceTeamID, planData.ID.ValueString())
+	client, _, split, err := r.clientFromExistingOrgResource(ctx, resourceTeamID, planData.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
@@ -676,7 +676,7 @@ func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	client, _, split, err := r.clientFromExistingOrgResource(resourceTeamID, data.ID.ValueString())
+	client, _, split, err := r.clientFromExistingOrgResource(ctx, resourceTeamID, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse resource ID", err.Error())
 		return
@@ -708,7 +708,7 @@ func (r *teamResource) ImportState(ctx context.Context, req resource.ImportState
 func (r *teamResource) read(ctx context.Context, id string, ignoreExternallySynced bool, readTeamSync bool) (*resourceTeamModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	client, _, split, err := r.clientFromExistingOrgResource(resourceTeamID, id)
+	client, _, split, err := r.clientFromExistingOrgResource(ctx, resourceTeamID, id)
 	if err != nil {
 		diags.AddError("Failed to parse resource ID", err.Error())
 		return nil, diags
@@ -62,7 +62,7 @@ func CreateTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta a
 	orgID, teamIDStr := SplitOrgResourceID(d.Get("team_id").(string))
 	teamID, _ := strconv.ParseInt(teamIDStr, 10, 64)
 	d.SetId(MakeOrgResourceID(orgID, strconv.FormatInt(teamID, 10)))
-	client, _, _ := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, _ := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 
 	if err := manageTeamExternalGroup(client, teamID, d, "groups"); err != nil {
 		return diag.FromErr(err)
@@ -72,7 +72,7 @@ func CreateTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta a
 }
 
 func ReadTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, orgID, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, orgID, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	teamID, _ := strconv.ParseInt(idStr, 10, 64)
 
 	resp, err := client.SyncTeamGroups.GetTeamGroupsAPI(strconv.FormatInt(teamID, 10))
@@ -93,7 +93,7 @@ func ReadTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func UpdateTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	teamID, _ := strconv.ParseInt(idStr, 10, 64)
 
 	if err := manageTeamExternalGroup(client, teamID, d, "groups"); err != nil {
@@ -104,7 +104,7 @@ func UpdateTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta a
 }
 
 func DeleteTeamExternalGroup(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, _, idStr := OAPIClientFromExistingOrgResource(meta, d.Id())
+	client, _, idStr := OAPIClientFromExistingOrgResource(ctx, meta, d.Id())
 	teamID, _ := strconv.ParseInt(idStr, 10, 64)
 	if err := applyTeamExternalGroup(client, teamID, nil, common.SetToStringSlice(d.Get("groups").(*schema.Set))); err != nil {
 		return diag.FromErr(err)
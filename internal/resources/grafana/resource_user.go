@@ -108,7 +108,7 @@ func listUsers(ctx context.Context, client *goapi.GrafanaHTTPAPI, data *ListerDa
 }
 
 func CreateUser(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := OAPIGlobalClient(meta)
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -133,7 +133,7 @@ func CreateUser(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 }
 
 func ReadUser(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := OAPIGlobalClient(meta)
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -156,7 +156,7 @@ func ReadUser(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagno
 }
 
 func UpdateUser(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := OAPIGlobalClient(meta)
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -190,7 +190,7 @@ func UpdateUser(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 }
 
 func DeleteUser(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, err := OAPIGlobalClient(meta)
+	client, err := OAPIGlobalClient(ctx, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -114,6 +114,7 @@ var Resources = addValidationToResources(
 	makeResourceAnnotation(),
 	resourceContactPoint(),
 	resourceDashboard(),
+	resourceDashboardBundle(),
 	resourcePublicDashboard(),
 	makeResourceDashboardPermission(),
 	resourceDataSource(),
@@ -126,6 +127,7 @@ var Resources = addValidationToResources(
 	resourceMessageTemplate(),
 	resourceMuteTiming(),
 	resourceNotificationPolicy(),
+	resourceNotificationPolicyRoute(),
 	resourceOrganization(),
 	resourceOrganizationPreferences(),
 	resourcePlaylist(),
@@ -143,3 +145,7 @@ var Resources = addValidationToResources(
 	makeResourceSCIMConfig(),
 	resourceUser(),
 )
+
+var EphemeralResources = []*common.EphemeralResource{
+	ephemeralServiceAccountToken(),
+}
@@ -38,7 +38,42 @@ func resourceAlert() *common.Resource {
 		resourceAlertName,
 		resourceAlertID,
 		&alertResource{},
-	)
+	).WithLister(lister(listAlerts))
+}
+
+// listAlerts lists the alerts of every job and outlier detector, as import IDs in the `/(jobs|outliers)/<jobID>/alerts/<alertID>` format.
+func listAlerts(ctx context.Context, client *mlapi.Client) ([]string, error) {
+	var ids []string
+
+	jobs, err := client.Jobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		alerts, err := client.JobAlerts(ctx, job.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, alert := range alerts {
+			ids = append(ids, fmt.Sprintf("/jobs/%s/alerts/%s", job.ID, alert.ID))
+		}
+	}
+
+	outliers, err := client.OutlierDetectors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, outlier := range outliers {
+		alerts, err := client.OutlierAlerts(ctx, outlier.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, alert := range alerts {
+			ids = append(ids, fmt.Sprintf("/outliers/%s/alerts/%s", outlier.ID, alert.ID))
+		}
+	}
+
+	return ids, nil
 }
 
 type resourceAlertModel struct {
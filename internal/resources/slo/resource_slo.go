@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"regexp"
+	"slices"
 
 	"github.com/grafana/slo-openapi-client/go/slo"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
@@ -42,6 +44,7 @@ var (
 	_ resource.ResourceWithConfigure        = &sloResource{}
 	_ resource.ResourceWithImportState      = &sloResource{}
 	_ resource.ResourceWithConfigValidators = &sloResource{}
+	_ resource.ResourceWithModifyPlan       = &sloResource{}
 )
 
 func makeResourceSlo() *common.Resource {
@@ -131,6 +134,12 @@ Resource manages Grafana SLOs (Service Level Objectives).
 					},
 				},
 			},
+			"labels_all": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "All the labels of the SLO: the `label` blocks and the `default_labels` of the provider. " +
+					"Changing the `default_labels` of the provider updates the SLO.",
+			},
 			"search_expression": schema.StringAttribute{
 				Optional:    true,
 				Description: "A Knowledge Graph search expression scoping this SLO to a set of entities, for example \"shipping connected services\". When set, the SLO links to the Asserts RCA workbench from the SLO list and performance pages, and generated burn-rate alert rules carry a `workbench_troubleshoot_url` annotation pointing at the matching entities. See [predefined searches](https://grafana.com/docs/grafana-cloud/platform/knowledge-graph/troubleshoot-infra-apps/explore-entity-graph/#use-predefined-searches) for the expression syntax. Must be non-empty if set; omit the attribute entirely to leave it unset.",
@@ -381,6 +390,7 @@ func (r *sloResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	sloModel.Labels = mergeDefaultLabels(sloModel.Labels, common.DefaultLabelsFromContext(ctx))
 
 	// Check if this SLO has Asserts provenance and create a custom client if needed
 	apiClient := r.client
@@ -396,7 +406,7 @@ func (r *sloResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	// Read back the created SLO to get all computed fields
-	data, readDiags := r.readSLO(ctx, response.Uuid)
+	data, readDiags := r.readSLO(ctx, response.Uuid, plan.Label)
 	resp.Diagnostics.Append(readDiags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -418,7 +428,7 @@ func (r *sloResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	data, diags := r.readSLO(ctx, sloID)
+	data, diags := r.readSLO(ctx, sloID, state.Label)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -451,6 +461,7 @@ func (r *sloResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	sloModel.Labels = mergeDefaultLabels(sloModel.Labels, common.DefaultLabelsFromContext(ctx))
 
 	// Check if this SLO has Asserts provenance and create a custom client if needed
 	apiClient := r.client
@@ -466,7 +477,7 @@ func (r *sloResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	}
 
 	// Read back the updated SLO
-	data, readDiags := r.readSLO(ctx, sloID)
+	data, readDiags := r.readSLO(ctx, sloID, plan.Label)
 	resp.Diagnostics.Append(readDiags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -475,6 +486,33 @@ func (r *sloResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
+// ModifyPlan plans the `labels_all` attribute from the labels of the SLO and the default labels, see common.DefaultLabels.
+func (r *sloResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var labelList types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("label"), &labelList)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var labels []labelModel
+	known := !labelList.IsUnknown()
+	if known {
+		resp.Diagnostics.Append(labelList.ElementsAs(ctx, &labels, false)...)
+	}
+	planned := map[string]string{}
+	for _, label := range labels {
+		known = known && !label.Key.IsUnknown() && !label.Value.IsUnknown()
+		planned[label.Key.ValueString()] = label.Value.ValueString()
+	}
+	if !known {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_all"), types.MapUnknown(types.StringType))...)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_all"), common.DefaultLabelsFromContext(ctx).Merge(planned))...)
+}
+
 func (r *sloResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state sloResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -492,7 +530,7 @@ func (r *sloResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *sloResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	data, diags := r.readSLO(ctx, req.ID)
+	data, diags := r.readSLO(ctx, req.ID, nil)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -504,8 +542,9 @@ func (r *sloResource) ImportState(ctx context.Context, req resource.ImportStateR
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
-// readSLO fetches an SLO by ID and returns the resource model
-func (r *sloResource) readSLO(ctx context.Context, sloID string) (*sloResourceModel, diag.Diagnostics) {
+// readSLO fetches an SLO by ID and returns the resource model.
+// The default labels which aren't in the configured labels are hidden from the model.
+func (r *sloResource) readSLO(ctx context.Context, sloID string, configuredLabels []labelModel) (*sloResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	apiReq := r.client.DefaultAPI.V1SloIdGet(ctx, sloID)
@@ -523,8 +562,45 @@ func (r *sloResource) readSLO(ctx context.Context, sloID string) (*sloResourceMo
 	if diags.HasError() {
 		return nil, diags
 	}
+	defaultLabels := common.DefaultLabelsFromContext(ctx)
+	labels := data.Label
+	data.Label = stripDefaultLabels(labels, configuredLabels, defaultLabels)
+	labelsAll, convertDiags := types.MapValueFrom(ctx, types.StringType, defaultLabels.All(labelsMap(labels), labelsMap(data.Label)))
+	diags.Append(convertDiags...)
+	data.LabelsAll = labelsAll
 
-	return data, nil
+	return data, diags
+}
+
+// mergeDefaultLabels returns the labels of the SLO with the default labels of the provider it doesn't set.
+func mergeDefaultLabels(labels []slo.SloV00Label, defaultLabels common.DefaultLabels) []slo.SloV00Label {
+	for _, key := range slices.Sorted(maps.Keys(defaultLabels)) {
+		if !slices.ContainsFunc(labels, func(l slo.SloV00Label) bool { return l.Key == key }) {
+			labels = append(labels, slo.SloV00Label{Key: key, Value: defaultLabels[key]})
+		}
+	}
+	return labels
+}
+
+// stripDefaultLabels returns the labels read from the API without the default labels which aren't in the configured labels.
+func stripDefaultLabels(labels, configuredLabels []labelModel, defaultLabels common.DefaultLabels) []labelModel {
+	stripped := []labelModel{}
+	for _, label := range labels {
+		isConfigured := slices.ContainsFunc(configuredLabels, func(l labelModel) bool { return l.Key.Equal(label.Key) })
+		if defaultLabels.IsDefault(label.Key.ValueString(), label.Value.ValueString()) && !isConfigured {
+			continue
+		}
+		stripped = append(stripped, label)
+	}
+	return stripped
+}
+
+func labelsMap(labels []labelModel) map[string]string {
+	m := make(map[string]string, len(labels))
+	for _, label := range labels {
+		m[label.Key.ValueString()] = label.Value.ValueString()
+	}
+	return m
 }
 
 // hasAssertsProvenanceLabel checks if the SLO has the grafana_slo_provenance=asserts label
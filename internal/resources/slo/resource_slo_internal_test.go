@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/grafana/slo-openapi-client/go/slo"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -339,3 +340,29 @@ func TestUnit_nonEmptyStringValidator(t *testing.T) {
 		})
 	}
 }
+
+// TestUnit_sloDefaultLabels covers the default labels of the provider: they're
+// merged into the labels of the SLO, which take precedence, and hidden from its
+// state unless they're set on the SLO or were changed outside of Terraform.
+func TestUnit_sloDefaultLabels(t *testing.T) {
+	defaultLabels := common.DefaultLabels{
+		"managed-by": "terraform",
+		"team":       "default-team",
+		"env":        "prod",
+	}
+
+	merged := mergeDefaultLabels([]slo.SloV00Label{{Key: "team", Value: "my-team"}}, defaultLabels)
+	require.Equal(t, []slo.SloV00Label{
+		{Key: "team", Value: "my-team"},
+		{Key: "env", Value: "prod"},
+		{Key: "managed-by", Value: "terraform"},
+	}, merged)
+
+	label := func(key, value string) labelModel {
+		return labelModel{Key: types.StringValue(key), Value: types.StringValue(value)}
+	}
+	labels := []labelModel{label("team", "my-team"), label("env", "prod"), label("managed-by", "changed")}
+	stripped := stripDefaultLabels(labels, []labelModel{label("team", "my-team")}, defaultLabels)
+	require.Equal(t, []labelModel{label("team", "my-team"), label("managed-by", "changed")}, stripped)
+	require.Equal(t, map[string]string{"team": "my-team", "env": "prod", "managed-by": "changed"}, defaultLabels.All(labelsMap(labels), labelsMap(stripped)))
+}
@@ -25,6 +25,7 @@ type sloResourceModel struct {
 	Objectives            []objectiveModel             `tfsdk:"objectives"`
 	Alerting              []alertingModel              `tfsdk:"alerting"`
 	SearchExpression      types.String                 `tfsdk:"search_expression"`
+	LabelsAll             types.Map                    `tfsdk:"labels_all"`
 }
 
 // packSloResourceModel converts the Terraform model to an API model
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -721,7 +722,7 @@ multiple checks for a single endpoint to check different capabilities.
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: resourceCheckCustomizeDiff,
+		CustomizeDiff: customdiff.All(resourceCheckCustomizeDiff, common.DiffLabelsAll("labels")),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -844,6 +845,7 @@ multiple checks for a single endpoint to check different capabilities.
 					Type: schema.TypeString,
 				},
 			},
+			"labels_all": common.LabelsAllSchema("labels"),
 			"settings": {
 				Description: "Check settings. Should contain exactly one nested block.",
 				Type:        schema.TypeSet,
@@ -883,7 +885,7 @@ func listChecks(ctx context.Context, client *common.Client, data any) ([]string,
 }
 
 func resourceCheckCreate(ctx context.Context, d *schema.ResourceData, c *smapi.Client) diag.Diagnostics {
-	chk, err := makeCheck(d)
+	chk, err := makeCheck(ctx, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -932,14 +934,17 @@ func resourceCheckRead(ctx context.Context, d *schema.ResourceData, c *smapi.Cli
 	}
 	d.Set("probes", chk.Probes)
 
+	// Convert []sm.Label into a map before set.
+	defaultLabels := common.DefaultLabelsFromContext(ctx)
+	labels := make(map[string]string, len(chk.Labels))
+	for _, l := range chk.Labels {
+		labels[l.Name] = l.Value
+	}
 	if len(chk.Labels) > 0 {
-		// Convert []sm.Label into a map before set.
-		labels := make(map[string]string, len(chk.Labels))
-		for _, l := range chk.Labels {
-			labels[l.Name] = l.Value
-		}
-		d.Set("labels", labels)
+		// The default labels which aren't set on the check are hidden from its state
+		d.Set("labels", defaultLabels.Strip(labels, common.UnpackMap[string](d.Get("labels"))))
 	}
+	d.Set("labels_all", defaultLabels.All(labels, common.UnpackMap[string](d.Get("labels"))))
 
 	// Convert sm.Settings...
 
@@ -1252,7 +1257,7 @@ func resourceCheckRead(ctx context.Context, d *schema.ResourceData, c *smapi.Cli
 }
 
 func resourceCheckUpdate(ctx context.Context, d *schema.ResourceData, c *smapi.Client) diag.Diagnostics {
-	chk, err := makeCheck(d)
+	chk, err := makeCheck(ctx, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1274,7 +1279,7 @@ func resourceCheckDelete(ctx context.Context, d *schema.ResourceData, c *smapi.C
 
 // makeCheck populates an instance of model.Check. We need this for create and
 // update calls with the SM API client.
-func makeCheck(d *schema.ResourceData) (*model.Check, error) {
+func makeCheck(ctx context.Context, d *schema.ResourceData) (*model.Check, error) {
 	var id int64
 	if d.Id() != "" {
 		id, _ = strconv.ParseInt(d.Id(), 10, 64)
@@ -1286,10 +1291,10 @@ func makeCheck(d *schema.ResourceData) (*model.Check, error) {
 	}
 
 	var labels []sm.Label
-	for name, value := range d.Get("labels").(map[string]any) {
+	for name, value := range common.DefaultLabelsFromContext(ctx).Merge(common.UnpackMap[string](d.Get("labels"))) {
 		labels = append(labels, sm.Label{
 			Name:  name,
-			Value: value.(string),
+			Value: value,
 		})
 	}
 
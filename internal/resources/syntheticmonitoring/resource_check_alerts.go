@@ -2,6 +2,7 @@ package syntheticmonitoring
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	smapi "github.com/grafana/synthetic-monitoring-api-go-client"
@@ -95,7 +96,32 @@ Manages alerts for a check in Grafana Synthetic Monitoring.
 				},
 			},
 		},
-	)
+	).WithLister(listCheckAlerts)
+}
+
+// listCheckAlerts lists the checks which have at least one alert configured.
+func listCheckAlerts(ctx context.Context, client *common.Client, data any) ([]string, error) {
+	smClient := client.SMAPI
+	if smClient == nil {
+		return nil, fmt.Errorf("client not configured for SM API")
+	}
+
+	checkList, err := smClient.ListChecks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, check := range checkList {
+		alerts, err := smClient.GetCheckAlerts(ctx, check.Id)
+		if err != nil {
+			return nil, err
+		}
+		if len(alerts) > 0 {
+			ids = append(ids, strconv.FormatInt(check.Id, 10))
+		}
+	}
+	return ids, nil
 }
 
 func resourceCheckAlertCreate(ctx context.Context, d *schema.ResourceData, c *smapi.Client) diag.Diagnostics {
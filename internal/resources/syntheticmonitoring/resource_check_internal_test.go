@@ -1,9 +1,13 @@
 package syntheticmonitoring
 
 import (
+	"context"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 )
 
 // TestUnitCheck_secretManagerEnabled verifies that the http block's
@@ -42,7 +46,7 @@ func TestUnitCheck_secretManagerEnabled(t *testing.T) {
 
 			d := schema.TestResourceDataRaw(t, resourceCheck().Schema.Schema, raw)
 
-			check, err := makeCheck(d)
+			check, err := makeCheck(context.Background(), d)
 			if err != nil {
 				t.Fatalf("makeCheck returned unexpected error: %v", err)
 			}
@@ -55,3 +59,34 @@ func TestUnitCheck_secretManagerEnabled(t *testing.T) {
 		})
 	}
 }
+
+// TestUnitCheck_defaultLabels verifies that the default labels of the provider are
+// merged into the labels of the check, which take precedence.
+func TestUnitCheck_defaultLabels(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCheck().Schema.Schema, map[string]any{
+		"job":    "test",
+		"target": "https://example.com",
+		"labels": map[string]any{"team": "my-team"},
+		"settings": []any{
+			map[string]any{
+				"http": []any{map[string]any{}},
+			},
+		},
+	})
+	ctx := common.ContextWithDefaultLabels(context.Background(), common.DefaultLabels{
+		"managed-by": "terraform",
+		"team":       "default-team",
+	})
+
+	check, err := makeCheck(ctx, d)
+	if err != nil {
+		t.Fatalf("makeCheck returned unexpected error: %v", err)
+	}
+	labels := map[string]string{}
+	for _, l := range check.Labels {
+		labels[l.Name] = l.Value
+	}
+	if expected := map[string]string{"managed-by": "terraform", "team": "my-team"}; !reflect.DeepEqual(labels, expected) {
+		t.Errorf("Labels = %v, want %v", labels, expected)
+	}
+}
@@ -47,6 +47,12 @@ func main() {
 		serveOpts...,
 	)
 
+	// If the provider is killed before this, the service accounts created for stack_slug are deleted by the next provider
+	// configured with the same stack once their token expired
+	if cleanupErr := provider.DeleteTemporaryStackCredentials(ctx); cleanupErr != nil {
+		log.Printf("[WARN] %v", cleanupErr)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,802 @@
+package generate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/pkg/generate/postprocessing"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/prometheus/alertmanager/matchers/parse"
+	"github.com/prometheus/common/model"
+	"github.com/zclconf/go-cty/cty"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	alertmanagerReportFile = "alertmanager-report.txt"
+
+	// Same as the depth of the policy blocks of grafana_notification_policy
+	alertmanagerMaxRouteDepth = 5
+
+	contactPointResourceType       = "grafana_contact_point"
+	notificationPolicyResourceType = "grafana_notification_policy"
+	muteTimingResourceType         = "grafana_mute_timing"
+	inhibitionRuleResourceType     = "grafana_apps_notifications_inhibitionrule_v1beta1"
+)
+
+// alertmanagerFile is the part of an Alertmanager configuration which can be translated to Grafana alerting resources.
+// The integrations of the receivers are kept as maps, so that their settings which can't be translated can be reported one by one.
+type alertmanagerFile struct {
+	Global            map[string]any             `yaml:"global"`
+	Route             *alertmanagerRoute         `yaml:"route"`
+	Receivers         []alertmanagerReceiver     `yaml:"receivers"`
+	InhibitRules      []alertmanagerInhibitRule  `yaml:"inhibit_rules"`
+	TimeIntervals     []alertmanagerTimeInterval `yaml:"time_intervals"`
+	MuteTimeIntervals []alertmanagerTimeInterval `yaml:"mute_time_intervals"` // Deprecated name of time_intervals
+	Templates         []string                   `yaml:"templates"`
+}
+
+type alertmanagerRoute struct {
+	Receiver            string               `yaml:"receiver"`
+	GroupBy             []string             `yaml:"group_by"`
+	Continue            bool                 `yaml:"continue"`
+	Match               map[string]string    `yaml:"match"`
+	MatchRE             map[string]string    `yaml:"match_re"`
+	Matchers            []string             `yaml:"matchers"`
+	GroupWait           string               `yaml:"group_wait"`
+	GroupInterval       string               `yaml:"group_interval"`
+	RepeatInterval      string               `yaml:"repeat_interval"`
+	MuteTimeIntervals   []string             `yaml:"mute_time_intervals"`
+	ActiveTimeIntervals []string             `yaml:"active_time_intervals"`
+	Routes              []*alertmanagerRoute `yaml:"routes"`
+}
+
+type alertmanagerReceiver struct {
+	Name         string                      `yaml:"name"`
+	Integrations map[string][]map[string]any `yaml:",inline"`
+}
+
+type alertmanagerInhibitRule struct {
+	SourceMatch    map[string]string `yaml:"source_match"`
+	SourceMatchRE  map[string]string `yaml:"source_match_re"`
+	SourceMatchers []string          `yaml:"source_matchers"`
+	TargetMatch    map[string]string `yaml:"target_match"`
+	TargetMatchRE  map[string]string `yaml:"target_match_re"`
+	TargetMatchers []string          `yaml:"target_matchers"`
+	Equal          []string          `yaml:"equal"`
+}
+
+type alertmanagerTimeInterval struct {
+	Name          string `yaml:"name"`
+	TimeIntervals []struct {
+		Times []struct {
+			StartTime string `yaml:"start_time"`
+			EndTime   string `yaml:"end_time"`
+		} `yaml:"times"`
+		Weekdays    []string `yaml:"weekdays"`
+		DaysOfMonth []string `yaml:"days_of_month"`
+		Months      []string `yaml:"months"`
+		Years       []string `yaml:"years"`
+		Location    string   `yaml:"location"`
+	} `yaml:"time_intervals"`
+}
+
+// alertmanagerTranslation is the result of the translation of an Alertmanager configuration.
+type alertmanagerTranslation struct {
+	blocks []*hclwrite.Block
+	// counts is the number of generated blocks per resource type
+	counts map[string]int
+	// untranslated lists the constructs which couldn't be translated
+	untranslated []string
+}
+
+func (t *alertmanagerTranslation) add(resourceType string, block *hclwrite.Block) {
+	t.blocks = append(t.blocks, block)
+	t.counts[resourceType]++
+}
+
+func (t *alertmanagerTranslation) untranslatedf(format string, args ...any) {
+	t.untranslated = append(t.untranslated, fmt.Sprintf(format, args...))
+}
+
+func validateAlertmanagerConfig(cfg *Config) error {
+	if cfg.Grafana != nil || cfg.Cloud != nil {
+		return errors.New("an Alertmanager configuration is translated without connecting to Grafana, it can't be used with Grafana or Grafana Cloud credentials")
+	}
+	if cfg.DriftSync {
+		return errors.New("drift sync can't be used with an Alertmanager configuration, there is nothing to sync")
+	}
+	if cfg.Format != "" && cfg.Format != OutputFormatHCL && cfg.Format != OutputFormatJSON {
+		return fmt.Errorf("an Alertmanager configuration can only be translated to the %q and %q output formats", OutputFormatHCL, OutputFormatJSON)
+	}
+	if cfg.Layout != "" && cfg.Layout != OutputLayoutFlat {
+		return errors.New("an Alertmanager configuration can only be translated to the flat output layout")
+	}
+	return nil
+}
+
+// generateAlertmanagerResources translates an Alertmanager configuration to new resources, written to resources.tf.
+// There is nothing to import: the resources are created by the next apply.
+func generateAlertmanagerResources(cfg *Config) GenerationResult {
+	content, err := os.ReadFile(cfg.AlertmanagerConfigFile)
+	if err != nil {
+		return failuref("failed to read the Alertmanager configuration: %w", err)
+	}
+
+	translation, err := translateAlertmanagerConfig(content)
+	if err != nil {
+		return failure(err)
+	}
+
+	result := GenerationResult{Untranslated: translation.untranslated}
+	for _, resourceType := range []string{contactPointResourceType, muteTimingResourceType, notificationPolicyResourceType, inhibitionRuleResourceType} {
+		result.Success = append(result.Success, GenerationSuccess{
+			Resource: &common.Resource{ResourceCommon: common.ResourceCommon{Name: resourceType, Category: common.CategoryAlerting}},
+			Blocks:   translation.counts[resourceType],
+		})
+	}
+
+	if err := writeBlocks(filepath.Join(cfg.OutputDir, "resources.tf"), translation.blocks...); err != nil {
+		return failure(err)
+	}
+	if err := writeAlertmanagerReport(filepath.Join(cfg.OutputDir, alertmanagerReportFile), translation.untranslated); err != nil {
+		return failure(err)
+	}
+	if cfg.Format == OutputFormatJSON {
+		if err := convertToTFJSON(cfg.OutputDir); err != nil {
+			return failure(err)
+		}
+	}
+
+	return result
+}
+
+func writeAlertmanagerReport(path string, untranslated []string) error {
+	var sb strings.Builder
+	sb.WriteString("# Constructs of the Alertmanager configuration which couldn't be translated\n")
+	sb.WriteString("# Review them before applying the generated resources, they have to be configured manually in Grafana.\n\n")
+	if len(untranslated) == 0 {
+		sb.WriteString("None\n")
+	}
+	for _, u := range untranslated {
+		sb.WriteString(u + "\n")
+	}
+	return os.WriteFile(path, []byte(sb.String()), 0600)
+}
+
+func translateAlertmanagerConfig(content []byte) (*alertmanagerTranslation, error) {
+	var file alertmanagerFile
+	if err := yaml.Unmarshal(content, &file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid Alertmanager configuration: %w", err)
+	}
+
+	t := &alertmanagerTranslation{counts: map[string]int{}}
+
+	for _, key := range sortedKeys(file.Global) {
+		if _, inherited := alertmanagerGlobalDefaults[key]; !inherited {
+			t.untranslatedf("global.%s can't be translated, e.g. the SMTP settings are part of the configuration of the Grafana server", key)
+		}
+	}
+	if len(file.Templates) > 0 {
+		t.untranslatedf("templates %v: the notification templates must be converted to grafana_message_template resources", file.Templates)
+	}
+
+	// Receivers without any integration which can be translated aren't generated, the routes use their name as is
+	contactPoints := map[string]bool{}
+	for _, receiver := range file.Receivers {
+		if block := translateAlertmanagerReceiver(t, receiver, file.Global); block != nil {
+			t.add(contactPointResourceType, block)
+			contactPoints[receiver.Name] = true
+		}
+	}
+
+	timeIntervals := map[string]bool{}
+	for _, interval := range append(file.TimeIntervals, file.MuteTimeIntervals...) {
+		t.add(muteTimingResourceType, translateAlertmanagerTimeInterval(interval))
+		timeIntervals[interval.Name] = true
+	}
+
+	if file.Route != nil {
+		policy := hclwrite.NewBlock("resource", []string{notificationPolicyResourceType, "policy"})
+		translateAlertmanagerRoute(t, file.Route, policy.Body(), 0, "route", contactPoints, timeIntervals)
+		t.add(notificationPolicyResourceType, policy)
+	}
+
+	for i, rule := range file.InhibitRules {
+		if block := translateAlertmanagerInhibitRule(t, i, rule); block != nil {
+			t.add(inhibitionRuleResourceType, block)
+		}
+	}
+
+	return t, nil
+}
+
+// translateAlertmanagerRoute translates the root route to the notification policy, and the child routes to nested policy blocks.
+func translateAlertmanagerRoute(t *alertmanagerTranslation, route *alertmanagerRoute, body *hclwrite.Body, depth int, path string, contactPoints, timeIntervals map[string]bool) {
+	if route.Receiver != "" {
+		setAlertmanagerReference(body, "contact_point", contactPointResourceType, route.Receiver, contactPoints)
+	}
+	// The root policy requires group_by, an empty list groups all alerts together like in Alertmanager
+	if len(route.GroupBy) > 0 || depth == 0 {
+		body.SetAttributeValue("group_by", stringListValue(route.GroupBy))
+	}
+
+	if depth > 0 {
+		matchers, err := alertmanagerMatchers(route.Match, route.MatchRE, route.Matchers)
+		if err != nil {
+			t.untranslatedf("%s: %s", path, err)
+		}
+		for _, m := range matchers {
+			matcher := body.AppendNewBlock("matcher", nil).Body()
+			matcher.SetAttributeValue("label", cty.StringVal(m.label))
+			matcher.SetAttributeValue("match", cty.StringVal(m.match))
+			matcher.SetAttributeValue("value", cty.StringVal(m.value))
+		}
+		if route.Continue {
+			body.SetAttributeValue("continue", cty.True)
+		}
+		setAlertmanagerReferences(body, "mute_timings", muteTimingResourceType, route.MuteTimeIntervals, timeIntervals)
+		setAlertmanagerReferences(body, "active_timings", muteTimingResourceType, route.ActiveTimeIntervals, timeIntervals)
+	} else if len(route.MuteTimeIntervals) > 0 || len(route.ActiveTimeIntervals) > 0 {
+		t.untranslatedf("%s: time intervals can't be set on the root route", path)
+	}
+
+	for _, setting := range []struct{ name, value string }{
+		{"group_wait", route.GroupWait},
+		{"group_interval", route.GroupInterval},
+		{"repeat_interval", route.RepeatInterval},
+	} {
+		if setting.value != "" {
+			body.SetAttributeValue(setting.name, cty.StringVal(setting.value))
+		}
+	}
+
+	for i, child := range route.Routes {
+		childPath := fmt.Sprintf("%s.routes[%d]", path, i)
+		if depth+1 > alertmanagerMaxRouteDepth {
+			t.untranslatedf("%s: routes can't be nested deeper than %d levels", childPath, alertmanagerMaxRouteDepth)
+			continue
+		}
+		translateAlertmanagerRoute(t, child, body.AppendNewBlock("policy", nil).Body(), depth+1, childPath, contactPoints, timeIntervals)
+	}
+}
+
+func translateAlertmanagerTimeInterval(interval alertmanagerTimeInterval) *hclwrite.Block {
+	block := hclwrite.NewBlock("resource", []string{muteTimingResourceType, postprocessing.CleanResourceName(interval.Name)})
+	block.Body().SetAttributeValue("name", cty.StringVal(interval.Name))
+
+	for _, ti := range interval.TimeIntervals {
+		body := block.Body().AppendNewBlock("intervals", nil).Body()
+		for _, times := range ti.Times {
+			timesBody := body.AppendNewBlock("times", nil).Body()
+			timesBody.SetAttributeValue("start", cty.StringVal(times.StartTime))
+			timesBody.SetAttributeValue("end", cty.StringVal(times.EndTime))
+		}
+		for _, ranges := range []struct {
+			name   string
+			values []string
+		}{
+			{"weekdays", ti.Weekdays},
+			{"days_of_month", ti.DaysOfMonth},
+			{"months", ti.Months},
+			{"years", ti.Years},
+		} {
+			if len(ranges.values) > 0 {
+				body.SetAttributeValue(ranges.name, stringListValue(ranges.values))
+			}
+		}
+		if ti.Location != "" {
+			body.SetAttributeValue("location", cty.StringVal(ti.Location))
+		}
+	}
+
+	return block
+}
+
+func translateAlertmanagerInhibitRule(t *alertmanagerTranslation, i int, rule alertmanagerInhibitRule) *hclwrite.Block {
+	path := fmt.Sprintf("inhibit_rules[%d]", i)
+	sourceMatchers, err := alertmanagerMatchers(rule.SourceMatch, rule.SourceMatchRE, rule.SourceMatchers)
+	if err != nil {
+		t.untranslatedf("%s: %s", path, err)
+		return nil
+	}
+	targetMatchers, err := alertmanagerMatchers(rule.TargetMatch, rule.TargetMatchRE, rule.TargetMatchers)
+	if err != nil {
+		t.untranslatedf("%s: %s", path, err)
+		return nil
+	}
+
+	uid := fmt.Sprintf("alertmanager-inhibit-rule-%d", i)
+	block := hclwrite.NewBlock("resource", []string{inhibitionRuleResourceType, postprocessing.CleanResourceName(uid)})
+	block.Body().AppendNewBlock("metadata", nil).Body().SetAttributeValue("uid", cty.StringVal(uid))
+	spec := block.Body().AppendNewBlock("spec", nil).Body()
+	for _, matchers := range []struct {
+		name     string
+		matchers []alertmanagerMatcher
+	}{
+		{"source_matchers", sourceMatchers},
+		{"target_matchers", targetMatchers},
+	} {
+		if len(matchers.matchers) == 0 {
+			continue
+		}
+		values := make([]cty.Value, 0, len(matchers.matchers))
+		for _, m := range matchers.matchers {
+			values = append(values, cty.ObjectVal(map[string]cty.Value{
+				"type":  cty.StringVal(m.match),
+				"label": cty.StringVal(m.label),
+				"value": cty.StringVal(m.value),
+			}))
+		}
+		spec.SetAttributeValue(matchers.name, cty.ListVal(values))
+	}
+	if len(rule.Equal) > 0 {
+		spec.SetAttributeValue("equal", stringListValue(rule.Equal))
+	}
+
+	return block
+}
+
+type alertmanagerMatcher struct {
+	label, match, value string
+}
+
+// alertmanagerMatchers merges the deprecated match and match_re maps with the matchers, sorted like Alertmanager does.
+func alertmanagerMatchers(match, matchRE map[string]string, matchers []string) ([]alertmanagerMatcher, error) {
+	var result []alertmanagerMatcher
+	for _, label := range sortedKeys(match) {
+		result = append(result, alertmanagerMatcher{label, "=", match[label]})
+	}
+	for _, label := range sortedKeys(matchRE) {
+		result = append(result, alertmanagerMatcher{label, "=~", matchRE[label]})
+	}
+	for _, input := range matchers {
+		parsed, err := parse.Matchers(input)
+		if err != nil {
+			return nil, fmt.Errorf("invalid matcher %q: %w", input, err)
+		}
+		for _, m := range parsed {
+			result = append(result, alertmanagerMatcher{m.Name, m.Type.String(), m.Value})
+		}
+	}
+	return result, nil
+}
+
+func setAlertmanagerReference(body *hclwrite.Body, attribute, resourceType, name string, generated map[string]bool) {
+	if generated[name] {
+		body.SetAttributeTraversal(attribute, traversal(resourceType, postprocessing.CleanResourceName(name), "name"))
+		return
+	}
+	body.SetAttributeValue(attribute, cty.StringVal(name))
+}
+
+func setAlertmanagerReferences(body *hclwrite.Body, attribute, resourceType string, names []string, generated map[string]bool) {
+	if len(names) == 0 {
+		return
+	}
+	elements := make([]hclwrite.Tokens, 0, len(names))
+	for _, name := range names {
+		if generated[name] {
+			elements = append(elements, hclwrite.TokensForTraversal(traversal(resourceType, postprocessing.CleanResourceName(name), "name")))
+		} else {
+			elements = append(elements, hclwrite.TokensForValue(cty.StringVal(name)))
+		}
+	}
+	body.SetAttributeRaw(attribute, hclwrite.TokensForTuple(elements))
+}
+
+// Settings of the global section which are inherited by the integrations
+var alertmanagerGlobalDefaults = map[string]struct{}{
+	"slack_api_url":     {},
+	"pagerduty_url":     {},
+	"opsgenie_api_url":  {},
+	"opsgenie_api_key":  {},
+	"victorops_api_url": {},
+	"victorops_api_key": {},
+	"webex_api_url":     {},
+}
+
+// alertmanagerIntegrationTranslator translates the settings of an Alertmanager integration to the settings of a notifier.
+// The settings which are read are marked as translated, the others are reported.
+type alertmanagerIntegrationTranslator func(s *alertmanagerSettings, global map[string]any)
+
+type alertmanagerIntegration struct {
+	notifier string
+	// Whether resolved alerts are notified when send_resolved isn't set
+	sendResolved bool
+	translate    alertmanagerIntegrationTranslator
+}
+
+var alertmanagerIntegrations = map[string]alertmanagerIntegration{
+	"discord_configs": {"discord", true, func(s *alertmanagerSettings, _ map[string]any) {
+		s.copy("webhook_url", "url")
+		s.copy("title", "title")
+		s.copy("message", "message")
+	}},
+	"email_configs": {"email", false, func(s *alertmanagerSettings, _ map[string]any) {
+		if to, ok := s.string("to"); ok {
+			var addresses []string
+			for _, address := range strings.Split(to, ",") {
+				addresses = append(addresses, strings.TrimSpace(address))
+			}
+			s.settings["addresses"] = stringListValue(addresses)
+		}
+		if headers, ok := s.values["headers"].(map[string]any); ok {
+			if subject, ok := headers["Subject"]; ok && len(headers) == 1 {
+				s.settings["subject"] = cty.StringVal(fmt.Sprint(subject))
+				s.translated["headers"] = true
+			}
+		}
+	}},
+	"jira_configs": {"jira", true, func(s *alertmanagerSettings, _ map[string]any) {
+		for _, key := range []string{"api_url", "project", "issue_type", "summary", "description", "priority", "resolve_transition", "reopen_transition", "reopen_duration", "wont_fix_resolution"} {
+			s.copy(key, key)
+		}
+		s.copyList("labels", "labels")
+		s.copyMap("fields", "fields")
+		s.httpConfig(map[string]string{"basic_auth.username": "user", "basic_auth.password": "password", "authorization.credentials": "api_token"})
+	}},
+	"msteams_configs": {"teams", true, translateAlertmanagerTeams},
+	// Workflows webhooks of Microsoft Teams are supported by the same notifier
+	"msteamsv2_configs": {"teams", true, translateAlertmanagerTeams},
+	"opsgenie_configs": {"opsgenie", true, func(s *alertmanagerSettings, global map[string]any) {
+		s.copyWithDefault("api_key", "api_key", global["opsgenie_api_key"])
+		if url, ok := s.stringWithDefault("api_url", global["opsgenie_api_url"]); ok {
+			s.settings["url"] = cty.StringVal(strings.TrimSuffix(url, "/") + "/v2/alerts")
+		}
+		s.copy("message", "message")
+		s.copy("description", "description")
+		if responders, ok := s.values["responders"].([]any); ok {
+			for _, responder := range responders {
+				settings, _ := responder.(map[string]any)
+				values := map[string]cty.Value{}
+				for _, key := range []string{"id", "name", "username", "type"} {
+					if v, ok := settings[key]; ok {
+						values[key] = cty.StringVal(fmt.Sprint(v))
+					}
+				}
+				s.blocks = append(s.blocks, alertmanagerNestedBlock{"responders", values})
+			}
+			s.translated["responders"] = true
+		}
+	}},
+	"pagerduty_configs": {"pagerduty", true, func(s *alertmanagerSettings, global map[string]any) {
+		// Grafana sends events with the Events API v2, the integration keys of the v1 API (service_key) don't work
+		s.copy("routing_key", "integration_key")
+		s.copyWithDefault("url", "url", global["pagerduty_url"])
+		s.copy("description", "summary")
+		for _, key := range []string{"client", "client_url", "severity", "class", "component", "group", "source"} {
+			s.copy(key, key)
+		}
+		s.copyMap("details", "details")
+	}},
+	"pushover_configs": {"pushover", true, func(s *alertmanagerSettings, _ map[string]any) {
+		s.copy("user_key", "user_key")
+		s.copy("token", "api_token")
+		for _, key := range []string{"title", "message", "sound", "device"} {
+			s.copy(key, key)
+		}
+		if priority, ok := s.string("priority"); ok {
+			if p, err := strconv.Atoi(priority); err == nil {
+				s.settings["priority"] = cty.NumberIntVal(int64(p))
+			} else {
+				delete(s.translated, "priority") // Templated priorities aren't supported
+			}
+		}
+		for _, key := range []string{"retry", "expire"} {
+			if value, ok := s.string(key); ok {
+				if d, err := model.ParseDuration(value); err == nil {
+					s.settings[key] = cty.NumberIntVal(int64(time.Duration(d) / time.Second))
+				} else {
+					delete(s.translated, key)
+				}
+			}
+		}
+	}},
+	"slack_configs": {"slack", false, func(s *alertmanagerSettings, global map[string]any) {
+		// Without a bearer token, the URL is an incoming webhook. With one, it is the endpoint of the Slack API
+		if token, ok := s.httpConfigString("authorization.credentials"); ok {
+			s.settings["token"] = cty.StringVal(token)
+			s.copyWithDefault("api_url", "endpoint_url", global["slack_api_url"])
+		} else {
+			s.copyWithDefault("api_url", "url", global["slack_api_url"])
+		}
+		s.copy("channel", "recipient")
+		for _, key := range []string{"username", "title", "text", "icon_emoji", "icon_url", "color"} {
+			s.copy(key, key)
+		}
+	}},
+	"sns_configs": {"sns", true, func(s *alertmanagerSettings, _ map[string]any) {
+		s.copy("topic_arn", "topic")
+		s.copy("subject", "subject")
+		s.copy("message", "body")
+		if sigv4, ok := s.values["sigv4"].(map[string]any); ok {
+			translated := true
+			for key, value := range sigv4 {
+				switch key {
+				case "access_key", "secret_key":
+					s.settings[key] = cty.StringVal(fmt.Sprint(value))
+				case "role_arn":
+					s.settings["assume_role_arn"] = cty.StringVal(fmt.Sprint(value))
+				default:
+					translated = false
+				}
+			}
+			s.translated["sigv4"] = translated
+		}
+	}},
+	"telegram_configs": {"telegram", true, func(s *alertmanagerSettings, _ map[string]any) {
+		s.copy("bot_token", "token")
+		for _, key := range []string{"chat_id", "message_thread_id", "message", "parse_mode"} {
+			s.copy(key, key)
+		}
+		s.copyBool("disable_notifications", "disable_notifications")
+	}},
+	"victorops_configs": {"victorops", true, func(s *alertmanagerSettings, global map[string]any) {
+		// The URL of the REST endpoint includes the API key and the routing key
+		apiURL, hasURL := s.stringWithDefault("api_url", global["victorops_api_url"])
+		if !hasURL {
+			apiURL = "https://alert.victorops.com/integrations/generic/20131114/alert/"
+		}
+		apiKey, hasKey := s.stringWithDefault("api_key", global["victorops_api_key"])
+		routingKey, hasRoutingKey := s.string("routing_key")
+		if hasKey && hasRoutingKey {
+			s.settings["url"] = cty.StringVal(strings.TrimSuffix(apiURL, "/") + "/" + apiKey + "/" + routingKey)
+		}
+		s.copy("message_type", "message_type")
+		s.copy("entity_display_name", "title")
+		s.copy("state_message", "description")
+	}},
+	"webex_configs": {"webex", true, func(s *alertmanagerSettings, global map[string]any) {
+		s.copyWithDefault("api_url", "api_url", global["webex_api_url"])
+		s.copy("room_id", "room_id")
+		s.copy("message", "message")
+		s.httpConfig(map[string]string{"authorization.credentials": "token"})
+	}},
+	"webhook_configs": {"webhook", true, func(s *alertmanagerSettings, _ map[string]any) {
+		s.copy("url", "url")
+		s.copyInt("max_alerts", "max_alerts")
+		s.httpConfig(map[string]string{
+			"basic_auth.username":             "basic_auth_user",
+			"basic_auth.password":             "basic_auth_password",
+			"authorization.type":              "authorization_scheme",
+			"authorization.credentials":       "authorization_credentials",
+			"tls_config.insecure_skip_verify": "tls_config.insecure_skip_verify",
+		})
+	}},
+}
+
+func translateAlertmanagerTeams(s *alertmanagerSettings, _ map[string]any) {
+	s.copy("webhook_url", "url")
+	s.copy("title", "title")
+	s.copy("text", "message")
+}
+
+// translateAlertmanagerReceiver translates a receiver to a contact point, or returns nil if none of its integrations can be translated.
+func translateAlertmanagerReceiver(t *alertmanagerTranslation, receiver alertmanagerReceiver, global map[string]any) *hclwrite.Block {
+	block := hclwrite.NewBlock("resource", []string{contactPointResourceType, postprocessing.CleanResourceName(receiver.Name)})
+	block.Body().SetAttributeValue("name", cty.StringVal(receiver.Name))
+
+	notifiers := 0
+	for _, integrationType := range sortedKeys(receiver.Integrations) {
+		integration, ok := alertmanagerIntegrations[integrationType]
+		if !ok {
+			t.untranslatedf("receiver %q: %s are not supported by Grafana", receiver.Name, integrationType)
+			continue
+		}
+
+		for i, values := range receiver.Integrations[integrationType] {
+			s := &alertmanagerSettings{values: values, settings: map[string]cty.Value{}, translated: map[string]bool{}}
+			integration.translate(s, global)
+
+			sendResolved := integration.sendResolved
+			if v, ok := values["send_resolved"].(bool); ok {
+				sendResolved = v
+				s.translated["send_resolved"] = true
+			}
+			if !sendResolved {
+				s.settings["disable_resolve_message"] = cty.True
+			}
+
+			for _, key := range sortedKeys(values) {
+				if !s.translated[key] {
+					t.untranslatedf("receiver %q: %s[%d].%s can't be translated", receiver.Name, integrationType, i, key)
+				}
+			}
+
+			notifier := block.Body().AppendNewBlock(integration.notifier, nil).Body()
+			for _, key := range sortedKeys(s.settings) {
+				notifier.SetAttributeValue(key, s.settings[key])
+			}
+			for _, nested := range s.blocks {
+				nestedBody := notifier.AppendNewBlock(nested.name, nil).Body()
+				for _, key := range sortedKeys(nested.values) {
+					nestedBody.SetAttributeValue(key, nested.values[key])
+				}
+			}
+			notifiers++
+		}
+	}
+
+	if notifiers == 0 {
+		t.untranslatedf("receiver %q: no integration can be translated, the contact point isn't generated and the policies refer to it by name", receiver.Name)
+		return nil
+	}
+	return block
+}
+
+type alertmanagerNestedBlock struct {
+	name   string
+	values map[string]cty.Value
+}
+
+// alertmanagerSettings holds the settings of an integration, and the settings of the notifier they are translated to.
+type alertmanagerSettings struct {
+	values     map[string]any
+	settings   map[string]cty.Value
+	blocks     []alertmanagerNestedBlock
+	translated map[string]bool
+}
+
+func (s *alertmanagerSettings) string(key string) (string, bool) {
+	value, ok := s.values[key]
+	if !ok || value == nil {
+		return "", false
+	}
+	s.translated[key] = true
+	return fmt.Sprint(value), true
+}
+
+// stringWithDefault reads a setting, or its default from the global section.
+func (s *alertmanagerSettings) stringWithDefault(key string, globalValue any) (string, bool) {
+	if value, ok := s.string(key); ok {
+		return value, true
+	}
+	if globalValue == nil {
+		return "", false
+	}
+	return fmt.Sprint(globalValue), true
+}
+
+func (s *alertmanagerSettings) copy(key, setting string) {
+	if value, ok := s.string(key); ok {
+		s.settings[setting] = cty.StringVal(value)
+	}
+}
+
+func (s *alertmanagerSettings) copyWithDefault(key, setting string, globalValue any) {
+	if value, ok := s.stringWithDefault(key, globalValue); ok {
+		s.settings[setting] = cty.StringVal(value)
+	}
+}
+
+func (s *alertmanagerSettings) copyBool(key, setting string) {
+	if value, ok := s.values[key].(bool); ok {
+		s.settings[setting] = cty.BoolVal(value)
+		s.translated[key] = true
+	}
+}
+
+func (s *alertmanagerSettings) copyInt(key, setting string) {
+	if value, ok := s.values[key].(int); ok {
+		s.settings[setting] = cty.NumberIntVal(int64(value))
+		s.translated[key] = true
+	}
+}
+
+func (s *alertmanagerSettings) copyList(key, setting string) {
+	values, ok := s.values[key].([]any)
+	if !ok {
+		return
+	}
+	list := make([]string, 0, len(values))
+	for _, v := range values {
+		list = append(list, fmt.Sprint(v))
+	}
+	s.settings[setting] = stringListValue(list)
+	s.translated[key] = true
+}
+
+// copyMap copies a map of settings. The values which aren't strings are encoded as JSON.
+func (s *alertmanagerSettings) copyMap(key, setting string) {
+	values, ok := s.values[key].(map[string]any)
+	if !ok {
+		return
+	}
+	m := map[string]cty.Value{}
+	for k, v := range values {
+		if str, ok := v.(string); ok {
+			m[k] = cty.StringVal(str)
+			continue
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return
+		}
+		m[k] = cty.StringVal(string(encoded))
+	}
+	if len(m) == 0 {
+		return
+	}
+	s.settings[setting] = cty.MapVal(m)
+	s.translated[key] = true
+}
+
+// httpConfigString reads a setting of http_config, e.g. "authorization.credentials".
+func (s *alertmanagerSettings) httpConfigString(path string) (string, bool) {
+	httpConfig, _ := s.values["http_config"].(map[string]any)
+	section, key, _ := strings.Cut(path, ".")
+	values, _ := httpConfig[section].(map[string]any)
+	value, ok := values[key]
+	if !ok || value == nil {
+		return "", false
+	}
+	return fmt.Sprint(value), true
+}
+
+// httpConfig translates the settings of http_config to notifier settings. Settings in the "map.key" format are set in a map setting.
+// http_config is reported if it has any other setting.
+func (s *alertmanagerSettings) httpConfig(settings map[string]string) {
+	httpConfig, ok := s.values["http_config"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	translated := true
+	maps := map[string]map[string]cty.Value{}
+	for section, values := range httpConfig {
+		sectionValues, ok := values.(map[string]any)
+		if !ok {
+			translated = false
+			continue
+		}
+		for key, value := range sectionValues {
+			setting, ok := settings[section+"."+key]
+			if !ok {
+				translated = false
+				continue
+			}
+			if mapName, mapKey, isMap := strings.Cut(setting, "."); isMap {
+				if maps[mapName] == nil {
+					maps[mapName] = map[string]cty.Value{}
+				}
+				maps[mapName][mapKey] = cty.StringVal(fmt.Sprint(value))
+				continue
+			}
+			s.settings[setting] = cty.StringVal(fmt.Sprint(value))
+		}
+	}
+	for name, values := range maps {
+		s.settings[name] = cty.MapVal(values)
+	}
+	s.translated["http_config"] = translated
+}
+
+func stringListValue(values []string) cty.Value {
+	if len(values) == 0 {
+		return cty.ListValEmpty(cty.String)
+	}
+	list := make([]cty.Value, 0, len(values))
+	for _, v := range values {
+		list = append(list, cty.StringVal(v))
+	}
+	return cty.ListVal(list)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,250 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAlertmanagerConfig = `
+global:
+  slack_api_url: https://hooks.slack.com/services/T000/B000/XXXX
+  smtp_smarthost: smtp.example.com:587
+templates:
+  - /etc/alertmanager/templates/*.tmpl
+route:
+  receiver: team-ops
+  group_by: [alertname, cluster]
+  group_wait: 30s
+  routes:
+    - receiver: team-db
+      matchers:
+        - service=~"mysql|postgres"
+        - severity!="info"
+      continue: true
+      mute_time_intervals: [out-of-hours]
+      routes:
+        - receiver: blackhole
+          match:
+            env: dev
+time_intervals:
+  - name: out-of-hours
+    time_intervals:
+      - times:
+          - start_time: "18:00"
+            end_time: "24:00"
+        weekdays: ["monday:friday"]
+        location: Europe/Paris
+receivers:
+  - name: team-ops
+    slack_configs:
+      - channel: "#ops"
+        title: "{{ .CommonLabels.alertname }}"
+        send_resolved: true
+        actions: []
+    pagerduty_configs:
+      - routing_key: secret-key
+        severity: critical
+  - name: team-db
+    webhook_configs:
+      - url: https://example.com/hook
+        max_alerts: 10
+        http_config:
+          basic_auth:
+            username: user
+            password: pass
+          proxy_url: http://proxy:8080
+    wechat_configs:
+      - corp_id: corp
+  - name: blackhole
+inhibit_rules:
+  - source_matchers: [severity="critical"]
+    target_match:
+      severity: warning
+    equal: [alertname]
+`
+
+func TestTranslateAlertmanagerConfig(t *testing.T) {
+	t.Parallel()
+
+	translation, err := translateAlertmanagerConfig([]byte(testAlertmanagerConfig))
+	require.NoError(t, err)
+
+	file := hclwrite.NewEmptyFile()
+	for _, block := range translation.blocks {
+		file.Body().AppendBlock(block)
+	}
+	assert.Equal(t, `resource "grafana_contact_point" "team-ops" {
+  name = "team-ops"
+  pagerduty {
+    integration_key = "secret-key"
+    severity        = "critical"
+  }
+  slack {
+    recipient = "#ops"
+    title     = "{{ .CommonLabels.alertname }}"
+    url       = "https://hooks.slack.com/services/T000/B000/XXXX"
+  }
+}
+resource "grafana_contact_point" "team-db" {
+  name = "team-db"
+  webhook {
+    basic_auth_password = "pass"
+    basic_auth_user     = "user"
+    max_alerts          = 10
+    url                 = "https://example.com/hook"
+  }
+}
+resource "grafana_mute_timing" "out-of-hours" {
+  name = "out-of-hours"
+  intervals {
+    times {
+      start = "18:00"
+      end   = "24:00"
+    }
+    weekdays = ["monday:friday"]
+    location = "Europe/Paris"
+  }
+}
+resource "grafana_notification_policy" "policy" {
+  contact_point = grafana_contact_point.team-ops.name
+  group_by      = ["alertname", "cluster"]
+  group_wait    = "30s"
+  policy {
+    contact_point = grafana_contact_point.team-db.name
+    matcher {
+      label = "service"
+      match = "=~"
+      value = "mysql|postgres"
+    }
+    matcher {
+      label = "severity"
+      match = "!="
+      value = "info"
+    }
+    continue     = true
+    mute_timings = [grafana_mute_timing.out-of-hours.name]
+    policy {
+      contact_point = "blackhole"
+      matcher {
+        label = "env"
+        match = "="
+        value = "dev"
+      }
+    }
+  }
+}
+resource "grafana_apps_notifications_inhibitionrule_v1beta1" "alertmanager-inhibit-rule-0" {
+  metadata {
+    uid = "alertmanager-inhibit-rule-0"
+  }
+  spec {
+    source_matchers = [{
+      label = "severity"
+      type  = "="
+      value = "critical"
+    }]
+    target_matchers = [{
+      label = "severity"
+      type  = "="
+      value = "warning"
+    }]
+    equal = ["alertname"]
+  }
+}
+`, string(hclwrite.Format(file.Bytes())))
+
+	assert.Equal(t, map[string]int{
+		contactPointResourceType:       2,
+		muteTimingResourceType:         1,
+		notificationPolicyResourceType: 1,
+		inhibitionRuleResourceType:     1,
+	}, translation.counts)
+
+	assert.Equal(t, []string{
+		"global.smtp_smarthost can't be translated, e.g. the SMTP settings are part of the configuration of the Grafana server",
+		"templates [/etc/alertmanager/templates/*.tmpl]: the notification templates must be converted to grafana_message_template resources",
+		`receiver "team-ops": slack_configs[0].actions can't be translated`,
+		`receiver "team-db": webhook_configs[0].http_config can't be translated`,
+		`receiver "team-db": wechat_configs are not supported by Grafana`,
+		`receiver "blackhole": no integration can be translated, the contact point isn't generated and the policies refer to it by name`,
+	}, translation.untranslated)
+}
+
+func TestTranslateAlertmanagerIntegrations(t *testing.T) {
+	t.Parallel()
+
+	translation, err := translateAlertmanagerConfig([]byte(`
+global:
+  opsgenie_api_key: global-key
+  victorops_api_key: victorops-key
+receivers:
+  - name: all
+    opsgenie_configs:
+      - message: "{{ .CommonLabels.alertname }}"
+        responders:
+          - type: team
+            name: ops
+    victorops_configs:
+      - routing_key: ops
+    email_configs:
+      - to: "a@example.com, b@example.com"
+        headers:
+          Subject: Alert
+    pushover_configs:
+      - user_key: user
+        token: token
+        priority: '{{ if eq .Status "firing" }}2{{ else }}0{{ end }}'
+        retry: 1m
+`))
+	require.NoError(t, err)
+	require.Len(t, translation.blocks, 1)
+
+	assert.Equal(t, `resource "grafana_contact_point" "all" {
+  name = "all"
+  email {
+    addresses               = ["a@example.com", "b@example.com"]
+    disable_resolve_message = true
+    subject                 = "Alert"
+  }
+  opsgenie {
+    api_key = "global-key"
+    message = "{{ .CommonLabels.alertname }}"
+    responders {
+      name = "ops"
+      type = "team"
+    }
+  }
+  pushover {
+    api_token = "token"
+    retry     = 60
+    user_key  = "user"
+  }
+  victorops {
+    url = "https://alert.victorops.com/integrations/generic/20131114/alert/victorops-key/ops"
+  }
+}
+`, string(hclwrite.Format(translation.blocks[0].BuildTokens(nil).Bytes())))
+
+	assert.Equal(t, []string{`receiver "all": pushover_configs[0].priority can't be translated`}, translation.untranslated)
+}
+
+func TestTranslateAlertmanagerConfigInvalidMatcher(t *testing.T) {
+	t.Parallel()
+
+	translation, err := translateAlertmanagerConfig([]byte(`
+route:
+  receiver: default
+  routes:
+    - matchers: ['severity=~"("']
+inhibit_rules:
+  - source_matchers: ["="]
+`))
+	require.NoError(t, err)
+	require.Len(t, translation.untranslated, 2)
+	assert.Contains(t, translation.untranslated[0], `route.routes[0]: invalid matcher "severity=~\"(\""`)
+	assert.Contains(t, translation.untranslated[1], `inhibit_rules[0]: invalid matcher "="`)
+	assert.Empty(t, translation.blocks[1:])
+}
@@ -0,0 +1,63 @@
+package generate
+
+import (
+	"context"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/appplatform"
+	appplatformgeneric "github.com/grafana/terraform-provider-grafana/v4/internal/resources/appplatform/generic"
+	"github.com/grafana/terraform-provider-grafana/v4/pkg/provider"
+)
+
+// appPlatformResources returns the App Platform resources to generate.
+// They are wrapped as common resources so that they go through the same listing and import pipeline as the other resources.
+// Only the latest version of each kind is generated, to avoid importing the same object twice.
+// Kinds served by the instance which have no typed resource are generated with the generic resource.
+func appPlatformResources() []*common.Resource {
+	type groupKind struct{ group, kind string }
+
+	var (
+		typedKinds = map[groupKind]appplatform.NamedResource{}
+		order      []groupKind
+	)
+	for _, r := range provider.AppPlatformResources() {
+		if r.ListIDsFunc == nil {
+			continue
+		}
+
+		// Resources are registered from the oldest to the newest version of their kind
+		key := groupKind{r.Kind.Group(), r.Kind.Kind()}
+		if _, ok := typedKinds[key]; !ok {
+			order = append(order, key)
+		}
+		typedKinds[key] = r
+	}
+
+	resources := make([]*common.Resource, 0, len(order)+1)
+	for _, key := range order {
+		r := typedKinds[key]
+		resources = append(resources, &common.Resource{
+			ResourceCommon: common.ResourceCommon{
+				Name:     r.Name,
+				Category: r.Category,
+			},
+			ListIDsFunc: r.ListIDsFunc,
+		})
+	}
+
+	generic := appplatformgeneric.GenericResource()
+	resources = append(resources, &common.Resource{
+		ResourceCommon: common.ResourceCommon{
+			Name:     generic.Name,
+			Category: generic.Category,
+		},
+		ListIDsFunc: func(ctx context.Context, client *common.Client, _ any) ([]string, error) {
+			return appplatformgeneric.ListIDs(ctx, client, func(apiGroup, kind string) bool {
+				_, ok := typedKinds[groupKind{apiGroup, kind}]
+				return ok
+			})
+		},
+	})
+
+	return resources
+}
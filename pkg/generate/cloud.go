@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/cloud"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/frontendo11y"
 	"github.com/grafana/terraform-provider-grafana/v4/pkg/generate/postprocessing"
 	"github.com/grafana/terraform-provider-grafana/v4/pkg/provider"
 	"github.com/hashicorp/hcl/v2/hclwrite"
@@ -32,6 +34,13 @@ type stack struct {
 
 	onCallURL   string
 	onCallToken string
+
+	fleetManagementURL  string
+	fleetManagementAuth string
+	cloudProviderURL    string
+	cloudProviderToken  string
+	connectionsURL      string
+	connectionsToken    string
 }
 
 func generateCloudResources(ctx context.Context, cfg *Config) ([]stack, GenerationResult) {
@@ -77,8 +86,9 @@ func generateCloudResources(ctx context.Context, cfg *Config) ([]stack, Generati
 		}
 	}
 
+	// Frontend Observability apps are managed with the cloud access policy token, like the stacks they belong to
 	data := cloud.NewListerData(cfg.Cloud.Org)
-	returnResult := generateImportBlocks(ctx, client, data, cloud.Resources, cfg, "cloud")
+	returnResult := generateImportBlocks(ctx, client, data, slices.Concat(cloud.Resources, frontendo11y.Resources), cfg, "cloud")
 	if returnResult.Blocks() == 0 { // Skip if no resources were found
 		return nil, returnResult
 	}
@@ -150,6 +160,9 @@ func generateCloudResources(ctx context.Context, cfg *Config) ([]stack, Generati
 		providerBlock.Body().SetAttributeTraversal("auth", traversal("grafana_cloud_stack_service_account_token", stack.Slug, "key"))
 		providerBlock.Body().SetAttributeTraversal("sm_access_token", traversal("grafana_synthetic_monitoring_installation", stack.Slug, "sm_access_token"))
 		providerBlock.Body().SetAttributeTraversal("sm_url", traversal("grafana_synthetic_monitoring_installation", stack.Slug, "stack_sm_api_url"))
+		if cfg.AppPlatform {
+			providerBlock.Body().SetAttributeTraversal("stack_id", traversal("grafana_cloud_stack", stack.Slug, "id"))
+		}
 
 		if err := writeBlocks(filepath.Join(cfg.OutputDir, fmt.Sprintf("stack-%s-provider.tf", stack.Slug)), saBlock, saTokenBlock, smInstallationMetricsPublishBlock, smInstallationTokenBlock, smInstallationBlock, providerBlock); err != nil {
 			return nil, failuref("failed to write management service account blocks for stack %q: %w", stack.Slug, err)
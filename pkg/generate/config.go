@@ -11,9 +11,22 @@ const (
 	OutputFormatJSON       OutputFormat = "json"
 	OutputFormatHCL        OutputFormat = "hcl"
 	OutputFormatCrossplane OutputFormat = "crossplane"
+	// Programs using the Grafana provider bridged to Pulumi and CDKTF
+	OutputFormatPulumiYAML      OutputFormat = "pulumi-yaml"
+	OutputFormatCDKTFTypeScript OutputFormat = "cdktf-ts"
 )
 
-var OutputFormats = []OutputFormat{OutputFormatJSON, OutputFormatHCL, OutputFormatCrossplane}
+var OutputFormats = []OutputFormat{OutputFormatJSON, OutputFormatHCL, OutputFormatCrossplane, OutputFormatPulumiYAML, OutputFormatCDKTFTypeScript}
+
+type OutputLayout string
+
+const (
+	OutputLayoutFlat     OutputLayout = "flat"
+	OutputLayoutCategory OutputLayout = "category"
+	OutputLayoutFolder   OutputLayout = "folder"
+)
+
+var OutputLayouts = []OutputLayout{OutputLayoutFlat, OutputLayoutCategory, OutputLayoutFolder}
 
 type GrafanaConfig struct {
 	URL                 string
@@ -23,6 +36,13 @@ type GrafanaConfig struct {
 	SMAccessToken       string
 	OnCallURL           string
 	OnCallAccessToken   string
+
+	FleetManagementURL        string
+	FleetManagementAuth       string
+	CloudProviderURL          string
+	CloudProviderAccessToken  string
+	ConnectionsAPIURL         string
+	ConnectionsAPIAccessToken string
 }
 
 type CloudConfig struct {
@@ -32,7 +52,29 @@ type CloudConfig struct {
 	StackServiceAccountName   string
 }
 
+// Engine is the binary which runs the generation, through `plan -generate-config-out`.
+type Engine string
+
+const (
+	EngineTerraform Engine = "terraform"
+	EngineOpenTofu  Engine = "tofu"
+)
+
+var Engines = []Engine{EngineTerraform, EngineOpenTofu}
+
+// displayName returns the name of the engine in messages, the engine defaults to Terraform.
+func (e Engine) displayName() string {
+	if e == EngineOpenTofu {
+		return "OpenTofu"
+	}
+	return "Terraform"
+}
+
 type TerraformInstallConfig struct {
+	// Engine defaults to Terraform. OpenTofu is never installed, it is looked up in InstallDir and in the PATH.
+	Engine Engine
+	// BinaryPath is the path to an existing Terraform or OpenTofu binary. If set, nothing is installed.
+	BinaryPath string
 	InstallDir string
 	Version    *version.Version
 	PluginDir  string
@@ -49,10 +91,35 @@ type Config struct {
 	Clobber           bool
 	OutputCredentials bool
 	Format            OutputFormat
-	ProviderVersion   string
-	Grafana           *GrafanaConfig
-	Cloud             *CloudConfig
+	// Layout splits the generated resources into one Terraform module per resource category or per Grafana folder.
+	// Modules are written to the modules/ directory and references between them are wired with outputs and variables.
+	// Defaults to a flat layout, with all resources in the root module.
+	Layout          OutputLayout
+	ProviderVersion string
+	Grafana         *GrafanaConfig
+	Cloud           *CloudConfig
+
+	// AppPlatform enables the generation of App Platform resources (grafana_apps_*).
+	// Kinds without a typed resource are generated as grafana_apps_generic_resource.
+	// Some kinds overlap with legacy resources, ex: dashboards are generated both as grafana_dashboard and grafana_apps_dashboard_dashboard_*.
+	AppPlatform bool
+
+	// DriftSync generates only the objects which are not yet managed in an existing output directory.
+	// Existing files are left untouched: new import and resource blocks are written to drift-imports.tf and drift-resources.tf,
+	// and managed objects which no longer exist upstream are listed in drift-report.txt.
+	DriftSync bool
+
+	// AlertmanagerConfigFile is the path to an Alertmanager configuration file (alertmanager.yml) to translate to alerting resources,
+	// instead of generating the resources of a Grafana instance. Receivers become contact points, the routing tree becomes the notification policy,
+	// time intervals become mute timings and inhibit rules become App Platform inhibition rules.
+	// The constructs which can't be translated are listed in alertmanager-report.txt.
+	AlertmanagerConfigFile string
 
 	TerraformInstallConfig TerraformInstallConfig
 	Terraform              *tfexec.Terraform
+
+	// drift is what is already managed in the output directory, set during a drift sync
+	drift *driftState
+	// identityImports is set when Terraform supports import blocks with the identity of the resources (Terraform 1.12+)
+	identityImports bool
 }
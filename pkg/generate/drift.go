@@ -0,0 +1,293 @@
+package generate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/grafana/terraform-provider-grafana/v4/pkg/generate/utils"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+const (
+	driftFilePrefix = "drift-"
+	driftReportFile = "drift-report.txt"
+)
+
+// Import IDs of org-scoped resources are written with or without the default org prefix depending on how many orgs exist.
+var defaultOrgIDPrefix = regexp.MustCompile(`^[01]:`)
+
+// StaleResource is a resource managed in the output directory of a drift sync, whose object no longer exists upstream.
+type StaleResource struct {
+	Address string
+	ID      string
+}
+
+// driftState holds what is already managed in the output directory of a drift sync.
+// It is shared by the parallel listers, so it is safe for concurrent use.
+type driftState struct {
+	// managed maps resource types to the (normalized) IDs of the objects that are already managed, in the state or with an import block
+	managed map[string]map[string]struct{}
+	// stateResources are the managed resources of the Terraform state, used to find the ones that no longer exist upstream
+	stateResources []*tfjson.StateResource
+	// existingAddresses are the resource addresses used in the state or in the configuration
+	existingAddresses map[string]struct{}
+
+	mu sync.Mutex
+	// newAddresses are the resource addresses given to the generated resources
+	newAddresses map[string]struct{}
+	// listed maps resource types to the (normalized) IDs that were listed upstream during the run
+	listed map[string]map[string]struct{}
+}
+
+func validateDriftSync(cfg *Config) error {
+	if cfg.Clobber {
+		return errors.New("drift sync cannot be used with the clobber option, it never deletes existing files")
+	}
+	if cfg.Cloud != nil {
+		return errors.New("drift sync is only supported when generating resources from a Grafana instance")
+	}
+	if cfg.Format != OutputFormatHCL {
+		return fmt.Errorf("drift sync only supports the %q output format", OutputFormatHCL)
+	}
+	if stat, err := os.Stat(cfg.OutputDir); err != nil || !stat.IsDir() {
+		return fmt.Errorf("drift sync requires an existing output dir, %q could not be read", cfg.OutputDir)
+	}
+	for _, suffix := range []string{"imports.tf", "resources.tf"} {
+		path := filepath.Join(cfg.OutputDir, driftFilePrefix+suffix)
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists, merge it into the workspace or delete it before running a new drift sync", path)
+		}
+	}
+	return nil
+}
+
+// loadDriftState reads the managed resources from the workspace's state, and the import blocks from its configuration.
+func loadDriftState(ctx context.Context, cfg *Config) (*driftState, error) {
+	d := &driftState{
+		managed:           map[string]map[string]struct{}{},
+		existingAddresses: map[string]struct{}{},
+		newAddresses:      map[string]struct{}{},
+		listed:            map[string]map[string]struct{}{},
+	}
+
+	state, err := getState(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if state.Values != nil {
+		d.addStateModule(state.Values.RootModule)
+	}
+
+	err = filepath.WalkDir(cfg.OutputDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() && entry.Name() == ".terraform" {
+			return filepath.SkipDir
+		}
+		if entry.IsDir() || filepath.Ext(path) != ".tf" {
+			return nil
+		}
+		return d.addConfigFile(path)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the existing configuration: %w", err)
+	}
+
+	return d, nil
+}
+
+func (d *driftState) addStateModule(module *tfjson.StateModule) {
+	if module == nil {
+		return
+	}
+	for _, r := range module.Resources {
+		if r.Mode != tfjson.ManagedResourceMode {
+			continue
+		}
+		d.existingAddresses[r.Type+"."+r.Name] = struct{}{}
+		if id, ok := r.AttributeValues["id"].(string); ok {
+			d.addManaged(r.Type, id)
+			d.stateResources = append(d.stateResources, r)
+		}
+	}
+	for _, child := range module.ChildModules {
+		d.addStateModule(child)
+	}
+}
+
+func (d *driftState) addConfigFile(path string) error {
+	file, err := utils.ReadHCLFile(path)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range file.Body().Blocks() {
+		switch block.Type() {
+		case "resource":
+			if labels := block.Labels(); len(labels) == 2 {
+				d.existingAddresses[labels[0]+"."+labels[1]] = struct{}{}
+			}
+		case "import":
+			to := block.Body().GetAttribute("to")
+			if to == nil {
+				continue
+			}
+			// Imports of resources in modules target `module.<name>.<type>.<name>`
+			address := strings.Split(strings.TrimSpace(string(to.Expr().BuildTokens(nil).Bytes())), ".")
+			for len(address) > 2 && address[0] == "module" {
+				address = address[2:]
+			}
+			// The import is written with the ID, or with the identity of the resource (Terraform 1.12+)
+			if id, ok := importBlockID(address[0], block); ok {
+				d.addManaged(address[0], id)
+			}
+		}
+	}
+	return nil
+}
+
+func (d *driftState) addManaged(resourceType, id string) {
+	if d.managed[resourceType] == nil {
+		d.managed[resourceType] = map[string]struct{}{}
+	}
+	d.managed[resourceType][normalizeDriftID(id)] = struct{}{}
+}
+
+// unmanagedIDs records the IDs listed upstream for the given resource type, and returns the ones which are not managed yet.
+func (d *driftState) unmanagedIDs(resourceType string, ids []string) []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.listed[resourceType] == nil {
+		d.listed[resourceType] = map[string]struct{}{}
+	}
+
+	var unmanaged []string
+	for _, id := range ids {
+		normalized := normalizeDriftID(id)
+		d.listed[resourceType][normalized] = struct{}{}
+		if _, ok := d.managed[resourceType][normalized]; !ok {
+			unmanaged = append(unmanaged, id)
+		}
+	}
+	return unmanaged
+}
+
+// reserveName returns a resource name which is not used yet for the given resource type, based on the given name.
+func (d *driftState) reserveName(resourceType, name string) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	candidate := name
+	for i := 2; d.addressInUse(resourceType + "." + candidate); i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	d.newAddresses[resourceType+"."+candidate] = struct{}{}
+	return candidate
+}
+
+func (d *driftState) addressInUse(address string) bool {
+	_, existing := d.existingAddresses[address]
+	_, generated := d.newAddresses[address]
+	return existing || generated
+}
+
+// staleResources returns the resources of the state whose objects were not listed upstream.
+// Only resource types which were successfully listed during the run are considered.
+func (d *driftState) staleResources() []StaleResource {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var stale []StaleResource
+	for _, r := range d.stateResources {
+		listed, ok := d.listed[r.Type]
+		if !ok {
+			continue
+		}
+		id := r.AttributeValues["id"].(string)
+		if _, ok := listed[normalizeDriftID(id)]; !ok {
+			stale = append(stale, StaleResource{Address: r.Address, ID: id})
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool {
+		return stale[i].Address < stale[j].Address
+	})
+	return stale
+}
+
+// renameConflictingResources renames the generated resources whose preferred name is already used in the workspace.
+// Import blocks are written with unique names, but the preferred names which replace them may conflict.
+// It is a no-op outside of drift sync.
+func renameConflictingResources(d *driftState, resourcesFile, importsFile string) error {
+	if d == nil {
+		return nil
+	}
+
+	resources, err := utils.ReadHCLFile(resourcesFile)
+	if err != nil {
+		return err
+	}
+	renamed := map[string]string{}
+	for _, block := range resources.Body().Blocks() {
+		labels := block.Labels()
+		if block.Type() != "resource" || len(labels) != 2 {
+			continue
+		}
+		oldAddress := labels[0] + "." + labels[1]
+		if _, ok := d.existingAddresses[oldAddress]; !ok {
+			continue
+		}
+		newName := d.reserveName(labels[0], labels[1])
+		block.SetLabels([]string{labels[0], newName})
+		renamed[oldAddress] = labels[0] + "." + newName
+	}
+	if len(renamed) == 0 {
+		return nil
+	}
+
+	imports, err := utils.ReadHCLFile(importsFile)
+	if err != nil {
+		return err
+	}
+	for _, block := range imports.Body().Blocks() {
+		to := block.Body().GetAttribute("to")
+		if block.Type() != "import" || to == nil {
+			continue
+		}
+		if newAddress, ok := renamed[strings.TrimSpace(string(to.Expr().BuildTokens(nil).Bytes()))]; ok {
+			resourceType, name, _ := strings.Cut(newAddress, ".")
+			block.Body().SetAttributeTraversal("to", traversal(resourceType, name))
+		}
+	}
+
+	if err := os.WriteFile(resourcesFile, resources.Bytes(), 0600); err != nil {
+		return err
+	}
+	return os.WriteFile(importsFile, imports.Bytes(), 0600)
+}
+
+func writeDriftReport(path string, stale []StaleResource) error {
+	var sb strings.Builder
+	sb.WriteString("# Managed resources whose object no longer exists upstream\n")
+	sb.WriteString("# Remove them from the configuration (and from the state with `terraform state rm`) or recreate them with `terraform apply`.\n\n")
+	if len(stale) == 0 {
+		sb.WriteString("None\n")
+	}
+	for _, r := range stale {
+		fmt.Fprintf(&sb, "%s (id: %s)\n", r.Address, r.ID)
+	}
+	return os.WriteFile(path, []byte(sb.String()), 0600)
+}
+
+func normalizeDriftID(id string) string {
+	return defaultOrgIDPrefix.ReplaceAllString(id, "")
+}
@@ -0,0 +1,161 @@
+package generate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDriftState(t *testing.T, config string, stateResources ...*tfjson.StateResource) *driftState {
+	t.Helper()
+
+	d := &driftState{
+		managed:           map[string]map[string]struct{}{},
+		existingAddresses: map[string]struct{}{},
+		newAddresses:      map[string]struct{}{},
+		listed:            map[string]map[string]struct{}{},
+	}
+	d.addStateModule(&tfjson.StateModule{Resources: stateResources})
+
+	configFile := filepath.Join(t.TempDir(), "resources.tf")
+	require.NoError(t, os.WriteFile(configFile, []byte(config), 0600)) //nolint:gosec // path is in test tempdir
+	require.NoError(t, d.addConfigFile(configFile))
+
+	return d
+}
+
+func stateResource(resourceType, name, id string) *tfjson.StateResource {
+	return &tfjson.StateResource{
+		Address:         resourceType + "." + name,
+		Mode:            tfjson.ManagedResourceMode,
+		Type:            resourceType,
+		Name:            name,
+		AttributeValues: map[string]any{"id": id},
+	}
+}
+
+func TestDriftUnmanagedIDs(t *testing.T) {
+	t.Parallel()
+
+	d := newTestDriftState(t, `
+import {
+  to = grafana_folder.imported
+  id = "1:imported"
+}
+
+import {
+  to = module.grafana-oss.grafana_folder.in_module
+  id = "in_module"
+}
+
+import {
+  to       = grafana_folder.by_identity
+  identity = { org_id = 1, uid = "by_identity" }
+}
+`,
+		stateResource("grafana_folder", "managed", "managed"),
+		stateResource("grafana_dashboard", "managed", "1:dashboard"),
+	)
+
+	// The default org prefix is ignored when comparing IDs
+	assert.Equal(t, []string{"new"}, d.unmanagedIDs("grafana_folder", []string{"1:managed", "imported", "in_module", "by_identity", "new"}))
+	assert.Equal(t, []string{"0:other"}, d.unmanagedIDs("grafana_dashboard", []string{"dashboard", "0:other"}))
+	assert.Equal(t, []string{"2:dashboard"}, d.unmanagedIDs("grafana_dashboard", []string{"2:dashboard"}))
+}
+
+func TestDriftReserveName(t *testing.T) {
+	t.Parallel()
+
+	d := newTestDriftState(t, `
+resource "grafana_folder" "edited" {
+  title = "Edited by hand"
+}
+`,
+		stateResource("grafana_folder", "managed", "managed"),
+	)
+
+	assert.Equal(t, "new", d.reserveName("grafana_folder", "new"))
+	assert.Equal(t, "new_2", d.reserveName("grafana_folder", "new"))
+	assert.Equal(t, "managed_2", d.reserveName("grafana_folder", "managed"))
+	assert.Equal(t, "edited_2", d.reserveName("grafana_folder", "edited"))
+	assert.Equal(t, "managed", d.reserveName("grafana_dashboard", "managed"))
+}
+
+func TestDriftStaleResources(t *testing.T) {
+	t.Parallel()
+
+	d := newTestDriftState(t, "",
+		stateResource("grafana_folder", "kept", "kept"),
+		stateResource("grafana_folder", "deleted", "1:deleted"),
+		stateResource("grafana_dashboard", "not_listed", "not_listed"),
+	)
+	d.unmanagedIDs("grafana_folder", []string{"kept", "new"})
+
+	// Resource types which were not listed are never reported
+	assert.Equal(t, []StaleResource{{Address: "grafana_folder.deleted", ID: "1:deleted"}}, d.staleResources())
+}
+
+func TestRenameConflictingResources(t *testing.T) {
+	t.Parallel()
+
+	d := newTestDriftState(t, "", stateResource("grafana_folder", "my_folder", "existing"))
+	// The import block was written with a unique name, but the preferred name conflicts with the existing resource
+	assert.Equal(t, "new", d.reserveName("grafana_folder", "new"))
+
+	dir := t.TempDir()
+	resourcesFile := filepath.Join(dir, "drift-resources.tf")
+	importsFile := filepath.Join(dir, "drift-imports.tf")
+	require.NoError(t, os.WriteFile(resourcesFile, []byte(`resource "grafana_folder" "my_folder" {
+  title = "My Folder"
+}
+`), 0600))
+	require.NoError(t, os.WriteFile(importsFile, []byte(`import {
+  to = grafana_folder.my_folder
+  id = "new"
+}
+`), 0600))
+
+	require.NoError(t, renameConflictingResources(d, resourcesFile, importsFile))
+	require.NoError(t, renameConflictingResources(nil, resourcesFile, importsFile)) // No-op outside of drift sync
+
+	resources, err := os.ReadFile(resourcesFile)
+	require.NoError(t, err)
+	assert.Equal(t, `resource "grafana_folder" "my_folder_2" {
+  title = "My Folder"
+}
+`, string(resources))
+
+	imports, err := os.ReadFile(importsFile)
+	require.NoError(t, err)
+	assert.Equal(t, `import {
+  to = grafana_folder.my_folder_2
+  id = "new"
+}
+`, string(imports))
+}
+
+func TestWriteDriftReport(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	emptyReport := filepath.Join(dir, "empty.txt")
+	require.NoError(t, writeDriftReport(emptyReport, nil))
+	content, err := os.ReadFile(emptyReport)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "\nNone\n")
+
+	report := filepath.Join(dir, "report.txt")
+	require.NoError(t, writeDriftReport(report, []StaleResource{
+		{Address: "grafana_folder.deleted", ID: "deleted"},
+		{Address: "grafana_team.deleted", ID: "1:5"},
+	}))
+	content, err = os.ReadFile(report)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "grafana_folder.deleted (id: deleted)\ngrafana_team.deleted (id: 1:5)\n")
+	assert.NotContains(t, string(content), "None")
+}
@@ -2,6 +2,7 @@ package generate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -46,9 +47,20 @@ type GenerationSuccess struct {
 	Blocks   int
 }
 
+// GenerationSkip is a resource type which was not generated, along with the reason why.
+type GenerationSkip struct {
+	Resource *common.Resource
+	Reason   string
+}
+
 type GenerationResult struct {
 	Success []GenerationSuccess
-	Errors  []error
+	Skipped []GenerationSkip
+	// Stale is set by drift syncs, with the managed resources whose object no longer exists upstream
+	Stale []StaleResource
+	// Untranslated is set when translating an Alertmanager configuration, with the constructs which couldn't be translated
+	Untranslated []string
+	Errors       []error
 }
 
 func (r GenerationResult) Blocks() int {
@@ -59,6 +71,38 @@ func (r GenerationResult) Blocks() int {
 	return blocks
 }
 
+// SkippedResources returns the skipped resource types, sorted by name and deduplicated
+// (the same resource type may be skipped once per generated stack).
+func (r GenerationResult) SkippedResources() []GenerationSkip {
+	type skipKey struct{ name, reason string }
+	seen := map[skipKey]struct{}{}
+	skipped := []GenerationSkip{}
+	for _, s := range r.Skipped {
+		key := skipKey{s.Resource.Name, s.Reason}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		skipped = append(skipped, s)
+	}
+	sort.Slice(skipped, func(i, j int) bool {
+		if skipped[i].Resource.Name != skipped[j].Resource.Name {
+			return skipped[i].Resource.Name < skipped[j].Resource.Name
+		}
+		return skipped[i].Reason < skipped[j].Reason
+	})
+	return skipped
+}
+
+func (r GenerationResult) hasCriticalErrors() bool {
+	for _, err := range r.Errors {
+		if _, ok := err.(NonCriticalError); !ok {
+			return true
+		}
+	}
+	return false
+}
+
 func failure(err error) GenerationResult {
 	return GenerationResult{
 		Errors: []error{err},
@@ -77,7 +121,21 @@ func Generate(ctx context.Context, cfg *Config) GenerationResult {
 		}
 	}
 
-	if _, err := os.Stat(cfg.OutputDir); err == nil && cfg.Clobber {
+	if err := validateLayout(cfg); err != nil {
+		return failure(err)
+	}
+
+	if cfg.AlertmanagerConfigFile != "" {
+		if err := validateAlertmanagerConfig(cfg); err != nil {
+			return failure(err)
+		}
+	}
+
+	if cfg.DriftSync {
+		if err := validateDriftSync(cfg); err != nil {
+			return failure(err)
+		}
+	} else if _, err := os.Stat(cfg.OutputDir); err == nil && cfg.Clobber {
 		log.Printf("Deleting all files in %s", cfg.OutputDir)
 		if err := os.RemoveAll(cfg.OutputDir); err != nil {
 			return failuref("failed to delete %s: %s", cfg.OutputDir, err)
@@ -99,16 +157,24 @@ func Generate(ctx context.Context, cfg *Config) GenerationResult {
 	}
 	defer os.Remove(filepath.Join(cfg.OutputDir, provider.EnableGenerateMarkerFile))
 
-	// Generate provider installation block
-	providerBlock := hclwrite.NewBlock("terraform", nil)
-	requiredProvidersBlock := hclwrite.NewBlock("required_providers", nil)
-	requiredProvidersBlock.Body().SetAttributeValue("grafana", cty.ObjectVal(map[string]cty.Value{
-		"source":  cty.StringVal("grafana/grafana"),
-		"version": cty.StringVal(strings.TrimPrefix(cfg.ProviderVersion, "v")),
-	}))
-	providerBlock.Body().AppendBlock(requiredProvidersBlock)
-	if err := writeBlocks(filepath.Join(cfg.OutputDir, "provider.tf"), providerBlock); err != nil {
-		return failure(err)
+	// Generate provider installation block. In drift sync mode, the workspace already has one
+	if !cfg.DriftSync {
+		providerBlock := hclwrite.NewBlock("terraform", nil)
+		requiredProvidersBlock := hclwrite.NewBlock("required_providers", nil)
+		requiredProvidersBlock.Body().SetAttributeValue("grafana", cty.ObjectVal(map[string]cty.Value{
+			"source":  cty.StringVal("grafana/grafana"),
+			"version": cty.StringVal(strings.TrimPrefix(cfg.ProviderVersion, "v")),
+		}))
+		providerBlock.Body().AppendBlock(requiredProvidersBlock)
+		if err := writeBlocks(filepath.Join(cfg.OutputDir, "provider.tf"), providerBlock); err != nil {
+			return failure(err)
+		}
+	}
+
+	// Translating an Alertmanager configuration doesn't read anything from Grafana, Terraform isn't needed
+	if cfg.AlertmanagerConfigFile != "" {
+		log.Printf("Translating the Alertmanager configuration %s", cfg.AlertmanagerConfigFile)
+		return generateAlertmanagerResources(cfg)
 	}
 
 	tf, err := setupTerraform(cfg)
@@ -117,6 +183,18 @@ func Generate(ctx context.Context, cfg *Config) GenerationResult {
 		return failuref("failed to run terraform init: %w", err)
 	}
 	cfg.Terraform = tf
+	if cfg.identityImports, err = supportsIdentityImports(tf, cfg.TerraformInstallConfig); err != nil {
+		return failure(err)
+	}
+	defer func() { cfg.identityImports = false }()
+
+	if cfg.DriftSync {
+		log.Printf("Reading the resources managed in %s", cfg.OutputDir)
+		if cfg.drift, err = loadDriftState(ctx, cfg); err != nil {
+			return failuref("failed to read the existing workspace: %w", err)
+		}
+		defer func() { cfg.drift = nil }()
+	}
 
 	var returnResult GenerationResult
 	if cfg.Cloud != nil {
@@ -128,6 +206,7 @@ func Generate(ctx context.Context, cfg *Config) GenerationResult {
 			stack.name = "stack-" + stack.slug
 			stackResult := generateGrafanaResources(ctx, cfg, stack, false)
 			returnResult.Success = append(returnResult.Success, stackResult.Success...)
+			returnResult.Skipped = append(returnResult.Skipped, stackResult.Skipped...)
 			returnResult.Errors = append(returnResult.Errors, stackResult.Errors...)
 		}
 	}
@@ -141,9 +220,36 @@ func Generate(ctx context.Context, cfg *Config) GenerationResult {
 			smURL:         cfg.Grafana.SMURL,
 			onCallToken:   cfg.Grafana.OnCallAccessToken,
 			onCallURL:     cfg.Grafana.OnCallURL,
+
+			fleetManagementURL:  cfg.Grafana.FleetManagementURL,
+			fleetManagementAuth: cfg.Grafana.FleetManagementAuth,
+			cloudProviderURL:    cfg.Grafana.CloudProviderURL,
+			cloudProviderToken:  cfg.Grafana.CloudProviderAccessToken,
+			connectionsURL:      cfg.Grafana.ConnectionsAPIURL,
+			connectionsToken:    cfg.Grafana.ConnectionsAPIAccessToken,
 		}
 		log.Printf("Generating Grafana resources")
-		returnResult = generateGrafanaResources(ctx, cfg, stack, true)
+		returnResult = generateGrafanaResources(ctx, cfg, stack, !cfg.DriftSync)
+	}
+
+	// After a critical failure, the resources that were not reached would be wrongly reported as skipped
+	if !returnResult.hasCriticalErrors() {
+		unhandled, err := unhandledResources(returnResult, cfg.IncludeResources)
+		if err != nil {
+			return failure(err)
+		}
+		returnResult.Skipped = append(returnResult.Skipped, skip("the generator is not configured with credentials for the resource's API", unhandled...)...)
+	}
+
+	if cfg.DriftSync {
+		if !returnResult.hasCriticalErrors() {
+			returnResult.Stale = cfg.drift.staleResources()
+			if err := writeDriftReport(filepath.Join(cfg.OutputDir, driftReportFile), returnResult.Stale); err != nil {
+				return failure(err)
+			}
+		}
+		// Existing files are left untouched, there is nothing more to do
+		return returnResult
 	}
 
 	if !cfg.OutputCredentials && cfg.Format != OutputFormatCrossplane {
@@ -169,10 +275,28 @@ func Generate(ctx context.Context, cfg *Config) GenerationResult {
 		return returnResult
 	}
 
-	if cfg.Format == OutputFormatJSON {
-		if err := convertToTFJSON(cfg.OutputDir); err != nil {
+	if cfg.Format == OutputFormatPulumiYAML || cfg.Format == OutputFormatCDKTFTypeScript {
+		if err := convertToProgram(cfg); err != nil {
 			return failure(err)
 		}
+		return returnResult
+	}
+
+	outputDirs := []string{cfg.OutputDir}
+	if cfg.Layout != "" && cfg.Layout != OutputLayoutFlat {
+		moduleDirs, err := splitIntoModules(cfg)
+		if err != nil {
+			return failuref("failed to split the resources into modules: %w", err)
+		}
+		outputDirs = append(outputDirs, moduleDirs...)
+	}
+
+	if cfg.Format == OutputFormatJSON {
+		for _, dir := range outputDirs {
+			if err := convertToTFJSON(dir); err != nil {
+				return failure(err)
+			}
+		}
 	}
 
 	return returnResult
@@ -180,11 +304,7 @@ func Generate(ctx context.Context, cfg *Config) GenerationResult {
 
 func generateImportBlocks(ctx context.Context, client *common.Client, listerData any, resources []*common.Resource, cfg *Config, provider string) GenerationResult {
 	generatedFilename := func(suffix string) string {
-		if provider == "" {
-			return filepath.Join(cfg.OutputDir, suffix)
-		}
-
-		return filepath.Join(cfg.OutputDir, provider+"-"+suffix)
+		return generatedFilePath(cfg, provider, suffix)
 	}
 
 	resources, err := filterResources(resources, cfg.IncludeResources)
@@ -198,6 +318,7 @@ func generateImportBlocks(ctx context.Context, client *common.Client, listerData
 	type result struct {
 		resource *common.Resource
 		blocks   []*hclwrite.Block
+		skipped  bool
 		err      error
 	}
 	results := make(chan result, len(resources))
@@ -209,6 +330,7 @@ func generateImportBlocks(ctx context.Context, client *common.Client, listerData
 				log.Printf("skipping %s because it does not have a lister\n", resource.Name)
 				results <- result{
 					resource: resource,
+					skipped:  true,
 				}
 				wg.Done()
 				return
@@ -225,6 +347,11 @@ func generateImportBlocks(ctx context.Context, client *common.Client, listerData
 				return
 			}
 
+			// In drift sync mode, only import the objects which are not managed yet
+			if cfg.drift != nil {
+				listedIDs = cfg.drift.unmanagedIDs(resource.Name, listedIDs)
+			}
+
 			// Make sure IDs are unique. If an API returns the same ID multiple times for any reason, we only want to import it once.
 			idMap := map[string]struct{}{}
 			for _, id := range listedIDs {
@@ -236,7 +363,7 @@ func generateImportBlocks(ctx context.Context, client *common.Client, listerData
 			}
 			sort.Strings(ids)
 
-			// Write blocks like these
+			// Write blocks like these, with `identity = { ... }` instead of the ID if Terraform supports it
 			// import {
 			//   to = aws_iot_thing.bar
 			//   id = "foo"
@@ -260,10 +387,17 @@ func generateImportBlocks(ctx context.Context, client *common.Client, listerData
 					id = provider + "_" + id
 				}
 				resourceName := postprocessing.CleanResourceName(id)
+				if cfg.drift != nil {
+					resourceName = cfg.drift.reserveName(resource.Name, resourceName)
+				}
 
 				b := hclwrite.NewBlock("import", nil)
 				b.Body().SetAttributeTraversal("to", traversal(resource.Name, resourceName))
-				b.Body().SetAttributeValue("id", cty.StringVal(id))
+				if identity, ok := importIdentity(cfg, resource, id); ok {
+					b.Body().SetAttributeValue("identity", identity)
+				} else {
+					b.Body().SetAttributeValue("id", cty.StringVal(id))
+				}
 				if provider != "" {
 					b.Body().SetAttributeTraversal("provider", traversal("grafana", provider))
 				}
@@ -292,6 +426,11 @@ func generateImportBlocks(ctx context.Context, client *common.Client, listerData
 				Resource: r.resource,
 				Err:      r.err,
 			})
+		} else if r.skipped {
+			returnResult.Skipped = append(returnResult.Skipped, GenerationSkip{
+				Resource: r.resource,
+				Reason:   "the resource does not have a lister",
+			})
 		} else {
 			resultsSlice = append(resultsSlice, r)
 			returnResult.Success = append(returnResult.Success, GenerationSuccess{
@@ -331,6 +470,7 @@ func generateImportBlocks(ctx context.Context, client *common.Client, listerData
 		postprocessing.ReplaceNullSensitiveAttributes(generatedFilename("resources.tf")),
 		removeOrphanedImports(generatedFilename("imports.tf"), generatedFilename("resources.tf")),
 		postprocessing.UsePreferredResourceNames(generatedFilename("resources.tf"), generatedFilename("imports.tf")),
+		renameConflictingResources(cfg.drift, generatedFilename("resources.tf"), generatedFilename("imports.tf")),
 		sortResourcesFile(generatedFilename("resources.tf")),
 		postprocessing.WrapJSONFieldsInFunction(generatedFilename("resources.tf")),
 	} {
@@ -342,6 +482,68 @@ func generateImportBlocks(ctx context.Context, client *common.Client, listerData
 	return returnResult
 }
 
+func skip(reason string, resources ...*common.Resource) []GenerationSkip {
+	skipped := make([]GenerationSkip, 0, len(resources))
+	for _, resource := range resources {
+		skipped = append(skipped, GenerationSkip{Resource: resource, Reason: reason})
+	}
+	return skipped
+}
+
+// filterSkipped removes the skipped resources which are excluded with the include filter.
+func filterSkipped(skipped []GenerationSkip, includedResources []string) ([]GenerationSkip, error) {
+	filtered := []GenerationSkip{}
+	for _, s := range skipped {
+		included, err := filterResources([]*common.Resource{s.Resource}, includedResources)
+		if err != nil {
+			return nil, err
+		}
+		if len(included) > 0 {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered, nil
+}
+
+// unhandledResources returns the provider's resources which were neither generated nor skipped during the run.
+// Resources excluded with the include filter are not returned.
+func unhandledResources(result GenerationResult, includedResources []string) ([]*common.Resource, error) {
+	handled := map[string]struct{}{}
+	for _, s := range result.Success {
+		handled[s.Resource.Name] = struct{}{}
+	}
+	for _, s := range result.Skipped {
+		handled[s.Resource.Name] = struct{}{}
+	}
+	for _, err := range result.Errors {
+		var resourceErr ResourceError
+		if errors.As(err, &resourceErr) {
+			handled[resourceErr.Resource.Name] = struct{}{}
+		}
+	}
+
+	var unhandled []*common.Resource
+	for _, resource := range provider.Resources() {
+		if _, ok := handled[resource.Name]; !ok {
+			unhandled = append(unhandled, resource)
+		}
+	}
+	return filterResources(unhandled, includedResources)
+}
+
+// generatedFilePath returns the path of a generated file, prefixed by the name of the provider alias it belongs to, if any.
+// In drift sync mode, generated files are also prefixed with "drift-" so that existing files are not overwritten.
+func generatedFilePath(cfg *Config, provider, suffix string) string {
+	if cfg.DriftSync {
+		suffix = driftFilePrefix + suffix
+	}
+	if provider == "" {
+		return filepath.Join(cfg.OutputDir, suffix)
+	}
+
+	return filepath.Join(cfg.OutputDir, provider+"-"+suffix)
+}
+
 // removeOrphanedImports removes import blocks that do not have a corresponding resource block in the resources file.
 // These happen when the Terraform plan command has failed for some resources.
 func removeOrphanedImports(importsFile, resourcesFile string) error {
@@ -62,6 +62,7 @@ func (tc *generateTestCase) Run(t *testing.T) {
 									Auth: "admin:admin",
 								},
 								TerraformInstallConfig: generate.TerraformInstallConfig{
+									Engine:     testEngine(),
 									InstallDir: tc.tfInstallDir,
 									PluginDir:  pluginDir(t),
 								},
@@ -108,6 +109,58 @@ func TestAccGenerate(t *testing.T) {
 				})
 			},
 		},
+		{
+			name:   "reports-skipped-resources",
+			config: testutils.TestAccExample(t, "resources/grafana_dashboard/resource.tf"),
+			resultCheck: func(t *testing.T, result generate.GenerationResult) {
+				require.Len(t, result.Errors, 0, "expected no errors, got: %v", result.Errors)
+
+				reasons := map[string]string{}
+				for _, s := range result.SkippedResources() {
+					reasons[s.Resource.Name] = s.Reason
+				}
+				assert.Equal(t, "the Grafana instance is not a Grafana Cloud stack", reasons["grafana_slo"])
+				assert.Equal(t, "no Synthetic Monitoring URL and access token were given", reasons["grafana_synthetic_monitoring_check"])
+				assert.Equal(t, "no Fleet Management URL and auth were given", reasons["grafana_fleet_management_collector"])
+				assert.Equal(t, "Frontend Observability apps are only generated with the Grafana Cloud resources", reasons["grafana_frontend_o11y_app"])
+				assert.NotContains(t, reasons, "grafana_dashboard")
+			},
+		},
+		{
+			name:   "skipped-resources-respect-include-filter",
+			config: testutils.TestAccExample(t, "resources/grafana_dashboard/resource.tf"),
+			generateConfig: func(cfg *generate.Config) {
+				cfg.IncludeResources = []string{"grafana_dashboard.*"}
+			},
+			resultCheck: func(t *testing.T, result generate.GenerationResult) {
+				require.Len(t, result.Errors, 0, "expected no errors, got: %v", result.Errors)
+				assert.Empty(t, result.SkippedResources())
+			},
+		},
+		{
+			name:   "dashboard-category-layout",
+			config: testutils.TestAccExample(t, "resources/grafana_dashboard/resource.tf"),
+			generateConfig: func(cfg *generate.Config) {
+				cfg.Layout = generate.OutputLayoutCategory
+			},
+			check: func(t *testing.T, tempDir string) {
+				assert.NoFileExists(t, filepath.Join(tempDir, "resources.tf"))
+
+				modules, err := os.ReadFile(filepath.Join(tempDir, "modules.tf"))
+				require.NoError(t, err)
+				assert.Contains(t, string(modules), `source = "./modules/grafana-oss"`)
+
+				resources, err := os.ReadFile(filepath.Join(tempDir, "modules", "grafana-oss", "resources.tf"))
+				require.NoError(t, err)
+				assert.Contains(t, string(resources), `resource "grafana_dashboard" "my-dashboard-uid"`)
+				assert.Contains(t, string(resources), `resource "grafana_folder" "my-folder-uid"`)
+
+				imports, err := os.ReadFile(filepath.Join(tempDir, "imports.tf"))
+				require.NoError(t, err)
+				assert.Contains(t, string(imports), "to = module.grafana-oss.grafana_dashboard.my-dashboard-uid")
+				assert.Contains(t, string(imports), "to = module.alerting.grafana_contact_point.email_receiver")
+			},
+		},
 		{
 			name: "large-dashboards-exported-to-files",
 			config: func() string {
@@ -154,6 +207,26 @@ func TestAccGenerate(t *testing.T) {
 				assertFiles(t, tempDir, "testdata/generate/dashboard-crossplane", nil)
 			},
 		},
+		{
+			name:   "dashboard-pulumi-yaml",
+			config: testutils.TestAccExample(t, "resources/grafana_dashboard/resource.tf"),
+			generateConfig: func(cfg *generate.Config) {
+				cfg.Format = generate.OutputFormatPulumiYAML
+			},
+			check: func(t *testing.T, tempDir string) {
+				assertFiles(t, tempDir, "testdata/generate/dashboard-pulumi-yaml", nil)
+			},
+		},
+		{
+			name:   "dashboard-cdktf-ts",
+			config: testutils.TestAccExample(t, "resources/grafana_dashboard/resource.tf"),
+			generateConfig: func(cfg *generate.Config) {
+				cfg.Format = generate.OutputFormatCDKTFTypeScript
+			},
+			check: func(t *testing.T, tempDir string) {
+				assertFiles(t, tempDir, "testdata/generate/dashboard-cdktf-ts", nil)
+			},
+		},
 		{
 			name:   "dashboard-filter-strict",
 			config: testutils.TestAccExample(t, "resources/grafana_dashboard/resource.tf"),
@@ -369,6 +442,97 @@ func TestAccGenerate_RestrictedPermissions(t *testing.T) {
 	tc.Run(t)
 }
 
+func TestAccGenerate_AppPlatform(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t, ">=12.2.0")
+
+	tc := generateTestCase{
+		name:   "app-platform",
+		config: testutils.TestAccExample(t, "resources/grafana_apps_playlist_playlist_v1/resource.tf"),
+		generateConfig: func(cfg *generate.Config) {
+			cfg.AppPlatform = true
+			cfg.IncludeResources = []string{
+				"grafana_apps_playlist_playlist_v1.*",
+			}
+		},
+		check: func(t *testing.T, tempDir string) {
+			imports, err := os.ReadFile(filepath.Join(tempDir, "imports.tf"))
+			require.NoError(t, err)
+			assert.Contains(t, string(imports), "to = grafana_apps_playlist_playlist_v1.example-playlist")
+			assert.Contains(t, string(imports), `id = "example-playlist"`)
+
+			resources, err := os.ReadFile(filepath.Join(tempDir, "resources.tf"))
+			require.NoError(t, err)
+			assert.Contains(t, string(resources), `resource "grafana_apps_playlist_playlist_v1" "example-playlist"`)
+			assert.Contains(t, string(resources), `"Example Playlist"`)
+		},
+	}
+
+	tc.Run(t)
+}
+
+func TestAccGenerate_DriftSync(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	installDir := t.TempDir()
+	tc := generateTestCase{
+		name:   "drift-sync",
+		config: testutils.TestAccExample(t, "resources/grafana_dashboard/resource.tf"),
+		generateConfig: func(cfg *generate.Config) {
+			cfg.OutputCredentials = true // The drift sync uses the provider block of the first generation
+			cfg.IncludeResources = []string{"grafana_folder.*"}
+		},
+		check: func(t *testing.T, tempDir string) {
+			imports, err := os.ReadFile(filepath.Join(tempDir, "imports.tf"))
+			require.NoError(t, err)
+			resources, err := os.ReadFile(filepath.Join(tempDir, "resources.tf"))
+			require.NoError(t, err)
+
+			result := generate.Generate(context.Background(), &generate.Config{
+				OutputDir:        tempDir,
+				DriftSync:        true,
+				Format:           generate.OutputFormatHCL,
+				ProviderVersion:  "999.999.999",
+				IncludeResources: []string{"grafana_folder.*", "grafana_dashboard.*"},
+				Grafana: &generate.GrafanaConfig{
+					URL:  "http://localhost:3000",
+					Auth: "admin:admin",
+				},
+				TerraformInstallConfig: generate.TerraformInstallConfig{
+					Engine:     testEngine(),
+					InstallDir: installDir,
+					PluginDir:  pluginDir(t),
+				},
+			})
+			require.Len(t, result.Errors, 0, "expected no errors, got: %v", result.Errors)
+			assert.Empty(t, result.Stale)
+
+			// Existing files are left untouched
+			gotImports, err := os.ReadFile(filepath.Join(tempDir, "imports.tf"))
+			require.NoError(t, err)
+			assert.Equal(t, string(imports), string(gotImports))
+			gotResources, err := os.ReadFile(filepath.Join(tempDir, "resources.tf"))
+			require.NoError(t, err)
+			assert.Equal(t, string(resources), string(gotResources))
+
+			// Only the unmanaged dashboard is generated
+			driftImports, err := os.ReadFile(filepath.Join(tempDir, "drift-imports.tf"))
+			require.NoError(t, err)
+			assert.Contains(t, string(driftImports), "to = grafana_dashboard.my-dashboard-uid")
+			assert.NotContains(t, string(driftImports), "grafana_folder")
+			driftResources, err := os.ReadFile(filepath.Join(tempDir, "drift-resources.tf"))
+			require.NoError(t, err)
+			assert.Contains(t, string(driftResources), `resource "grafana_dashboard" "my-dashboard-uid"`)
+
+			report, err := os.ReadFile(filepath.Join(tempDir, "drift-report.txt"))
+			require.NoError(t, err)
+			assert.Contains(t, string(report), "\nNone\n")
+		},
+		tfInstallDir: installDir,
+	}
+
+	tc.Run(t)
+}
+
 func TestAccGenerate_SMCheck(t *testing.T) {
 	testutils.CheckCloudInstanceTestsEnabled(t)
 
@@ -412,6 +576,40 @@ func TestAccGenerate_SMCheck(t *testing.T) {
 	tc.Run(t)
 }
 
+func TestAccGenerate_FleetManagementCollector(t *testing.T) {
+	testutils.CheckCloudInstanceTestsEnabled(t)
+
+	collectorID := "testacc_" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+
+	tc := generateTestCase{
+		name: "fleet-management-collector",
+		config: testutils.TestAccExampleWithReplace(t, "resources/grafana_fleet_management_collector/resource.tf", map[string]string{
+			`"my_collector"`: strconv.Quote(collectorID),
+		}),
+		generateConfig: func(cfg *generate.Config) {
+			cfg.Grafana = &generate.GrafanaConfig{
+				URL:                 os.Getenv("GRAFANA_URL"),
+				Auth:                os.Getenv("GRAFANA_AUTH"),
+				FleetManagementURL:  os.Getenv("GRAFANA_FLEET_MANAGEMENT_URL"),
+				FleetManagementAuth: os.Getenv("GRAFANA_FLEET_MANAGEMENT_AUTH"),
+			}
+			cfg.IncludeResources = []string{"grafana_fleet_management_collector." + collectorID}
+		},
+		check: func(t *testing.T, tempDir string) {
+			templateAttrs := map[string]string{
+				"ID":                 collectorID,
+				"FleetManagementURL": os.Getenv("GRAFANA_FLEET_MANAGEMENT_URL"),
+			}
+			assertFilesWithTemplating(t, tempDir, "testdata/generate/fleet-management-collector", []string{
+				".terraform",
+				".terraform.lock.hcl",
+			}, templateAttrs)
+		},
+	}
+
+	tc.Run(t)
+}
+
 func TestAccGenerate_OnCall(t *testing.T) {
 	testutils.CheckCloudInstanceTestsEnabled(t)
 
@@ -627,8 +825,19 @@ func assertFilesSubdir(t *testing.T, gotFilesDir, expectedFilesDir, subdir strin
 		gotContent, err := os.ReadFile(filepath.Join(gotFilesDir, expectedFile.Name()))
 		require.NoError(t, err)
 
-		assert.Equal(t, strings.TrimSpace(string(expectedContent)), strings.TrimSpace(string(gotContent)))
+		// The goldens are generated with Terraform, OpenTofu only differs in the comments of the generated config
+		gotContentNormalized := strings.ReplaceAll(string(gotContent), "# __generated__ by OpenTofu", "# __generated__ by Terraform")
+
+		assert.Equal(t, strings.TrimSpace(string(expectedContent)), strings.TrimSpace(gotContentNormalized))
+	}
+}
+
+// testEngine returns the engine which runs the generation in tests. Set TFGEN_TEST_ENGINE=tofu to test with OpenTofu.
+func testEngine() generate.Engine {
+	if engine := os.Getenv("TFGEN_TEST_ENGINE"); engine != "" {
+		return generate.Engine(engine)
 	}
+	return generate.EngineTerraform
 }
 
 func pluginDir(t *testing.T) string {
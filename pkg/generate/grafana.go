@@ -2,9 +2,14 @@ package generate
 
 import (
 	"context"
-	"path/filepath"
 	"strings"
 
+	appplatformgeneric "github.com/grafana/terraform-provider-grafana/v4/internal/resources/appplatform/generic"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/cloudintegrations"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/cloudprovider"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/connections"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/fleetmanagement"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/frontendo11y"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/grafana"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/machinelearning"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/oncall"
@@ -19,31 +24,7 @@ import (
 
 func generateGrafanaResources(ctx context.Context, cfg *Config, stack stack, genProvider bool) GenerationResult {
 	generatedFilename := func(suffix string) string {
-		if stack.name == "" {
-			return filepath.Join(cfg.OutputDir, suffix)
-		}
-
-		return filepath.Join(cfg.OutputDir, stack.name+"-"+suffix)
-	}
-
-	if genProvider {
-		providerBlock := hclwrite.NewBlock("provider", []string{"grafana"})
-		providerBlock.Body().SetAttributeValue("url", cty.StringVal(stack.url))
-		providerBlock.Body().SetAttributeValue("auth", cty.StringVal(stack.managementKey))
-		if stack.smToken != "" && stack.smURL != "" {
-			providerBlock.Body().SetAttributeValue("sm_url", cty.StringVal(stack.smURL))
-			providerBlock.Body().SetAttributeValue("sm_access_token", cty.StringVal(stack.smToken))
-		}
-		if stack.onCallToken != "" && stack.onCallURL != "" {
-			providerBlock.Body().SetAttributeValue("oncall_url", cty.StringVal(stack.onCallURL))
-			providerBlock.Body().SetAttributeValue("oncall_access_token", cty.StringVal(stack.onCallToken))
-		}
-		if stack.name != "" {
-			providerBlock.Body().SetAttributeValue("alias", cty.StringVal(stack.name))
-		}
-		if err := writeBlocks(generatedFilename("provider.tf"), providerBlock); err != nil {
-			return failure(err)
-		}
+		return generatedFilePath(cfg, stack.name, suffix)
 	}
 
 	singleOrg := !strings.Contains(stack.managementKey, ":")
@@ -55,15 +36,40 @@ func generateGrafanaResources(ctx context.Context, cfg *Config, stack stack, gen
 		Auth: types.StringValue(stack.managementKey),
 	}
 	resources := grafana.Resources
+	var skipped []GenerationSkip
 	if stack.smToken != "" && stack.smURL != "" {
 		resources = append(resources, syntheticmonitoring.Resources...)
 		config.SMURL = types.StringValue(stack.smURL)
 		config.SMAccessToken = types.StringValue(stack.smToken)
+	} else {
+		skipped = append(skipped, skip("no Synthetic Monitoring URL and access token were given", syntheticmonitoring.Resources...)...)
 	}
 	if stack.onCallToken != "" && stack.onCallURL != "" {
 		resources = append(resources, oncall.Resources...)
 		config.OncallAccessToken = types.StringValue(stack.onCallToken)
 		config.OncallURL = types.StringValue(stack.onCallURL)
+	} else {
+		skipped = append(skipped, skip("no OnCall URL and access token were given", oncall.Resources...)...)
+	}
+	if stack.fleetManagementAuth != "" && stack.fleetManagementURL != "" {
+		resources = append(resources, fleetmanagement.Resources...)
+		config.FleetManagementAuth = types.StringValue(stack.fleetManagementAuth)
+		config.FleetManagementURL = types.StringValue(stack.fleetManagementURL)
+	} else {
+		skipped = append(skipped, skip("no Fleet Management URL and auth were given", fleetmanagement.Resources...)...)
+	}
+	// The Cloud Provider and Connections APIs are scoped by stack, their resources are added below for Grafana Cloud stacks only
+	hasCloudProvider := stack.cloudProviderToken != "" && stack.cloudProviderURL != ""
+	if hasCloudProvider {
+		config.CloudProviderAccessToken = types.StringValue(stack.cloudProviderToken)
+		config.CloudProviderURL = types.StringValue(stack.cloudProviderURL)
+	}
+	hasConnections := stack.connectionsToken != ""
+	if hasConnections {
+		config.ConnectionsAPIAccessToken = types.StringValue(stack.connectionsToken)
+		if stack.connectionsURL != "" {
+			config.ConnectionsAPIURL = types.StringValue(stack.connectionsURL)
+		}
 	}
 	if err := config.SetDefaults(); err != nil {
 		return failure(err)
@@ -74,12 +80,88 @@ func generateGrafanaResources(ctx context.Context, cfg *Config, stack stack, gen
 		return failure(err)
 	}
 
+	// App Platform, Cloud Provider and Connections resources are scoped by stack in Grafana Cloud, the provider needs to know the stack ID to manage them
+	if stack.isCloud && (cfg.AppPlatform || hasCloudProvider || hasConnections) {
+		if client.GrafanaStackID, err = appplatformgeneric.DiscoverStackID(ctx, client); err != nil {
+			return failuref("failed to discover the stack ID of %s: %w", stack.url, err)
+		}
+	}
+
+	if genProvider {
+		providerBlock := hclwrite.NewBlock("provider", []string{"grafana"})
+		providerBlock.Body().SetAttributeValue("url", cty.StringVal(stack.url))
+		providerBlock.Body().SetAttributeValue("auth", cty.StringVal(stack.managementKey))
+		if stack.smToken != "" && stack.smURL != "" {
+			providerBlock.Body().SetAttributeValue("sm_url", cty.StringVal(stack.smURL))
+			providerBlock.Body().SetAttributeValue("sm_access_token", cty.StringVal(stack.smToken))
+		}
+		if stack.onCallToken != "" && stack.onCallURL != "" {
+			providerBlock.Body().SetAttributeValue("oncall_url", cty.StringVal(stack.onCallURL))
+			providerBlock.Body().SetAttributeValue("oncall_access_token", cty.StringVal(stack.onCallToken))
+		}
+		if stack.fleetManagementAuth != "" && stack.fleetManagementURL != "" {
+			providerBlock.Body().SetAttributeValue("fleet_management_url", cty.StringVal(stack.fleetManagementURL))
+			providerBlock.Body().SetAttributeValue("fleet_management_auth", cty.StringVal(stack.fleetManagementAuth))
+		}
+		if hasCloudProvider && stack.isCloud {
+			providerBlock.Body().SetAttributeValue("cloud_provider_url", cty.StringVal(stack.cloudProviderURL))
+			providerBlock.Body().SetAttributeValue("cloud_provider_access_token", cty.StringVal(stack.cloudProviderToken))
+		}
+		if hasConnections && stack.isCloud {
+			if stack.connectionsURL != "" {
+				providerBlock.Body().SetAttributeValue("connections_api_url", cty.StringVal(stack.connectionsURL))
+			}
+			providerBlock.Body().SetAttributeValue("connections_api_access_token", cty.StringVal(stack.connectionsToken))
+		}
+		if client.GrafanaStackID > 0 {
+			providerBlock.Body().SetAttributeValue("stack_id", cty.NumberIntVal(client.GrafanaStackID))
+		}
+		if stack.name != "" {
+			providerBlock.Body().SetAttributeValue("alias", cty.StringVal(stack.name))
+		}
+		if err := writeBlocks(generatedFilename("provider.tf"), providerBlock); err != nil {
+			return failure(err)
+		}
+	}
+
 	if stack.isCloud {
 		resources = append(resources, slo.Resources...)
 		resources = append(resources, machinelearning.Resources...)
+		resources = append(resources, cloudintegrations.Resources...)
+		if hasCloudProvider {
+			resources = append(resources, cloudprovider.Resources...)
+		} else {
+			skipped = append(skipped, skip("no Cloud Provider URL and access token were given", cloudprovider.Resources...)...)
+		}
+		if hasConnections {
+			resources = append(resources, connections.Resources...)
+		} else {
+			skipped = append(skipped, skip("no Connections API access token was given", connections.Resources...)...)
+		}
+	} else {
+		skipped = append(skipped, skip("the Grafana instance is not a Grafana Cloud stack", slo.Resources...)...)
+		skipped = append(skipped, skip("the Grafana instance is not a Grafana Cloud stack", machinelearning.Resources...)...)
+		skipped = append(skipped, skip("the Grafana instance is not a Grafana Cloud stack", cloudintegrations.Resources...)...)
+		skipped = append(skipped, skip("the Grafana instance is not a Grafana Cloud stack", cloudprovider.Resources...)...)
+		skipped = append(skipped, skip("the Grafana instance is not a Grafana Cloud stack", connections.Resources...)...)
+	}
+	// Frontend Observability apps are managed with a cloud access policy token, they are generated with the Grafana Cloud resources
+	if cfg.Cloud == nil {
+		skipped = append(skipped, skip("Frontend Observability apps are only generated with the Grafana Cloud resources", frontendo11y.Resources...)...)
+	}
+	if cfg.AppPlatform {
+		resources = append(resources, appPlatformResources()...)
+	} else {
+		skipped = append(skipped, skip("App Platform resources were not included", appPlatformResources()...)...)
+	}
+
+	skipped, err = filterSkipped(skipped, cfg.IncludeResources)
+	if err != nil {
+		return failure(err)
 	}
 
 	returnResult := generateImportBlocks(ctx, client, listerData, resources, cfg, stack.name)
+	returnResult.Skipped = append(returnResult.Skipped, skipped...)
 	if returnResult.Blocks() == 0 { // Skip if no resources were found
 		return returnResult
 	}
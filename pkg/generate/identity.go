@@ -0,0 +1,129 @@
+package generate
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/pkg/provider"
+)
+
+// Import blocks with an identity were introduced in Terraform 1.12
+var minIdentityImportsVersion = version.Must(version.NewVersion("1.12.0"))
+
+var resourcesByName = sync.OnceValue(provider.ResourcesMap)
+
+// supportsIdentityImports returns whether import blocks can be written with the identity of the resources.
+// OpenTofu imports keep using IDs.
+func supportsIdentityImports(tf *tfexec.Terraform, installConfig TerraformInstallConfig) (bool, error) {
+	if installConfig.Engine == EngineOpenTofu {
+		return false, nil
+	}
+	tfVersion, _, err := tf.Version(context.Background(), true)
+	if err != nil {
+		return false, fmt.Errorf("failed to read the version of %s: %w", tf.ExecPath(), err)
+	}
+	return !tfVersion.LessThan(minIdentityImportsVersion), nil
+}
+
+// importIdentity returns the identity to write in the import block of the resource with the given ID, instead of the ID.
+// It returns false if identity imports aren't supported, or if the ID is not in the format of the resource ID.
+func importIdentity(cfg *Config, resource *common.Resource, id string) (cty.Value, bool) {
+	if !cfg.identityImports || !resource.IDType.HasIdentity() {
+		return cty.NilVal, false
+	}
+	values, err := resource.IDType.IdentityValues(id)
+	if err != nil {
+		return cty.NilVal, false
+	}
+
+	attributes := map[string]cty.Value{}
+	for name, value := range values {
+		switch value := value.(type) {
+		case int64:
+			attributes[name] = cty.NumberIntVal(value)
+		case string:
+			attributes[name] = cty.StringVal(value)
+		}
+	}
+	return cty.ObjectVal(attributes), true
+}
+
+// importBlockID returns the ID imported by the import block of the given resource type, from its `id` or `identity` attribute.
+func importBlockID(resourceType string, block *hclwrite.Block) (string, bool) {
+	if id := block.Body().GetAttribute("id"); id != nil {
+		value, ok := attributeValue(id)
+		if !ok || value.Type() != cty.String {
+			return "", false
+		}
+		return value.AsString(), true
+	}
+
+	identity := block.Body().GetAttribute("identity")
+	if identity == nil {
+		return "", false
+	}
+	value, ok := attributeValue(identity)
+	if !ok || !value.Type().IsObjectType() {
+		return "", false
+	}
+	values := map[string]any{}
+	for name, v := range value.AsValueMap() {
+		switch {
+		case v.IsNull():
+			continue
+		case v.Type() == cty.String:
+			values[name] = v.AsString()
+		case v.Type() == cty.Number:
+			i, accuracy := v.AsBigFloat().Int64()
+			if accuracy != big.Exact {
+				return "", false
+			}
+			values[name] = i
+		}
+	}
+	return identityImportID(resourceType, values)
+}
+
+// identityImportID joins the values of an identity into the ID of the resource. JSON numbers (float64) are accepted for the int fields.
+func identityImportID(resourceType string, identity map[string]any) (string, bool) {
+	resource, ok := resourcesByName()[resourceType]
+	if !ok || !resource.IDType.HasIdentity() {
+		return "", false
+	}
+	values := map[string]any{}
+	for name, value := range identity {
+		if f, ok := value.(float64); ok {
+			if f != math.Trunc(f) {
+				return "", false
+			}
+			value = int64(f)
+		}
+		values[name] = value
+	}
+	id, err := resource.IDType.FromIdentityValues(values)
+	return id, err == nil
+}
+
+// attributeValue evaluates the expression of an attribute, which must not have references.
+func attributeValue(attribute *hclwrite.Attribute) (cty.Value, bool) {
+	expr, diags := hclsyntax.ParseExpression(attribute.Expr().BuildTokens(nil).Bytes(), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return cty.NilVal, false
+	}
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() || !value.IsWhollyKnown() || value.IsNull() {
+		return cty.NilVal, false
+	}
+	return value, true
+}
@@ -0,0 +1,81 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportsIdentityImports(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name          string
+		binaryVersion string
+		installConfig TerraformInstallConfig
+		expected      bool
+	}{
+		{
+			name:          "terraform 1.11",
+			binaryVersion: "1.11.4",
+			installConfig: TerraformInstallConfig{Engine: EngineTerraform},
+		},
+		{
+			name:          "terraform 1.12",
+			binaryVersion: "1.12.0",
+			installConfig: TerraformInstallConfig{Engine: EngineTerraform},
+			expected:      true,
+		},
+		{
+			name:          "opentofu",
+			binaryVersion: "1.12.0",
+			installConfig: TerraformInstallConfig{Engine: EngineOpenTofu},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			tf, err := tfexec.NewTerraform(dir, fakeOpenTofu(t, dir, tc.binaryVersion))
+			require.NoError(t, err)
+
+			got, err := supportsIdentityImports(tf, tc.installConfig)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestImportIdentity(t *testing.T) {
+	t.Parallel()
+
+	folder := resourcesByName()["grafana_folder"]
+	_, ok := importIdentity(&Config{}, folder, "1:abc")
+	assert.False(t, ok, "identity imports are not supported")
+
+	cfg := &Config{identityImports: true}
+	_, ok = importIdentity(cfg, folder, "1:abc:def")
+	assert.False(t, ok, "the ID is not in the format of the resource ID")
+
+	identity, ok := importIdentity(cfg, folder, "1:abc")
+	require.True(t, ok)
+	written := hclwrite.NewEmptyFile()
+	written.Body().AppendNewBlock("import", nil).Body().SetAttributeValue("identity", identity)
+	content := hclwrite.Format(written.Bytes())
+	assert.Equal(t, "import {\n  identity = {\n    org_id = 1\n    uid    = \"abc\"\n  }\n}\n", string(content))
+
+	// The import block is read back to the ID
+	file, diags := hclwrite.ParseConfig(content, "", hcl.InitialPos)
+	require.False(t, diags.HasErrors())
+	id, ok := importBlockID("grafana_folder", file.Body().Blocks()[0])
+	require.True(t, ok)
+	assert.Equal(t, "1:abc", id)
+
+	id, ok = identityImportID("grafana_folder", map[string]any{"uid": "abc"})
+	require.True(t, ok)
+	assert.Equal(t, "abc", id)
+}
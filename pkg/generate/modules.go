@@ -0,0 +1,406 @@
+package generate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/pkg/generate/utils"
+	"github.com/grafana/terraform-provider-grafana/v4/pkg/provider"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	modulesDir = "modules"
+	// generalModule holds the resources which are not in a folder, with the folder layout
+	generalModule = "general"
+	// otherModule holds the resources whose category is unknown, with the category layout
+	otherModule = "other"
+)
+
+var nonModuleNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// generatedModule is a module of the module-structured layout, with the resources moved from the root module.
+type generatedModule struct {
+	name  string
+	alias string // provider alias of the module's resources, if any
+
+	resources []*hclwrite.Block
+	// variables maps the variables of the module to the module which outputs them
+	variables map[string]string
+	// outputs maps the outputs of the module to the references they expose
+	outputs map[string][]string
+}
+
+func validateLayout(cfg *Config) error {
+	if cfg.Layout == "" || cfg.Layout == OutputLayoutFlat {
+		return nil
+	}
+	if !slices.Contains(OutputLayouts, cfg.Layout) {
+		return fmt.Errorf("unsupported output layout %q, supported layouts are: %v", cfg.Layout, OutputLayouts)
+	}
+	if cfg.Format != "" && cfg.Format != OutputFormatHCL && cfg.Format != OutputFormatJSON {
+		return fmt.Errorf("the %q output layout is not supported with the %q output format", cfg.Layout, cfg.Format)
+	}
+	if cfg.DriftSync {
+		return errors.New("drift sync only supports the flat output layout, new resources are written to the root module")
+	}
+	return nil
+}
+
+// splitIntoModules moves the generated resources of the root module to one module per category or per folder.
+// Import blocks stay in the root module and target the resources through their module.
+// References between resources of different modules are replaced by a module output, passed as a variable to the module which uses it.
+func splitIntoModules(cfg *Config) ([]string, error) {
+	resourceFiles, err := filepath.Glob(filepath.Join(cfg.OutputDir, "*resources.tf"))
+	if err != nil {
+		return nil, err
+	}
+	importFiles, err := filepath.Glob(filepath.Join(cfg.OutputDir, "*imports.tf"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the module of each resource
+	var blocks []*hclwrite.Block
+	for _, file := range resourceFiles {
+		hclFile, err := utils.ReadHCLFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range hclFile.Body().Blocks() {
+			if block.Type() == "resource" && len(block.Labels()) == 2 {
+				blocks = append(blocks, block)
+			}
+		}
+	}
+
+	categories := resourceCategories()
+	modules := map[string]*generatedModule{}
+	owners := map[string]*generatedModule{}
+	for _, block := range blocks {
+		alias := ""
+		if providerAttr := block.Body().GetAttribute("provider"); providerAttr != nil {
+			alias = strings.TrimPrefix(strings.TrimSpace(string(providerAttr.Expr().BuildTokens(nil).Bytes())), "grafana.")
+			block.Body().RemoveAttribute("provider") // The provider is passed to the module instead
+		}
+
+		var group string
+		switch cfg.Layout {
+		case OutputLayoutCategory:
+			group = otherModule
+			if category, ok := categories[block.Labels()[0]]; ok {
+				group = string(category)
+			}
+		case OutputLayoutFolder:
+			group = resourceFolder(block)
+		}
+		name := moduleName(alias, group)
+
+		module, ok := modules[name]
+		if !ok {
+			module = &generatedModule{
+				name:      name,
+				alias:     alias,
+				variables: map[string]string{},
+				outputs:   map[string][]string{},
+			}
+			modules[name] = module
+		}
+		module.resources = append(module.resources, block)
+		owners[blockAddress(block)] = module
+	}
+
+	// Wire references to the resources of other modules with outputs and variables
+	for _, module := range modules {
+		for _, block := range module.resources {
+			replaceModuleReferences(block.Body(), func(ref []string) (string, bool) {
+				owner, ok := owners[ref[0]+"."+ref[1]]
+				if !ok || owner == module {
+					return "", false
+				}
+				name := strings.Join(ref, "_")
+				owner.outputs[name] = ref
+				module.variables[name] = owner.name
+				return name, true
+			})
+		}
+	}
+
+	// Write the modules
+	names := slices.Sorted(maps.Keys(modules))
+	resources := provider.ResourcesMap()
+	var moduleBlocks []*hclwrite.Block
+	for _, name := range names {
+		module := modules[name]
+		if err := writeModule(cfg.OutputDir, module, resources); err != nil {
+			return nil, fmt.Errorf("failed to write module %s: %w", name, err)
+		}
+		moduleBlocks = append(moduleBlocks, module.callBlock())
+	}
+
+	// Replace the resources of the root module by the module calls, and make the imports target the modules' resources
+	for _, file := range resourceFiles {
+		if err := os.Remove(file); err != nil {
+			return nil, err
+		}
+	}
+	if err := removeDirIfEmpty(filepath.Join(cfg.OutputDir, "dashboards")); err != nil {
+		return nil, err
+	}
+	if err := writeBlocksFile(filepath.Join(cfg.OutputDir, "modules.tf"), true, moduleBlocks...); err != nil {
+		return nil, err
+	}
+	for _, file := range importFiles {
+		if err := retargetImports(file, owners); err != nil {
+			return nil, err
+		}
+	}
+
+	moduleDirs := make([]string, 0, len(names))
+	for _, name := range names {
+		moduleDirs = append(moduleDirs, filepath.Join(cfg.OutputDir, modulesDir, name))
+	}
+	return moduleDirs, nil
+}
+
+// resourceCategories maps the resource types of the provider to their category.
+func resourceCategories() map[string]common.ResourceCategory {
+	categories := map[string]common.ResourceCategory{}
+	for _, r := range provider.Resources() {
+		categories[r.Name] = r.Category
+	}
+	for _, r := range provider.AppPlatformResources() {
+		categories[r.Name] = r.Category
+	}
+	return categories
+}
+
+// resourceFolder returns the name of the folder resource that the given resource is in.
+// Folders are their own group, nested folders included.
+func resourceFolder(block *hclwrite.Block) string {
+	if block.Labels()[0] == "grafana_folder" {
+		return block.Labels()[1]
+	}
+
+	folder := generalModule
+	replaceModuleReferences(block.Body(), func(ref []string) (string, bool) {
+		if ref[0] == "grafana_folder" && folder == generalModule {
+			folder = ref[1]
+		}
+		return "", false
+	})
+	return folder
+}
+
+func moduleName(alias, group string) string {
+	name := nonModuleNameChars.ReplaceAllString(strings.ToLower(strings.ReplaceAll(group, " ", "-")), "")
+	if alias == "" || alias == name {
+		return name
+	}
+	return alias + "-" + name
+}
+
+func blockAddress(block *hclwrite.Block) string {
+	return block.Labels()[0] + "." + block.Labels()[1]
+}
+
+// replaceModuleReferences finds the `<type>.<name>.<attribute>` references in the given body and its nested blocks.
+// If replace returns true, the reference is replaced by `var.<replacement>`.
+func replaceModuleReferences(body *hclwrite.Body, replace func(ref []string) (string, bool)) {
+	attributes := body.Attributes()
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		tokens := attributes[name].Expr().BuildTokens(nil)
+		var newTokens hclwrite.Tokens
+		changed := false
+		for i := 0; i < len(tokens); i++ {
+			if ref := referenceAt(tokens, i); ref != nil {
+				if variable, ok := replace(ref); ok {
+					newTokens = append(newTokens,
+						&hclwrite.Token{Type: hclsyntax.TokenIdent, Bytes: []byte("var"), SpacesBefore: tokens[i].SpacesBefore},
+						&hclwrite.Token{Type: hclsyntax.TokenDot, Bytes: []byte(".")},
+						&hclwrite.Token{Type: hclsyntax.TokenIdent, Bytes: []byte(variable)},
+					)
+					i += 4
+					changed = true
+					continue
+				}
+			}
+			newTokens = append(newTokens, tokens[i])
+		}
+		if changed {
+			body.SetAttributeRaw(name, newTokens)
+		}
+	}
+	for _, block := range body.Blocks() {
+		replaceModuleReferences(block.Body(), replace)
+	}
+}
+
+// referenceAt returns the type, name and attribute of the resource reference starting at the given token, if any.
+func referenceAt(tokens hclwrite.Tokens, i int) []string {
+	if i+4 >= len(tokens) || (i > 0 && tokens[i-1].Type == hclsyntax.TokenDot) {
+		return nil
+	}
+	for j, tokenType := range []hclsyntax.TokenType{hclsyntax.TokenIdent, hclsyntax.TokenDot, hclsyntax.TokenIdent, hclsyntax.TokenDot, hclsyntax.TokenIdent} {
+		if tokens[i+j].Type != tokenType {
+			return nil
+		}
+	}
+	return []string{string(tokens[i].Bytes), string(tokens[i+2].Bytes), string(tokens[i+4].Bytes)}
+}
+
+func writeModule(outputDir string, module *generatedModule, resources map[string]*common.Resource) error {
+	dir := filepath.Join(outputDir, modulesDir, module.name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	// Modules must declare the provider's source, otherwise Terraform looks for hashicorp/grafana
+	versionsBlock := hclwrite.NewBlock("terraform", nil)
+	requiredProvidersBlock := hclwrite.NewBlock("required_providers", nil)
+	requiredProvidersBlock.Body().SetAttributeValue("grafana", cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal("grafana/grafana"),
+	}))
+	versionsBlock.Body().AppendBlock(requiredProvidersBlock)
+	if err := writeBlocksFile(filepath.Join(dir, "versions.tf"), true, versionsBlock); err != nil {
+		return err
+	}
+
+	for _, block := range module.resources {
+		if err := moveDashboardFiles(outputDir, dir, block); err != nil {
+			return err
+		}
+	}
+	if err := writeBlocksFile(filepath.Join(dir, "resources.tf"), true, module.resources...); err != nil {
+		return err
+	}
+
+	if len(module.variables) > 0 {
+		var variableBlocks []*hclwrite.Block
+		for _, name := range slices.Sorted(maps.Keys(module.variables)) {
+			variableBlocks = append(variableBlocks, hclwrite.NewBlock("variable", []string{name}))
+		}
+		if err := writeBlocksFile(filepath.Join(dir, "variables.tf"), true, variableBlocks...); err != nil {
+			return err
+		}
+	}
+
+	if len(module.outputs) > 0 {
+		var outputBlocks []*hclwrite.Block
+		for _, name := range slices.Sorted(maps.Keys(module.outputs)) {
+			ref := module.outputs[name]
+			b := hclwrite.NewBlock("output", []string{name})
+			b.Body().SetAttributeTraversal("value", traversal(ref[0], ref[1:]...))
+			if isSensitiveAttribute(resources[ref[0]], ref[2]) {
+				b.Body().SetAttributeValue("sensitive", cty.True)
+			}
+			outputBlocks = append(outputBlocks, b)
+		}
+		if err := writeBlocksFile(filepath.Join(dir, "outputs.tf"), true, outputBlocks...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// moveDashboardFiles moves the dashboard JSON files extracted from the resource to the module's directory.
+// They are read with `file("${path.module}/dashboards/...")`, which is relative to the module.
+func moveDashboardFiles(outputDir, moduleDir string, block *hclwrite.Block) error {
+	for _, attr := range block.Body().Attributes() {
+		for _, token := range attr.Expr().BuildTokens(nil) {
+			path := string(token.Bytes)
+			if token.Type != hclsyntax.TokenQuotedLit || !strings.HasPrefix(path, "/dashboards/") {
+				continue
+			}
+			if _, err := os.Stat(filepath.Join(outputDir, path)); err != nil {
+				continue
+			}
+			if err := os.MkdirAll(filepath.Join(moduleDir, "dashboards"), 0755); err != nil {
+				return err
+			}
+			if err := os.Rename(filepath.Join(outputDir, path), filepath.Join(moduleDir, path)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *generatedModule) callBlock() *hclwrite.Block {
+	b := hclwrite.NewBlock("module", []string{m.name})
+	b.Body().SetAttributeValue("source", cty.StringVal("./"+modulesDir+"/"+m.name))
+	if m.alias != "" {
+		b.Body().SetAttributeRaw("providers", hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{{
+			Name:  hclwrite.TokensForIdentifier("grafana"),
+			Value: hclwrite.TokensForTraversal(traversal("grafana", m.alias)),
+		}}))
+	}
+	for _, name := range slices.Sorted(maps.Keys(m.variables)) {
+		b.Body().SetAttributeTraversal(name, traversal("module", m.variables[name], name))
+	}
+	return b
+}
+
+// retargetImports makes the import blocks of the given file target the resources moved to modules.
+func retargetImports(file string, owners map[string]*generatedModule) error {
+	hclFile, err := utils.ReadHCLFile(file)
+	if err != nil {
+		return err
+	}
+	for _, block := range hclFile.Body().Blocks() {
+		to := block.Body().GetAttribute("to")
+		if block.Type() != "import" || to == nil {
+			continue
+		}
+		address := strings.TrimSpace(string(to.Expr().BuildTokens(nil).Bytes()))
+		owner, ok := owners[address]
+		if !ok {
+			continue
+		}
+		resourceType, resourceName, _ := strings.Cut(address, ".")
+		block.Body().SetAttributeTraversal("to", traversal("module", owner.name, resourceType, resourceName))
+		block.Body().RemoveAttribute("provider") // The resource's provider is passed to its module
+	}
+	return os.WriteFile(file, hclFile.Bytes(), 0600)
+}
+
+// isSensitiveAttribute returns whether the given attribute of the resource is sensitive.
+// Outputs of sensitive values must be marked as such.
+func isSensitiveAttribute(r *common.Resource, attr string) bool {
+	switch {
+	case r == nil:
+		return false
+	case r.Schema != nil:
+		s, ok := r.Schema.Schema[attr]
+		return ok && s.Sensitive
+	case r.PluginFrameworkSchema != nil:
+		var resp resource.SchemaResponse
+		r.PluginFrameworkSchema.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+		s, ok := resp.Schema.Attributes[attr]
+		return ok && s.IsSensitive()
+	}
+	return false
+}
+
+func removeDirIfEmpty(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil || len(entries) > 0 {
+		return err
+	}
+	return os.Remove(dir)
+}
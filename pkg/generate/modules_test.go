@@ -0,0 +1,186 @@
+package generate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testModulesResources = `resource "grafana_folder" "my_folder" {
+  title = "My Folder"
+}
+
+resource "grafana_dashboard" "my_dashboard" {
+  config_json = file("${path.module}/dashboards/my_dashboard.json")
+  folder      = grafana_folder.my_folder.uid
+}
+
+resource "grafana_rule_group" "my_rule_group" {
+  folder_uid       = grafana_folder.my_folder.uid
+  interval_seconds = 60
+  name             = "My Rule Group"
+}
+
+resource "grafana_contact_point" "my_contact_point" {
+  name = "My Contact Point"
+  email {
+    addresses = ["test@example.com"]
+  }
+}
+`
+
+const testModulesImports = `import {
+  to = grafana_folder.my_folder
+  id = "my_folder"
+}
+
+import {
+  to = grafana_dashboard.my_dashboard
+  id = "my_dashboard"
+}
+
+import {
+  to = grafana_rule_group.my_rule_group
+  id = "my_folder:My Rule Group"
+}
+
+import {
+  to = grafana_contact_point.my_contact_point
+  id = "My Contact Point"
+}
+`
+
+func setupModulesTest(t *testing.T, layout OutputLayout) *Config {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "resources.tf"), []byte(testModulesResources), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "imports.tf"), []byte(testModulesImports), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "dashboards"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dashboards", "my_dashboard.json"), []byte(`{"title": "My Dashboard"}`), 0600))
+
+	return &Config{OutputDir: dir, Layout: layout}
+}
+
+func readTestFile(t *testing.T, path ...string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join(path...))
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestSplitIntoModulesByCategory(t *testing.T) {
+	t.Parallel()
+
+	cfg := setupModulesTest(t, OutputLayoutCategory)
+	moduleDirs, err := splitIntoModules(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(cfg.OutputDir, "modules", "alerting"),
+		filepath.Join(cfg.OutputDir, "modules", "grafana-oss"),
+	}, moduleDirs)
+
+	assert.NoFileExists(t, filepath.Join(cfg.OutputDir, "resources.tf"))
+	assert.NoDirExists(t, filepath.Join(cfg.OutputDir, "dashboards"))
+	assert.FileExists(t, filepath.Join(cfg.OutputDir, "modules", "grafana-oss", "dashboards", "my_dashboard.json"))
+
+	assert.Equal(t, `module "alerting" {
+  source                       = "./modules/alerting"
+  grafana_folder_my_folder_uid = module.grafana-oss.grafana_folder_my_folder_uid
+}
+
+module "grafana-oss" {
+  source = "./modules/grafana-oss"
+}
+`, readTestFile(t, cfg.OutputDir, "modules.tf"))
+
+	assert.Equal(t, `resource "grafana_rule_group" "my_rule_group" {
+  folder_uid       = var.grafana_folder_my_folder_uid
+  interval_seconds = 60
+  name             = "My Rule Group"
+}
+
+resource "grafana_contact_point" "my_contact_point" {
+  name = "My Contact Point"
+  email {
+    addresses = ["test@example.com"]
+  }
+}
+`, readTestFile(t, cfg.OutputDir, "modules", "alerting", "resources.tf"))
+	assert.Equal(t, `variable "grafana_folder_my_folder_uid" {
+}
+`, readTestFile(t, cfg.OutputDir, "modules", "alerting", "variables.tf"))
+	assert.Contains(t, readTestFile(t, cfg.OutputDir, "modules", "alerting", "versions.tf"), `source = "grafana/grafana"`)
+
+	assert.Contains(t, readTestFile(t, cfg.OutputDir, "modules", "grafana-oss", "resources.tf"), `folder      = grafana_folder.my_folder.uid`)
+	assert.Equal(t, `output "grafana_folder_my_folder_uid" {
+  value = grafana_folder.my_folder.uid
+}
+`, readTestFile(t, cfg.OutputDir, "modules", "grafana-oss", "outputs.tf"))
+
+	imports := readTestFile(t, cfg.OutputDir, "imports.tf")
+	assert.Contains(t, imports, "to = module.grafana-oss.grafana_folder.my_folder")
+	assert.Contains(t, imports, "to = module.grafana-oss.grafana_dashboard.my_dashboard")
+	assert.Contains(t, imports, "to = module.alerting.grafana_rule_group.my_rule_group")
+	assert.Contains(t, imports, "to = module.alerting.grafana_contact_point.my_contact_point")
+}
+
+func TestSplitIntoModulesByFolder(t *testing.T) {
+	t.Parallel()
+
+	cfg := setupModulesTest(t, OutputLayoutFolder)
+	moduleDirs, err := splitIntoModules(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(cfg.OutputDir, "modules", "general"),
+		filepath.Join(cfg.OutputDir, "modules", "my_folder"),
+	}, moduleDirs)
+
+	// Resources in the folder reference it within the module
+	folderResources := readTestFile(t, cfg.OutputDir, "modules", "my_folder", "resources.tf")
+	assert.Contains(t, folderResources, `resource "grafana_folder" "my_folder"`)
+	assert.Contains(t, folderResources, `folder      = grafana_folder.my_folder.uid`)
+	assert.Contains(t, folderResources, `folder_uid       = grafana_folder.my_folder.uid`)
+	assert.NoFileExists(t, filepath.Join(cfg.OutputDir, "modules", "my_folder", "variables.tf"))
+	assert.FileExists(t, filepath.Join(cfg.OutputDir, "modules", "my_folder", "dashboards", "my_dashboard.json"))
+
+	assert.Contains(t, readTestFile(t, cfg.OutputDir, "modules", "general", "resources.tf"), `resource "grafana_contact_point" "my_contact_point"`)
+	assert.Contains(t, readTestFile(t, cfg.OutputDir, "imports.tf"), "to = module.general.grafana_contact_point.my_contact_point")
+}
+
+func TestSplitIntoModulesWithProviderAlias(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "stack-test-resources.tf"), []byte(`resource "grafana_folder" "stack-test_my_folder" {
+  provider = grafana.stack-test
+  title    = "My Folder"
+}
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "stack-test-imports.tf"), []byte(`import {
+  provider = grafana.stack-test
+  to       = grafana_folder.stack-test_my_folder
+  id       = "stack-test_my_folder"
+}
+`), 0600))
+
+	_, err := splitIntoModules(&Config{OutputDir: dir, Layout: OutputLayoutCategory})
+	require.NoError(t, err)
+
+	assert.Equal(t, `module "stack-test-grafana-oss" {
+  source = "./modules/stack-test-grafana-oss"
+  providers = {
+    grafana = grafana.stack-test
+  }
+}
+`, readTestFile(t, dir, "modules.tf"))
+	assert.NotContains(t, readTestFile(t, dir, "modules", "stack-test-grafana-oss", "resources.tf"), "provider")
+
+	imports := readTestFile(t, dir, "stack-test-imports.tf")
+	assert.Contains(t, imports, "to = module.stack-test-grafana-oss.grafana_folder.stack-test_my_folder")
+	assert.NotContains(t, imports, "provider")
+}
@@ -123,6 +123,10 @@ var knownReferences = []string{
 	"grafana_notification_policy.contact_point=grafana_contact_point.name",
 	"grafana_notification_policy.mute_timings=grafana_mute_timing.name",
 	"grafana_notification_policy.org_id=grafana_organization.id",
+	"grafana_notification_policy_route.active_timings=grafana_mute_timing.name",
+	"grafana_notification_policy_route.contact_point=grafana_contact_point.name",
+	"grafana_notification_policy_route.mute_timings=grafana_mute_timing.name",
+	"grafana_notification_policy_route.org_id=grafana_organization.id",
 	"grafana_oncall_escalation.escalation_chain_id=grafana_oncall_escalation_chain.id",
 	"grafana_oncall_escalation.notify_on_call_from_schedule=grafana_oncall_schedule.id",
 	"grafana_oncall_integration.escalation_chain_id=grafana_oncall_escalation_chain.id",
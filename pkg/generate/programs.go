@@ -0,0 +1,354 @@
+package generate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/pkg/provider"
+	tfjson "github.com/hashicorp/terraform-json"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// A `<type>.<name>.<attribute>` reference, as written by postprocessing.ReplaceReferences and converted to JSON
+var programReferenceRegexp = regexp.MustCompile(`^\$\{([a-zA-Z0-9_]+)\.([a-zA-Z0-9_-]+)\.([a-zA-Z0-9_]+)\}$`)
+
+// program is the generated configuration, to be written as a program of another IaC tool using the bridged provider.
+type program struct {
+	providers []*programProvider
+	// resources are ordered so that resources are declared after the ones they reference
+	resources []*programResource
+}
+
+type programProvider struct {
+	alias  string // empty for the default provider
+	config map[string]any
+}
+
+type programResource struct {
+	resourceType string
+	name         string
+	category     common.ResourceCategory
+	id           string // import ID
+	provider     string // provider alias, empty for the default provider
+	// properties have camelCase keys. References to other resources are *programReference values
+	properties map[string]any
+	references []*programResource
+}
+
+// logicalName is unique in the program, unlike the Terraform resource name.
+func (r *programResource) logicalName() string {
+	return r.resourceType + "_" + r.name
+}
+
+// programReference is a reference to an attribute of another resource of the program.
+type programReference struct {
+	resource  *programResource
+	attribute string // camelCase
+}
+
+// programSchema describes the nested blocks and attributes of a resource.
+// Like the bridged providers, blocks with at most one element are converted to an object rather than a list.
+type programSchema struct {
+	nested map[string]*programSchema
+	single bool // single nested block or attribute, or list block with at most one element
+	isMap  bool // map nested attribute, whose keys are not attribute names
+}
+
+// tfJSONConfig is the part of the Terraform JSON configuration used to write programs.
+type tfJSONConfig struct {
+	Provider map[string][]map[string]any            `json:"provider"`
+	Resource map[string]map[string][]map[string]any `json:"resource"`
+	Import   []map[string]any                       `json:"import"`
+}
+
+func convertToProgram(cfg *Config) error {
+	ctx := context.Background()
+
+	state, err := getPlannedState(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Convert to JSON, to read values and references without parsing HCL expressions
+	if err := convertToTFJSON(cfg.OutputDir); err != nil {
+		return err
+	}
+
+	configs, err := readTFJSONConfigs(cfg.OutputDir)
+	if err != nil {
+		return err
+	}
+
+	// Remove (Terraform) files from the output directory
+	dirFiles, err := os.ReadDir(cfg.OutputDir)
+	if err != nil {
+		return err
+	}
+	for _, dirFile := range dirFiles {
+		if err := os.RemoveAll(filepath.Join(cfg.OutputDir, dirFile.Name())); err != nil {
+			return err
+		}
+	}
+
+	p := newProgram(configs, state.PlannedValues.RootModule.Resources, provider.ResourcesMap())
+
+	switch cfg.Format {
+	case OutputFormatPulumiYAML:
+		return writePulumiYAML(cfg.OutputDir, p)
+	case OutputFormatCDKTFTypeScript:
+		return writeCDKTFTypeScript(cfg.OutputDir, strings.TrimPrefix(cfg.ProviderVersion, "v"), p)
+	}
+	return fmt.Errorf("unsupported program format %q", cfg.Format)
+}
+
+func readTFJSONConfigs(dir string) ([]tfJSONConfig, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.tf.json"))
+	if err != nil {
+		return nil, err
+	}
+	var configs []tfJSONConfig
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var config tfJSONConfig
+		if err := json.Unmarshal(content, &config); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		configs = append(configs, config)
+	}
+	return configs, nil
+}
+
+func newProgram(configs []tfJSONConfig, plannedResources []*tfjson.StateResource, resourcesMap map[string]*common.Resource) *program {
+	p := &program{}
+
+	// Import IDs, by resource address
+	importIDs := map[string]string{}
+	for _, config := range configs {
+		for _, importBlock := range config.Import {
+			to, _ := importBlock["to"].(string)
+			id, _ := importBlock["id"].(string)
+			if identity, ok := importBlock["identity"].(map[string]any); ok {
+				resourceType, _, _ := strings.Cut(to, ".")
+				id, _ = identityImportID(resourceType, identity)
+			}
+			importIDs[to] = id
+		}
+	}
+
+	resources := map[string]*programResource{}
+	resourceConfigs := map[*programResource]map[string]any{}
+	for _, config := range configs {
+		for _, providerConfig := range config.Provider["grafana"] {
+			alias, _ := providerConfig["alias"].(string)
+			p.providers = append(p.providers, &programProvider{
+				alias:  alias,
+				config: convertProgramObject(nil, providerConfig, nil, nil),
+			})
+		}
+
+		for resourceType, byName := range config.Resource {
+			for name, resourceConfig := range byName {
+				if len(resourceConfig) == 0 {
+					continue
+				}
+				r := &programResource{
+					resourceType: resourceType,
+					name:         name,
+					id:           importIDs[resourceType+"."+name],
+				}
+				if info, ok := resourcesMap[resourceType]; ok {
+					r.category = info.Category
+				}
+				// The provider is written as `grafana.<alias>` by convertToTFJSON
+				if providerRef, ok := resourceConfig[0]["provider"].(string); ok {
+					r.provider = strings.TrimPrefix(providerRef, "grafana.")
+				}
+				resources[resourceType+"."+name] = r
+				resourceConfigs[r] = resourceConfig[0]
+			}
+		}
+	}
+	slices.SortFunc(p.providers, func(a, b *programProvider) int { return strings.Compare(a.alias, b.alias) })
+
+	// Convert the properties, once all resources are known to resolve references
+	for r, config := range resourceConfigs {
+		var planned map[string]any
+		for _, plannedResource := range plannedResources {
+			if plannedResource.Type == r.resourceType && plannedResource.Name == r.name {
+				planned = plannedResource.AttributeValues
+			}
+		}
+
+		delete(config, "provider")
+		r.properties = convertProgramObject(newProgramSchema(resourcesMap[r.resourceType]), config, planned, func(ref []string) *programReference {
+			referenced, ok := resources[ref[0]+"."+ref[1]]
+			if !ok || referenced == r {
+				return nil
+			}
+			if !slices.Contains(r.references, referenced) {
+				r.references = append(r.references, referenced)
+			}
+			return &programReference{resource: referenced, attribute: toCamelCase(ref[2])}
+		})
+	}
+
+	// Declare resources after the ones they reference, and by name otherwise
+	sorted := make([]*programResource, 0, len(resources))
+	for _, r := range resources {
+		sorted = append(sorted, r)
+	}
+	slices.SortFunc(sorted, func(a, b *programResource) int { return strings.Compare(a.logicalName(), b.logicalName()) })
+	visited := map[*programResource]bool{}
+	var visit func(r *programResource)
+	visit = func(r *programResource) {
+		if visited[r] {
+			return
+		}
+		visited[r] = true
+		slices.SortFunc(r.references, func(a, b *programResource) int { return strings.Compare(a.logicalName(), b.logicalName()) })
+		for _, referenced := range r.references {
+			visit(referenced)
+		}
+		p.resources = append(p.resources, r)
+	}
+	for _, r := range sorted {
+		visit(r)
+	}
+
+	return p
+}
+
+// convertProgramObject converts a Terraform JSON object to program properties, with camelCase keys.
+// Expressions other than references to resources of the program (ex: jsonencode or file calls) are replaced by their planned value.
+func convertProgramObject(s *programSchema, config, planned map[string]any, resolve func(ref []string) *programReference) map[string]any {
+	result := map[string]any{}
+	for key, value := range config {
+		var nested *programSchema
+		if s != nil {
+			nested = s.nested[key]
+		}
+		if converted := convertProgramValue(nested, value, planned[key], resolve); converted != nil {
+			result[toCamelCase(key)] = converted
+		}
+	}
+	return result
+}
+
+func convertProgramValue(s *programSchema, config, planned any, resolve func(ref []string) *programReference) any {
+	switch value := config.(type) {
+	case string:
+		if !strings.Contains(value, "${") {
+			return value
+		}
+		if match := programReferenceRegexp.FindStringSubmatch(value); match != nil && resolve != nil {
+			if ref := resolve(match[1:]); ref != nil {
+				return ref
+			}
+		}
+		return planned
+	case []any:
+		plannedList, _ := planned.([]any)
+		result := make([]any, 0, len(value))
+		for i, element := range value {
+			var plannedElement any
+			if i < len(plannedList) {
+				plannedElement = plannedList[i]
+			}
+			if object, ok := element.(map[string]any); ok {
+				plannedObject, _ := plannedElement.(map[string]any)
+				result = append(result, convertProgramObject(s, object, plannedObject, resolve))
+				continue
+			}
+			result = append(result, convertProgramValue(nil, element, plannedElement, resolve))
+		}
+		if s != nil && s.single && len(result) == 1 {
+			return result[0]
+		}
+		return result
+	case map[string]any:
+		plannedObject, _ := planned.(map[string]any)
+		if s != nil && !s.isMap {
+			return convertProgramObject(s, value, plannedObject, resolve)
+		}
+		// Map attributes keep their keys
+		result := map[string]any{}
+		for key, element := range value {
+			var elementSchema *programSchema
+			if s != nil {
+				elementSchema = &programSchema{nested: s.nested}
+			}
+			result[key] = convertProgramValue(elementSchema, element, plannedObject[key], resolve)
+		}
+		return result
+	}
+	return config
+}
+
+// newProgramSchema reads the nested blocks and attributes of a resource, from its SDKv2 or Plugin Framework schema.
+func newProgramSchema(r *common.Resource) *programSchema {
+	switch {
+	case r == nil:
+		return nil
+	case r.Schema != nil:
+		return newProgramSchemaFromSDK(r.Schema.Schema)
+	case r.PluginFrameworkSchema != nil:
+		var resp fwresource.SchemaResponse
+		r.PluginFrameworkSchema.Schema(context.Background(), fwresource.SchemaRequest{}, &resp)
+		return newProgramSchemaFromFramework(resp.Schema.Attributes, resp.Schema.Blocks)
+	}
+	return nil
+}
+
+func newProgramSchemaFromSDK(attributes map[string]*sdkschema.Schema) *programSchema {
+	s := &programSchema{nested: map[string]*programSchema{}}
+	for name, attribute := range attributes {
+		if elem, ok := attribute.Elem.(*sdkschema.Resource); ok {
+			nested := newProgramSchemaFromSDK(elem.Schema)
+			nested.single = attribute.MaxItems == 1
+			s.nested[name] = nested
+		}
+	}
+	return s
+}
+
+func newProgramSchemaFromFramework(attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) *programSchema {
+	s := &programSchema{nested: map[string]*programSchema{}}
+	for name, attribute := range attributes {
+		switch attribute := attribute.(type) {
+		case fwschema.SingleNestedAttribute:
+			s.nested[name] = newProgramSchemaFromFramework(attribute.Attributes, nil)
+			s.nested[name].single = true
+		case fwschema.ListNestedAttribute:
+			s.nested[name] = newProgramSchemaFromFramework(attribute.NestedObject.Attributes, nil)
+		case fwschema.SetNestedAttribute:
+			s.nested[name] = newProgramSchemaFromFramework(attribute.NestedObject.Attributes, nil)
+		case fwschema.MapNestedAttribute:
+			s.nested[name] = newProgramSchemaFromFramework(attribute.NestedObject.Attributes, nil)
+			s.nested[name].isMap = true
+		}
+	}
+	for name, block := range blocks {
+		switch block := block.(type) {
+		case fwschema.SingleNestedBlock:
+			s.nested[name] = newProgramSchemaFromFramework(block.Attributes, block.Blocks)
+			s.nested[name].single = true
+		case fwschema.ListNestedBlock:
+			s.nested[name] = newProgramSchemaFromFramework(block.NestedObject.Attributes, block.NestedObject.Blocks)
+		case fwschema.SetNestedBlock:
+			s.nested[name] = newProgramSchemaFromFramework(block.NestedObject.Attributes, block.NestedObject.Blocks)
+		}
+	}
+	return s
+}
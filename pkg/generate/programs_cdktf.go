@@ -0,0 +1,175 @@
+package generate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	tsIdentifierRegexp = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*$`)
+	tsWordRegexp       = regexp.MustCompile(`[a-zA-Z0-9]+`)
+)
+
+// writeCDKTFTypeScript writes the program as a CDKTF TypeScript project, which imports the existing resources on the first `cdktf deploy`.
+// The provider bindings are generated in `.gen` by `cdktf get`.
+func writeCDKTFTypeScript(dir, providerVersion string, p *program) error {
+	var body strings.Builder
+	classes := map[string]string{} // class name -> import path
+	usesFn := false
+	tsString := func(s string) string {
+		if strings.Contains(s, "${") || strings.Contains(s, "%{") {
+			usesFn = true
+			return "Fn.rawString(" + tsQuote(s) + ")"
+		}
+		return tsQuote(s)
+	}
+
+	providerVars := map[string]string{}
+	for _, provider := range p.providers {
+		id := "grafana"
+		if provider.alias != "" {
+			id += "-" + provider.alias
+		}
+		body.WriteString("    ")
+		if provider.alias != "" {
+			providerVars[provider.alias] = tsVariableName(id)
+			fmt.Fprintf(&body, "const %s = ", providerVars[provider.alias])
+		}
+		fmt.Fprintf(&body, "new GrafanaProvider(this, %s, %s);\n\n", tsQuote(id), tsValue(provider.config, 2, tsString))
+	}
+
+	for _, r := range p.resources {
+		className, importPath := cdktfClass(r.resourceType)
+		classes[className] = importPath
+
+		properties := maps.Clone(r.properties)
+		if providerVar, ok := providerVars[r.provider]; ok {
+			properties["provider"] = programRawTS(providerVar)
+		}
+		variable := tsVariableName(r.logicalName())
+		fmt.Fprintf(&body, "    const %s = new %s(this, %s, %s);\n", variable, className, tsQuote(r.logicalName()), tsValue(properties, 2, tsString))
+		if r.id != "" {
+			fmt.Fprintf(&body, "    %s.importFrom(%s);\n", variable, tsQuote(r.id))
+		}
+		body.WriteString("\n")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("// Grafana resources generated by the Terraform Grafana provider's config generator\n")
+	sb.WriteString("import { Construct } from \"constructs\";\n")
+	if usesFn {
+		sb.WriteString("import { App, Fn, TerraformStack } from \"cdktf\";\n")
+	} else {
+		sb.WriteString("import { App, TerraformStack } from \"cdktf\";\n")
+	}
+	sb.WriteString("import { GrafanaProvider } from \"./.gen/providers/grafana/provider\";\n")
+	for _, className := range slices.Sorted(maps.Keys(classes)) {
+		fmt.Fprintf(&sb, "import { %s } from %s;\n", className, tsQuote(classes[className]))
+	}
+	sb.WriteString("\nclass GrafanaStack extends TerraformStack {\n")
+	sb.WriteString("  constructor(scope: Construct, id: string) {\n")
+	sb.WriteString("    super(scope, id);\n\n")
+	sb.WriteString(strings.TrimSuffix(body.String(), "\n"))
+	sb.WriteString("  }\n}\n\n")
+	sb.WriteString("const app = new App();\n")
+	sb.WriteString("new GrafanaStack(app, \"grafana\");\n")
+	sb.WriteString("app.synth();\n")
+	if err := os.WriteFile(filepath.Join(dir, "main.ts"), []byte(sb.String()), 0600); err != nil {
+		return err
+	}
+
+	terraformProvider := "grafana/grafana"
+	if providerVersion != "" {
+		terraformProvider += "@" + providerVersion
+	}
+	cdktfJSON, err := json.MarshalIndent(map[string]any{
+		"language":           "typescript",
+		"app":                "npx ts-node main.ts",
+		"terraformProviders": []string{terraformProvider},
+		"codeMakerOutput":    ".gen",
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "cdktf.json"), append(cdktfJSON, '\n'), 0600)
+}
+
+// programRawTS is a TypeScript expression, written as is.
+type programRawTS string
+
+// cdktfClass returns the class of the resource in the provider bindings generated by `cdktf get`, and its import path.
+func cdktfClass(resourceType string) (string, string) {
+	name := strings.TrimPrefix(resourceType, "grafana_")
+	className := toCamelCase(name)
+	className = strings.ToUpper(className[:1]) + className[1:]
+	return className, "./.gen/providers/grafana/" + strings.ReplaceAll(name, "_", "-")
+}
+
+// tsVariableName converts a logical name to a camelCase TypeScript variable name.
+func tsVariableName(name string) string {
+	var sb strings.Builder
+	for i, word := range tsWordRegexp.FindAllString(name, -1) {
+		if i == 0 {
+			sb.WriteString(strings.ToLower(word[:1]) + word[1:])
+			continue
+		}
+		sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return sb.String()
+}
+
+func tsQuote(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s) // Strings are always encodable
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// tsValue writes program properties as a TypeScript expression, with references written as attributes of the resource variables.
+func tsValue(value any, indent int, tsString func(string) string) string {
+	prefix := strings.Repeat("  ", indent)
+	switch value := value.(type) {
+	case programRawTS:
+		return string(value)
+	case *programReference:
+		return tsVariableName(value.resource.logicalName()) + "." + value.attribute
+	case string:
+		return tsString(value)
+	case []any:
+		if len(value) == 0 {
+			return "[]"
+		}
+		var sb strings.Builder
+		sb.WriteString("[\n")
+		for _, element := range value {
+			fmt.Fprintf(&sb, "%s  %s,\n", prefix, tsValue(element, indent+1, tsString))
+		}
+		sb.WriteString(prefix + "]")
+		return sb.String()
+	case map[string]any:
+		if len(value) == 0 {
+			return "{}"
+		}
+		var sb strings.Builder
+		sb.WriteString("{\n")
+		for _, key := range slices.Sorted(maps.Keys(value)) {
+			tsKey := key
+			if !tsIdentifierRegexp.MatchString(key) {
+				tsKey = tsQuote(key)
+			}
+			fmt.Fprintf(&sb, "%s  %s: %s,\n", prefix, tsKey, tsValue(value[key], indent+1, tsString))
+		}
+		sb.WriteString(prefix + "}")
+		return sb.String()
+	}
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
@@ -0,0 +1,121 @@
+package generate
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"gopkg.in/yaml.v2"
+)
+
+const pulumiProviderType = "pulumi:providers:grafana"
+
+// pulumiModules maps resource categories to the modules of the Pulumi Grafana provider,
+// and to the prefix which is removed from the Terraform resource type to get the Pulumi resource name.
+var pulumiModules = map[common.ResourceCategory]struct{ module, prefix string }{
+	common.CategoryAlerting:            {"alerting", "grafana_"},
+	common.CategoryCloud:               {"cloud", "grafana_cloud_"},
+	common.CategoryGrafanaEnterprise:   {"enterprise", "grafana_"},
+	common.CategoryGrafanaOSS:          {"oss", "grafana_"},
+	common.CategoryMachineLearning:     {"machineLearning", "grafana_machine_learning_"},
+	common.CategoryOnCall:              {"onCall", "grafana_oncall_"},
+	common.CategorySLO:                 {"slo", "grafana_"},
+	common.CategorySyntheticMonitoring: {"syntheticMonitoring", "grafana_synthetic_monitoring_"},
+	common.CategoryCloudProvider:       {"cloudProvider", "grafana_cloud_provider_"},
+	common.CategoryConnections:         {"connections", "grafana_connections_"},
+	common.CategoryFleetManagement:     {"fleetManagement", "grafana_fleet_management_"},
+	common.CategoryFrontendO11y:        {"frontendObservability", "grafana_frontend_o11y_"},
+	common.CategoryAsserts:             {"asserts", "grafana_asserts_"},
+	common.CategoryK6:                  {"k6", "grafana_k6_"},
+}
+
+// writePulumiYAML writes the program as a Pulumi YAML project, which imports the existing resources on the first `pulumi up`.
+func writePulumiYAML(dir string, p *program) error {
+	providerNames := map[string]string{}
+	resources := yaml.MapSlice{}
+	for _, provider := range p.providers {
+		name := "grafana"
+		if provider.alias != "" {
+			name += "-" + provider.alias
+		}
+		providerNames[provider.alias] = name
+
+		// The alias is only needed by Terraform, Pulumi providers are referenced by name
+		properties := map[string]any{}
+		for key, value := range provider.config {
+			if key != "alias" {
+				properties[key] = value
+			}
+		}
+		resources = append(resources, yaml.MapItem{Key: name, Value: yaml.MapSlice{
+			{Key: "type", Value: pulumiProviderType},
+			{Key: "properties", Value: pulumiValue(properties)},
+		}})
+	}
+
+	for _, r := range p.resources {
+		resource := yaml.MapSlice{{Key: "type", Value: pulumiType(r)}}
+		if len(r.properties) > 0 {
+			resource = append(resource, yaml.MapItem{Key: "properties", Value: pulumiValue(r.properties)})
+		}
+		options := yaml.MapSlice{}
+		if providerName, ok := providerNames[r.provider]; ok {
+			options = append(options, yaml.MapItem{Key: "provider", Value: "${" + providerName + "}"})
+		}
+		if r.id != "" {
+			options = append(options, yaml.MapItem{Key: "import", Value: r.id})
+		}
+		if len(options) > 0 {
+			resource = append(resource, yaml.MapItem{Key: "options", Value: options})
+		}
+		resources = append(resources, yaml.MapItem{Key: r.logicalName(), Value: resource})
+	}
+
+	content, err := yaml.Marshal(yaml.MapSlice{
+		{Key: "name", Value: "grafana"},
+		{Key: "runtime", Value: "yaml"},
+		{Key: "description", Value: "Generated by the Grafana Terraform provider's config generator"},
+		{Key: "resources", Value: resources},
+	})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "Pulumi.yaml"), content, 0600)
+}
+
+// pulumiType returns the type token of the resource in the Pulumi Grafana provider, ex: `grafana:oss/folder:Folder`.
+func pulumiType(r *programResource) string {
+	module, prefix := "index", "grafana_"
+	if m, ok := pulumiModules[r.category]; ok && strings.HasPrefix(r.resourceType, m.prefix) {
+		module, prefix = m.module, m.prefix
+	}
+	name := toCamelCase(strings.TrimPrefix(r.resourceType, prefix))
+	return "grafana:" + module + "/" + name + ":" + strings.ToUpper(name[:1]) + name[1:]
+}
+
+// pulumiValue converts program properties to YAML values, with references written as Pulumi interpolations.
+func pulumiValue(value any) any {
+	switch value := value.(type) {
+	case *programReference:
+		return "${" + value.resource.logicalName() + "." + value.attribute + "}"
+	case string:
+		// Literal interpolations (ex: in alert templates) are escaped
+		return strings.ReplaceAll(value, "${", "$${")
+	case []any:
+		result := make([]any, 0, len(value))
+		for _, element := range value {
+			result = append(result, pulumiValue(element))
+		}
+		return result
+	case map[string]any:
+		result := yaml.MapSlice{}
+		for _, key := range slices.Sorted(maps.Keys(value)) {
+			result = append(result, yaml.MapItem{Key: key, Value: pulumiValue(value[key])})
+		}
+		return result
+	}
+	return value
+}
@@ -0,0 +1,111 @@
+package generate
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/pkg/provider"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestProgram reads the Terraform JSON configuration and the planned values in testdata/programs.
+func newTestProgram(t *testing.T) *program {
+	t.Helper()
+
+	configs, err := readTFJSONConfigs(filepath.Join("testdata", "programs", "config"))
+	require.NoError(t, err)
+
+	var planned []*tfjson.StateResource
+	require.NoError(t, json.Unmarshal([]byte(readTestFile(t, "testdata", "programs", "planned.json")), &planned))
+
+	return newProgram(configs, planned, provider.ResourcesMap())
+}
+
+func assertProgramFiles(t *testing.T, gotDir, expectedDir string) {
+	t.Helper()
+
+	expectedFiles, err := os.ReadDir(expectedDir)
+	require.NoError(t, err)
+	gotFiles, err := os.ReadDir(gotDir)
+	require.NoError(t, err)
+	require.Len(t, gotFiles, len(expectedFiles))
+
+	for _, file := range expectedFiles {
+		assert.Equal(t, readTestFile(t, expectedDir, file.Name()), readTestFile(t, gotDir, file.Name()), file.Name())
+	}
+}
+
+func TestNewProgram(t *testing.T) {
+	t.Parallel()
+
+	p := newTestProgram(t)
+
+	var names []string
+	for _, r := range p.resources {
+		names = append(names, r.logicalName())
+	}
+	// Referenced resources are declared first
+	assert.Equal(t, []string{
+		"grafana_contact_point_email_receiver",
+		"grafana_folder_my-folder-uid",
+		"grafana_dashboard_my-dashboard-uid",
+		"grafana_folder_stack-test_my-folder-uid",
+		"grafana_rule_group_my_rule_group",
+	}, names)
+
+	dashboard := p.resources[2]
+	assert.Equal(t, "my-dashboard-uid", dashboard.id)
+	assert.Equal(t, `{"title":"My Dashboard","uid":"my-dashboard-uid"}`, dashboard.properties["configJson"])
+	assert.Equal(t, &programReference{resource: p.resources[1], attribute: "uid"}, dashboard.properties["folder"])
+
+	assert.Equal(t, "stack-test", p.resources[3].provider)
+	assert.NotContains(t, p.resources[3].properties, "provider")
+
+	// Blocks with at most one element are objects, map attributes keep their keys
+	rule := p.resources[4].properties["rule"].([]any)[0].(map[string]any)
+	data := rule["data"].([]any)[0].(map[string]any)
+	assert.Equal(t, map[string]any{"from": float64(600), "to": float64(0)}, data["relativeTimeRange"])
+	assert.Equal(t, map[string]any{"app.kubernetes.io/name": "grafana", "team": "platform"}, rule["labels"])
+}
+
+func TestWritePulumiYAML(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, writePulumiYAML(dir, newTestProgram(t)))
+	assertProgramFiles(t, dir, filepath.Join("testdata", "programs", "pulumi-yaml"))
+}
+
+func TestWriteCDKTFTypeScript(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, writeCDKTFTypeScript(dir, "999.999.999", newTestProgram(t)))
+	assertProgramFiles(t, dir, filepath.Join("testdata", "programs", "cdktf-ts"))
+}
+
+func TestPulumiType(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		resourceType string
+		category     common.ResourceCategory
+		expected     string
+	}{
+		{"grafana_folder", common.CategoryGrafanaOSS, "grafana:oss/folder:Folder"},
+		{"grafana_contact_point", common.CategoryAlerting, "grafana:alerting/contactPoint:ContactPoint"},
+		{"grafana_cloud_stack", common.CategoryCloud, "grafana:cloud/stack:Stack"},
+		{"grafana_synthetic_monitoring_check", common.CategorySyntheticMonitoring, "grafana:syntheticMonitoring/check:Check"},
+		{"grafana_oncall_integration", common.CategoryOnCall, "grafana:onCall/integration:Integration"},
+		{"grafana_report", common.CategoryGrafanaEnterprise, "grafana:enterprise/report:Report"},
+		{"grafana_apps_dashboard_dashboard_v1beta1", common.CategoryGrafanaApps, "grafana:index/appsDashboardDashboardV1beta1:AppsDashboardDashboardV1beta1"},
+	} {
+		r := &programResource{resourceType: tc.resourceType, category: tc.category}
+		assert.Equal(t, tc.expected, pulumiType(r), tc.resourceType)
+	}
+}
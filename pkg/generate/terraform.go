@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -21,10 +22,60 @@ import (
 	"github.com/tmccombs/hcl2json/convert"
 )
 
+var (
+	// Config generation with import blocks was introduced in Terraform 1.5 and OpenTofu 1.6
+	minTerraformVersion = version.Must(version.NewVersion("1.5.0"))
+	minOpenTofuVersion  = version.Must(version.NewVersion("1.6.0"))
+)
+
 func setupTerraform(cfg *Config) (*tfexec.Terraform, error) {
 	var err error
 
-	tfVersion := cfg.TerraformInstallConfig.Version
+	installConfig := cfg.TerraformInstallConfig
+	var execPath string
+	switch {
+	case installConfig.BinaryPath != "":
+		execPath = installConfig.BinaryPath
+	case installConfig.Engine == EngineOpenTofu:
+		if execPath, err = findOpenTofu(installConfig); err != nil {
+			return nil, err
+		}
+	case installConfig.Engine == "" || installConfig.Engine == EngineTerraform:
+		if execPath, err = installTerraform(installConfig); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported engine %q, supported engines are: %v", installConfig.Engine, Engines)
+	}
+
+	tf, err := tfexec.NewTerraform(cfg.OutputDir, execPath)
+	if err != nil {
+		return nil, fmt.Errorf("error running NewTerraform: %s", err)
+	}
+	if err := checkEngineVersion(tf, installConfig); err != nil {
+		return nil, err
+	}
+
+	// In drift sync mode, keep the provider versions locked by the existing workspace
+	initOptions := []tfexec.InitOption{
+		tfexec.Upgrade(!cfg.DriftSync),
+	}
+	if installConfig.PluginDir != "" {
+		initOptions = append(initOptions, tfexec.PluginDir(installConfig.PluginDir))
+	}
+
+	err = tf.Init(context.Background(), initOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("error running Init: %w", err)
+	}
+
+	return tf, nil
+}
+
+func installTerraform(installConfig TerraformInstallConfig) (string, error) {
+	var err error
+
+	tfVersion := installConfig.Version
 	if tfVersion == nil {
 		// Not using latest to avoid unexpected breaking changes
 		log.Printf("No Terraform version specified, defaulting to version 1.8.5")
@@ -33,12 +84,12 @@ func setupTerraform(cfg *Config) (*tfexec.Terraform, error) {
 
 	// Check if Terraform is already installed
 	var execPath string
-	if cfg.TerraformInstallConfig.InstallDir != "" {
+	if installConfig.InstallDir != "" {
 		finder := fs.ExactVersion{
 			Product: product.Terraform,
 			Version: tfVersion,
 			ExtraPaths: []string{
-				cfg.TerraformInstallConfig.InstallDir,
+				installConfig.InstallDir,
 			},
 		}
 
@@ -53,31 +104,58 @@ func setupTerraform(cfg *Config) (*tfexec.Terraform, error) {
 		installer := &releases.ExactVersion{
 			Product:    product.Terraform,
 			Version:    tfVersion,
-			InstallDir: cfg.TerraformInstallConfig.InstallDir,
+			InstallDir: installConfig.InstallDir,
 		}
 		if execPath, err = installer.Install(context.Background()); err != nil {
-			return nil, fmt.Errorf("error installing Terraform: %s", err)
+			return "", fmt.Errorf("error installing Terraform: %s", err)
 		}
 	}
 
-	tf, err := tfexec.NewTerraform(cfg.OutputDir, execPath)
-	if err != nil {
-		return nil, fmt.Errorf("error running NewTerraform: %s", err)
+	return execPath, nil
+}
+
+// findOpenTofu looks up the OpenTofu binary in the install dir, then in the PATH.
+// hc-install only supports HashiCorp products, so OpenTofu has to be installed beforehand.
+func findOpenTofu(installConfig TerraformInstallConfig) (string, error) {
+	if installConfig.InstallDir != "" {
+		execPath := filepath.Join(installConfig.InstallDir, "tofu")
+		if _, err := os.Stat(execPath); err == nil {
+			return execPath, nil
+		}
 	}
 
-	initOptions := []tfexec.InitOption{
-		tfexec.Upgrade(true),
+	execPath, err := exec.LookPath("tofu")
+	if err != nil {
+		return "", fmt.Errorf("OpenTofu must be installed in the install dir or in the PATH, or its binary path must be given: %w", err)
 	}
-	if cfg.TerraformInstallConfig.PluginDir != "" {
-		initOptions = append(initOptions, tfexec.PluginDir(cfg.TerraformInstallConfig.PluginDir))
+	log.Printf("Using OpenTofu installed at %s", execPath)
+	return execPath, nil
+}
+
+// checkEngineVersion checks that binaries which were not installed by the generator support config generation,
+// and match the requested version if any.
+func checkEngineVersion(tf *tfexec.Terraform, installConfig TerraformInstallConfig) error {
+	if installConfig.BinaryPath == "" && installConfig.Engine != EngineOpenTofu {
+		return nil // Installed by the generator, with the requested version
 	}
 
-	err = tf.Init(context.Background(), initOptions...)
+	// OpenTofu reports its version like Terraform does
+	tfVersion, _, err := tf.Version(context.Background(), true)
 	if err != nil {
-		return nil, fmt.Errorf("error running Init: %w", err)
+		return fmt.Errorf("failed to read the version of %s: %w", tf.ExecPath(), err)
 	}
 
-	return tf, nil
+	minVersion := minTerraformVersion
+	if installConfig.Engine == EngineOpenTofu {
+		minVersion = minOpenTofuVersion
+	}
+	if tfVersion.LessThan(minVersion) {
+		return fmt.Errorf("%s has version %s, but at least version %s is required to generate config", tf.ExecPath(), tfVersion, minVersion)
+	}
+	if installConfig.Version != nil && !tfVersion.Equal(installConfig.Version) {
+		return fmt.Errorf("%s has version %s, but version %s was requested", tf.ExecPath(), tfVersion, installConfig.Version)
+	}
+	return nil
 }
 
 func writeBlocks(filepath string, blocks ...*hclwrite.Block) error {
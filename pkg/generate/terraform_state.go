@@ -5,16 +5,23 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
 )
 
+const (
+	terraformRegistryHost = "registry.terraform.io/"
+	openTofuRegistryHost  = "registry.opentofu.org/"
+)
+
 func getState(ctx context.Context, cfg *Config) (*tfjson.State, error) {
 	state, err := cfg.Terraform.Show(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read terraform state: %w", err)
+		return nil, fmt.Errorf("failed to read %s state: %w", cfg.TerraformInstallConfig.Engine.displayName(), err)
 	}
+	normalizeState(state)
 	return state, nil
 }
 
@@ -25,10 +32,60 @@ func getPlannedState(ctx context.Context, cfg *Config) (*tfjson.Plan, error) {
 	}
 	defer os.RemoveAll(tempWorkingDir)
 
+	engine := cfg.TerraformInstallConfig.Engine.displayName()
 	planFile := filepath.Join(tempWorkingDir, "plan.tfplan")
 	if _, err := cfg.Terraform.Plan(ctx, tfexec.Out(planFile)); err != nil {
-		return nil, fmt.Errorf("failed to read terraform plan: %w", err)
+		return nil, fmt.Errorf("failed to read %s plan: %w", engine, err)
+	}
+
+	plan, err := cfg.Terraform.ShowPlanFile(ctx, planFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s plan: %w", engine, err)
+	}
+	normalizePlan(plan)
+	return plan, nil
+}
+
+// normalizeState makes the state read from OpenTofu look like the state read from Terraform:
+// the provider addresses use the Terraform registry, and the values are never nil, even when the state is empty.
+func normalizeState(state *tfjson.State) {
+	if state.Values == nil {
+		state.Values = &tfjson.StateValues{}
+	}
+	if state.Values.RootModule == nil {
+		state.Values.RootModule = &tfjson.StateModule{}
+	}
+	normalizeStateModule(state.Values.RootModule)
+}
+
+// normalizePlan is normalizeState for the planned values of a plan.
+func normalizePlan(plan *tfjson.Plan) {
+	if plan.PlannedValues == nil {
+		plan.PlannedValues = &tfjson.StateValues{}
+	}
+	if plan.PlannedValues.RootModule == nil {
+		plan.PlannedValues.RootModule = &tfjson.StateModule{}
+	}
+	normalizeStateModule(plan.PlannedValues.RootModule)
+	for _, change := range plan.ResourceChanges {
+		change.ProviderName = normalizeProviderAddress(change.ProviderName)
+	}
+}
+
+func normalizeStateModule(module *tfjson.StateModule) {
+	for _, resource := range module.Resources {
+		resource.ProviderName = normalizeProviderAddress(resource.ProviderName)
+	}
+	for _, child := range module.ChildModules {
+		normalizeStateModule(child)
 	}
+}
 
-	return cfg.Terraform.ShowPlanFile(ctx, planFile)
+// normalizeProviderAddress rewrites the providers of the OpenTofu registry, e.g. registry.opentofu.org/grafana/grafana,
+// to their Terraform registry address. The Grafana provider has the same source on both registries.
+func normalizeProviderAddress(address string) string {
+	if rest, ok := strings.CutPrefix(address, openTofuRegistryHost); ok {
+		return terraformRegistryHost + rest
+	}
+	return address
 }
@@ -1,10 +1,15 @@
 package generate
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-exec/tfexec"
+	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -32,3 +37,128 @@ func TestTFJSON(t *testing.T) {
 
 	assert.Equal(t, string(expectedContent), string(gotContent))
 }
+
+// fakeOpenTofu writes a tofu binary which only reports the given version
+func fakeOpenTofu(t *testing.T, dir, tofuVersion string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake binary is a shell script")
+	}
+
+	execPath := filepath.Join(dir, "tofu")
+	script := fmt.Sprintf("#!/bin/sh\necho '{\"terraform_version\":\"%s\",\"platform\":\"linux_amd64\",\"provider_selections\":{}}'\n", tofuVersion)
+	require.NoError(t, os.WriteFile(execPath, []byte(script), 0700)) //nolint:gosec // path is in test tempdir
+	return execPath
+}
+
+func TestFindOpenTofu(t *testing.T) {
+	installDir := t.TempDir()
+	execPath := fakeOpenTofu(t, installDir, "1.8.0")
+
+	got, err := findOpenTofu(TerraformInstallConfig{Engine: EngineOpenTofu, InstallDir: installDir})
+	require.NoError(t, err)
+	assert.Equal(t, execPath, got)
+
+	t.Setenv("PATH", installDir)
+	got, err = findOpenTofu(TerraformInstallConfig{Engine: EngineOpenTofu})
+	require.NoError(t, err)
+	assert.Equal(t, execPath, got)
+
+	t.Setenv("PATH", t.TempDir())
+	_, err = findOpenTofu(TerraformInstallConfig{Engine: EngineOpenTofu})
+	require.ErrorContains(t, err, "OpenTofu must be installed")
+}
+
+func TestCheckEngineVersion(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name          string
+		binaryVersion string
+		installConfig TerraformInstallConfig
+		expectedError string
+	}{
+		{
+			name:          "opentofu",
+			binaryVersion: "1.8.0",
+			installConfig: TerraformInstallConfig{Engine: EngineOpenTofu},
+		},
+		{
+			name:          "opentofu too old",
+			binaryVersion: "1.5.7",
+			installConfig: TerraformInstallConfig{Engine: EngineOpenTofu},
+			expectedError: "at least version 1.6.0 is required",
+		},
+		{
+			name:          "opentofu with requested version",
+			binaryVersion: "1.8.0",
+			installConfig: TerraformInstallConfig{Engine: EngineOpenTofu, Version: version.Must(version.NewVersion("1.7.0"))},
+			expectedError: "version 1.7.0 was requested",
+		},
+		{
+			name:          "terraform binary",
+			binaryVersion: "1.5.0",
+			installConfig: TerraformInstallConfig{Engine: EngineTerraform, BinaryPath: "set"},
+		},
+		{
+			name:          "terraform binary too old",
+			binaryVersion: "1.4.6",
+			installConfig: TerraformInstallConfig{Engine: EngineTerraform, BinaryPath: "set"},
+			expectedError: "at least version 1.5.0 is required",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			tf, err := tfexec.NewTerraform(dir, fakeOpenTofu(t, dir, tc.binaryVersion))
+			require.NoError(t, err)
+
+			err = checkEngineVersion(tf, tc.installConfig)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestNormalizeOpenTofuPlan(t *testing.T) {
+	t.Parallel()
+
+	var plan tfjson.Plan
+	require.NoError(t, plan.UnmarshalJSON([]byte(`{
+  "format_version": "1.2",
+  "terraform_version": "1.8.0",
+  "planned_values": {
+    "root_module": {
+      "resources": [{"address": "grafana_folder.test", "mode": "managed", "type": "grafana_folder", "name": "test", "provider_name": "registry.opentofu.org/grafana/grafana", "values": {"title": "test"}}],
+      "child_modules": [{
+        "address": "module.dashboards",
+        "resources": [{"address": "module.dashboards.grafana_dashboard.test", "mode": "managed", "type": "grafana_dashboard", "name": "test", "provider_name": "registry.opentofu.org/grafana/grafana", "values": {}}]
+      }]
+    }
+  },
+  "resource_changes": [{"address": "grafana_folder.test", "mode": "managed", "type": "grafana_folder", "name": "test", "provider_name": "registry.opentofu.org/grafana/grafana", "change": {"actions": ["create"]}}]
+}`)))
+	normalizePlan(&plan)
+
+	root := plan.PlannedValues.RootModule
+	assert.Equal(t, "registry.terraform.io/grafana/grafana", root.Resources[0].ProviderName)
+	assert.Equal(t, "registry.terraform.io/grafana/grafana", root.ChildModules[0].Resources[0].ProviderName)
+	assert.Equal(t, "registry.terraform.io/grafana/grafana", plan.ResourceChanges[0].ProviderName)
+}
+
+func TestNormalizeEmptyState(t *testing.T) {
+	t.Parallel()
+
+	// An empty state has no values
+	var state tfjson.State
+	require.NoError(t, state.UnmarshalJSON([]byte(`{"format_version": "1.0"}`)))
+	normalizeState(&state)
+
+	require.NotNil(t, state.Values)
+	require.NotNil(t, state.Values.RootModule)
+	assert.Empty(t, state.Values.RootModule.Resources)
+}
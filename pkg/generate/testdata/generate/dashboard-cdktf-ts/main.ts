@@ -0,0 +1,73 @@
+// Grafana resources generated by the Terraform Grafana provider's config generator
+import { Construct } from "constructs";
+import { App, TerraformStack } from "cdktf";
+import { GrafanaProvider } from "./.gen/providers/grafana/provider";
+import { ContactPoint } from "./.gen/providers/grafana/contact-point";
+import { Dashboard } from "./.gen/providers/grafana/dashboard";
+import { Folder } from "./.gen/providers/grafana/folder";
+import { NotificationPolicy } from "./.gen/providers/grafana/notification-policy";
+import { OrganizationPreferences } from "./.gen/providers/grafana/organization-preferences";
+import { User } from "./.gen/providers/grafana/user";
+
+class GrafanaStack extends TerraformStack {
+  constructor(scope: Construct, id: string) {
+    super(scope, id);
+
+    new GrafanaProvider(this, "grafana", {
+      auth: "REDACTED",
+      url: "http://localhost:3000",
+    });
+
+    const grafanaContactPointEmailReceiver = new ContactPoint(this, "grafana_contact_point_email_receiver", {
+      disableProvenance: true,
+      email: [
+        {
+          addresses: [
+            "<example@email.com>",
+          ],
+          disableResolveMessage: false,
+          singleEmail: false,
+        },
+      ],
+      name: "email receiver",
+    });
+    grafanaContactPointEmailReceiver.importFrom("email receiver");
+
+    const grafanaFolderMyFolderUid = new Folder(this, "grafana_folder_my-folder-uid", {
+      title: "My Folder",
+      uid: "my-folder-uid",
+    });
+    grafanaFolderMyFolderUid.importFrom("my-folder-uid");
+
+    const grafanaDashboardMyDashboardUid = new Dashboard(this, "grafana_dashboard_my-dashboard-uid", {
+      configJson: "{\"title\":\"My Dashboard\",\"uid\":\"my-dashboard-uid\"}",
+      folder: grafanaFolderMyFolderUid.uid,
+    });
+    grafanaDashboardMyDashboardUid.importFrom("my-dashboard-uid");
+
+    const grafanaNotificationPolicyPolicy = new NotificationPolicy(this, "grafana_notification_policy_policy", {
+      contactPoint: "grafana-default-email",
+      disableProvenance: true,
+      groupBy: [
+        "grafana_folder",
+        "alertname",
+      ],
+    });
+    grafanaNotificationPolicyPolicy.importFrom("policy");
+
+    const grafanaOrganizationPreferences1 = new OrganizationPreferences(this, "grafana_organization_preferences__1", {});
+    grafanaOrganizationPreferences1.importFrom("1");
+
+    const grafanaUserAdmin = new User(this, "grafana_user_admin", {
+      email: "admin@localhost",
+      isAdmin: true,
+      login: "admin",
+      password: "SENSITIVE_VALUE_TO_REPLACE",
+    });
+    grafanaUserAdmin.importFrom("1");
+  }
+}
+
+const app = new App();
+new GrafanaStack(app, "grafana");
+app.synth();
@@ -0,0 +1,89 @@
+// Grafana resources generated by the Terraform Grafana provider's config generator
+import { Construct } from "constructs";
+import { App, Fn, TerraformStack } from "cdktf";
+import { GrafanaProvider } from "./.gen/providers/grafana/provider";
+import { ContactPoint } from "./.gen/providers/grafana/contact-point";
+import { Dashboard } from "./.gen/providers/grafana/dashboard";
+import { Folder } from "./.gen/providers/grafana/folder";
+import { RuleGroup } from "./.gen/providers/grafana/rule-group";
+
+class GrafanaStack extends TerraformStack {
+  constructor(scope: Construct, id: string) {
+    super(scope, id);
+
+    new GrafanaProvider(this, "grafana", {
+      auth: "REDACTED",
+      url: "http://localhost:3000",
+    });
+
+    const grafanaStackTest = new GrafanaProvider(this, "grafana-stack-test", {
+      alias: "stack-test",
+      auth: "REDACTED",
+      url: "https://test.grafana.net",
+    });
+
+    const grafanaContactPointEmailReceiver = new ContactPoint(this, "grafana_contact_point_email_receiver", {
+      email: [
+        {
+          addresses: [
+            "test@example.com",
+          ],
+          message: Fn.rawString("Firing: ${labels.alertname}"),
+        },
+      ],
+      name: "email receiver",
+    });
+    grafanaContactPointEmailReceiver.importFrom("email receiver");
+
+    const grafanaFolderMyFolderUid = new Folder(this, "grafana_folder_my-folder-uid", {
+      title: "My Folder",
+      uid: "my-folder-uid",
+    });
+    grafanaFolderMyFolderUid.importFrom("my-folder-uid");
+
+    const grafanaDashboardMyDashboardUid = new Dashboard(this, "grafana_dashboard_my-dashboard-uid", {
+      configJson: "{\"title\":\"My Dashboard\",\"uid\":\"my-dashboard-uid\"}",
+      folder: grafanaFolderMyFolderUid.uid,
+    });
+    grafanaDashboardMyDashboardUid.importFrom("my-dashboard-uid");
+
+    const grafanaFolderStackTestMyFolderUid = new Folder(this, "grafana_folder_stack-test_my-folder-uid", {
+      provider: grafanaStackTest,
+      title: "My Folder",
+      uid: "my-folder-uid",
+    });
+    grafanaFolderStackTestMyFolderUid.importFrom("my-folder-uid");
+
+    const grafanaRuleGroupMyRuleGroup = new RuleGroup(this, "grafana_rule_group_my_rule_group", {
+      folderUid: grafanaFolderMyFolderUid.uid,
+      intervalSeconds: 60,
+      name: "My Rule Group",
+      rule: [
+        {
+          condition: "A",
+          data: [
+            {
+              datasourceUid: "prometheus",
+              model: "{\"expr\":\"up\"}",
+              refId: "A",
+              relativeTimeRange: {
+                from: 600,
+                to: 0,
+              },
+            },
+          ],
+          labels: {
+            "app.kubernetes.io/name": "grafana",
+            team: "platform",
+          },
+          name: "My Rule",
+        },
+      ],
+    });
+    grafanaRuleGroupMyRuleGroup.importFrom("my-folder-uid:My Rule Group");
+  }
+}
+
+const app = new App();
+new GrafanaStack(app, "grafana");
+app.synth();
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+const auditLogRedacted = "[REDACTED]"
+
+// auditLogSensitiveKeys are the substrings of the JSON keys whose values are redacted in the audit log.
+var auditLogSensitiveKeys = []string{"password", "secret", "token", "key", "auth", "credential", "secure", "private", "cert", "url", "webhook"}
+
+// auditLogMutex serializes the writes to the audit logs, the provider instances may share the same file.
+var auditLogMutex sync.Mutex
+
+// auditLogRecord is a line of the audit log.
+type auditLogRecord struct {
+	Time         time.Time       `json:"time"`
+	Method       string          `json:"method"`
+	Host         string          `json:"host"`
+	Path         string          `json:"path"`
+	Status       int             `json:"status,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	ResourceType string          `json:"resource_type,omitempty"`
+	ResourceID   string          `json:"resource_id,omitempty"`
+	StackID      int64           `json:"stack_id,omitempty"`
+	OrgID        int64           `json:"org_id,omitempty"`
+	Body         json.RawMessage `json:"body,omitempty"`
+}
+
+// auditLogRoundTripper appends a record to the audit log for every request which could modify a resource.
+type auditLogRoundTripper struct {
+	base    http.RoundTripper
+	path    string
+	stackID int64
+	orgID   int64
+}
+
+func (rt *auditLogRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isReadRequest(req) {
+		return rt.base.RoundTrip(req)
+	}
+
+	record := auditLogRecord{
+		Time:         time.Now().UTC(),
+		Method:       req.Method,
+		Host:         req.URL.Host,
+		Path:         req.URL.Path,
+		ResourceType: common.ResourceTypeFromContext(req.Context()),
+		ResourceID:   common.ResourceIDFromContext(req.Context()),
+		StackID:      rt.stackID,
+		OrgID:        rt.orgID,
+	}
+	if orgID, err := strconv.ParseInt(req.Header.Get("X-Grafana-Org-Id"), 10, 64); err == nil {
+		record.OrgID = orgID
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the request body for the audit log: %w", err)
+		}
+		// The body was consumed, the request is cloned to be sent with a copy of it
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		record.Body = redactAuditLogBody(body)
+	}
+
+	resp, err := rt.base.RoundTrip(req)
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.Status = resp.StatusCode
+	}
+
+	// The request was already sent, failing it would lose track of the change in the Terraform state
+	if logErr := appendAuditLogRecord(rt.path, record); logErr != nil {
+		log.Printf("[WARN] failed to write %s %s to the audit log %s: %v", record.Method, record.Path, rt.path, logErr)
+	}
+	return resp, err
+}
+
+// isReadRequest returns whether the request can't modify a resource.
+func isReadRequest(req *http.Request) bool {
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead:
+		return true
+	}
+	return false
+}
+
+// checkAuditLog fails when the audit log can't be written, so that no change is made without being recorded.
+func checkAuditLog(path string) error {
+	auditLogMutex.Lock()
+	defer auditLogMutex.Unlock()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) //nolint:gosec // path is set by the provider configuration
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func appendAuditLogRecord(path string, record auditLogRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	auditLogMutex.Lock()
+	defer auditLogMutex.Unlock()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) //nolint:gosec // path is set by the provider configuration
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// redactAuditLogBody returns the JSON body with the values of the sensitive keys redacted.
+// Other bodies are replaced by their size, they may contain anything.
+func redactAuditLogBody(body []byte) json.RawMessage {
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		redacted, _ := json.Marshal(fmt.Sprintf("%s %d bytes", auditLogRedacted, len(body)))
+		return redacted
+	}
+	redacted, _ := json.Marshal(redactAuditLogValue(value))
+	return redacted
+}
+
+func redactAuditLogValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if isSensitiveAuditLogKey(key) {
+				v[key] = auditLogRedacted
+			} else {
+				v[key] = redactAuditLogValue(child)
+			}
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = redactAuditLogValue(child)
+		}
+	}
+	return value
+}
+
+func isSensitiveAuditLogKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range auditLogSensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	onCallAPI "github.com/grafana/amixr-api-go-client"
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte("[]"))
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	c, err := CreateClients(ProviderConfig{
+		URL:          types.StringValue(server.URL),
+		Auth:         types.StringValue("admin:admin"),
+		StackID:      types.Int64Value(12),
+		AuditLogPath: types.StringValue(path),
+	})
+	require.NoError(t, err)
+
+	// Reads aren't recorded
+	_, err = c.GrafanaAPI.Folders.GetFolders(nil)
+	require.NoError(t, err)
+	_, err = c.GrafanaAPI.WithOrgID(3).Folders.CreateFolder(&models.CreateFolderCommand{Title: "test"})
+	require.NoError(t, err)
+
+	// The resource type is recorded when the request has the context of the resource
+	ctx := common.ContextWithResourceType(context.Background(), "grafana_data_source")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, server.URL+"/api/datasources/uid/test", strings.NewReader(`{"name":"test","secureJsonData":{"password":"secret"},"jsonData":{"httpHeaderName1":"X-Token"}}`))
+	require.NoError(t, err)
+	resp, err := getRetryClient(ProviderConfig{AuditLogPath: types.StringValue(path)}).Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// The requests are sent unchanged
+	require.Len(t, bodies, 3)
+	assert.JSONEq(t, `{"title":"test"}`, bodies[1])
+	assert.Contains(t, bodies[2], `"password":"secret"`)
+
+	records := readAuditLog(t, path)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, http.MethodPost, records[0].Method)
+	assert.Equal(t, "/api/folders", records[0].Path)
+	assert.Equal(t, http.StatusOK, records[0].Status)
+	assert.Equal(t, int64(12), records[0].StackID)
+	assert.Equal(t, int64(3), records[0].OrgID)
+	assert.Empty(t, records[0].ResourceType)
+	assert.JSONEq(t, `{"title":"test"}`, string(records[0].Body))
+
+	assert.Equal(t, http.MethodPut, records[1].Method)
+	assert.Equal(t, "grafana_data_source", records[1].ResourceType)
+	assert.JSONEq(t, `{"name":"test","secureJsonData":"[REDACTED]","jsonData":{"httpHeaderName1":"X-Token"}}`, string(records[1].Body))
+}
+
+func TestAuditLogResourceType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"uid":"test","title":"test","url":"/dashboards/f/test"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	c, err := CreateClients(ProviderConfig{
+		URL:          types.StringValue(server.URL),
+		Auth:         types.StringValue("admin:admin"),
+		AuditLogPath: types.StringValue(path),
+	})
+	require.NoError(t, err)
+
+	// The folder resource doesn't give a context to the OpenAPI calls, its client sends them with the context of the resource
+	folder := legacySDKResources()["grafana_folder"]
+	d := folder.TestResourceData()
+	require.NoError(t, d.Set("title", "test"))
+	diags := folder.CreateContext(context.Background(), d, c)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "test", d.Get("uid"))
+
+	// The ID isn't known on create, the other functions record it
+	diags = folder.DeleteContext(context.Background(), d, c)
+	require.False(t, diags.HasError(), diags)
+
+	records := readAuditLog(t, path)
+	require.Len(t, records, 2)
+	assert.Equal(t, http.MethodPost, records[0].Method)
+	assert.Equal(t, "/api/folders", records[0].Path)
+	assert.Equal(t, "grafana_folder", records[0].ResourceType)
+	assert.Empty(t, records[0].ResourceID)
+	assert.Equal(t, http.MethodDelete, records[1].Method)
+	assert.Equal(t, "grafana_folder", records[1].ResourceType)
+	assert.Equal(t, d.Id(), records[1].ResourceID)
+}
+
+func TestAuditLogOnCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"test","name":"test"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	c, err := CreateClients(ProviderConfig{
+		OncallURL:         types.StringValue(server.URL),
+		OncallAccessToken: types.StringValue("oncall-token"),
+		AuditLogPath:      types.StringValue(path),
+	})
+	require.NoError(t, err)
+
+	// The OnCall client has its own HTTP client, its transport is wrapped too
+	_, _, err = c.OnCallClient.EscalationChains.CreateEscalationChain(&onCallAPI.CreateEscalationChainOptions{Name: "test"})
+	require.NoError(t, err)
+
+	records := readAuditLog(t, path)
+	require.Len(t, records, 1)
+	assert.Equal(t, http.MethodPost, records[0].Method)
+	assert.Contains(t, records[0].Path, "/escalation_chains")
+	assert.Contains(t, string(records[0].Body), `"name":"test"`)
+}
+
+func TestAuditLogReadOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	client := getRetryClient(ProviderConfig{ReadOnly: types.BoolValue(true), AuditLogPath: types.StringValue(path)})
+
+	resp, err := client.Post("http://localhost:1/api/folders", "application/json", strings.NewReader(`{"title":"test"}`))
+	require.ErrorIs(t, err, common.ErrReadOnly)
+	require.Nil(t, resp)
+
+	records := readAuditLog(t, path)
+	require.Len(t, records, 1)
+	assert.Equal(t, "POST /api/folders: "+common.ErrReadOnly.Error(), records[0].Error)
+	assert.Zero(t, records[0].Status)
+}
+
+func TestAuditLogInvalidPath(t *testing.T) {
+	_, err := CreateClients(ProviderConfig{
+		AuditLogPath: types.StringValue(filepath.Join(t.TempDir(), "missing", "audit.jsonl")),
+	})
+	require.ErrorContains(t, err, "failed to open the audit log")
+}
+
+func TestRedactAuditLogBody(t *testing.T) {
+	for _, tc := range []struct {
+		body     string
+		expected string
+	}{
+		{
+			body:     `{"title":"test","uid":"abc"}`,
+			expected: `{"title":"test","uid":"abc"}`,
+		},
+		{
+			body:     `{"settings":{"url":"https://hooks.slack.com/services/XXX","recipient":"#alerts"},"secureSettings":{"token":"t"}}`,
+			expected: `{"settings":{"url":"[REDACTED]","recipient":"#alerts"},"secureSettings":"[REDACTED]"}`,
+		},
+		{
+			body:     `[{"basicAuthPassword":"p","apiKey":"k","name":"n"}]`,
+			expected: `[{"basicAuthPassword":"[REDACTED]","apiKey":"[REDACTED]","name":"n"}]`,
+		},
+		{
+			body:     `title=test&password=secret`,
+			expected: `"[REDACTED] 26 bytes"`,
+		},
+	} {
+		assert.JSONEq(t, tc.expected, string(redactAuditLogBody([]byte(tc.body))), tc.body)
+	}
+}
+
+func readAuditLog(t *testing.T, path string) []auditLogRecord {
+	t.Helper()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var records []auditLogRecord
+	for line := range strings.SplitSeq(strings.TrimSpace(string(content)), "\n") {
+		if line == "" {
+			continue
+		}
+		var record auditLogRecord
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
@@ -9,10 +10,12 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"syscall"
 	"time"
+	"unsafe"
 
 	onCallAPI "github.com/grafana/amixr-api-go-client"
 	"github.com/grafana/grafana-app-sdk/k8s"
@@ -20,6 +23,7 @@ import (
 	assertsapi "github.com/grafana/grafana-asserts-public-clients/go/gcom"
 	"github.com/grafana/grafana-com-public-clients/go/gcom"
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	goapitransport "github.com/grafana/grafana-openapi-client-go/pkg/transport"
 	"github.com/grafana/k6-cloud-openapi-client-go/k6"
 	"github.com/grafana/machine-learning-go-client/mlapi"
 	"github.com/grafana/slo-openapi-client/go/slo"
@@ -50,6 +54,21 @@ import (
 func CreateClients(providerConfig ProviderConfig) (*common.Client, error) {
 	var err error
 	c := &common.Client{}
+	if path := providerConfig.AuditLogPath.ValueString(); path != "" {
+		if err := checkAuditLog(path); err != nil {
+			return nil, fmt.Errorf("failed to open the audit log: %w", err)
+		}
+	}
+	if providerConfig.requestLimiter, err = getRequestLimiter(providerConfig); err != nil {
+		return nil, err
+	}
+	c.RequestsLimited = providerConfig.requestLimiter != nil
+	c.HTTPClient = getRetryClient(providerConfig)
+	if !providerConfig.StackSlug.IsNull() {
+		if err := configureStackAuth(context.Background(), &providerConfig); err != nil {
+			return nil, err
+		}
+	}
 	if !providerConfig.Auth.IsNull() && !providerConfig.URL.IsNull() {
 		if err = createGrafanaURLClients(c, providerConfig); err != nil {
 			return nil, err
@@ -66,7 +85,7 @@ func CreateClients(providerConfig ProviderConfig) (*common.Client, error) {
 			versionString = "unknown"
 		}
 
-		c.SMAPI = SMAPI.NewClient(providerConfig.SMURL.ValueString(), providerConfig.SMAccessToken.ValueString(), getRetryClient(providerConfig))
+		c.SMAPI = SMAPI.NewClient(providerConfig.SMURL.ValueString(), providerConfig.SMAccessToken.ValueString(), stackTokenClient(getRetryClient(providerConfig), providerConfig.smToken))
 		c.SMAPI.SetCustomClientID("terraform")
 		c.SMAPI.SetCustomClientVersion(versionString)
 	}
@@ -113,6 +132,14 @@ func CreateClients(providerConfig ProviderConfig) (*common.Client, error) {
 	}
 
 	grafana.StoreDashboardSHA256 = providerConfig.StoreDashboardSha256.ValueBool()
+	c.DashboardLint = dashboardLintConfig(providerConfig.DashboardLint)
+	c.ReadOnly = providerConfig.ReadOnly.ValueBool()
+	if c.DefaultLabels, err = getDefaultLabels(providerConfig); err != nil {
+		return nil, err
+	}
+	if c.DatasourceUIDMap, err = getDatasourceUIDMap(providerConfig); err != nil {
+		return nil, err
+	}
 
 	return c, nil
 }
@@ -191,6 +218,21 @@ func createGrafanaAPIClient(client *common.Client, providerConfig ProviderConfig
 		return err
 	}
 	cfg.HTTPHeaders["User-Agent"] = providerConfig.UserAgent.ValueString()
+	if wrapsTransport(providerConfig) {
+		// The client rebuilds its transport from the config (e.g. in WithOrgID), only the *http.Client is kept.
+		// It's built with the same retries as the default transport.
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		cfg.Client = &http.Client{
+			Transport: wrapTransport(providerConfig, &goapitransport.RetryableTransport{
+				Transport:        limitTransport(providerConfig, transport),
+				NumRetries:       cfg.NumRetries,
+				RetryTimeout:     cfg.RetryTimeout,
+				RetryStatusCodes: cfg.RetryStatusCodes,
+				HTTPHeaders:      cfg.HTTPHeaders,
+			}),
+		}
+	}
 	client.GrafanaAPI = goapi.NewHTTPClientWithConfig(strfmt.Default, &cfg)
 	client.GrafanaAPIConfig = &cfg
 
@@ -278,6 +320,10 @@ func createGrafanaAppPlatformClient(client *common.Client, cfg ProviderConfig) e
 	client.GrafanaAppPlatformAPIClientID = appplatform.DefaultManagerIdentity
 	appPlatformTLSConfig, _ := tlsClientConfig.TLSConfig()
 	client.GrafanaHTTPClient = newGrafanaHTTPClient(appPlatformTLSConfig, userInfo, apiKey, client.GrafanaAPIConfig)
+	client.GrafanaHTTPClient.Transport = wrapTransport(cfg, limitTransport(cfg, client.GrafanaHTTPClient.Transport))
+	rcfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		return wrapTransport(cfg, limitTransport(cfg, rt))
+	}
 	client.GrafanaAppPlatformAPI = k8s.NewClientRegistry(rcfg, k8s.ClientConfig{
 		NegotiatedSerializerProvider: func(kind resource.Kind) runtime.NegotiatedSerializer {
 			return &k8s.KindNegotiatedSerializer{
@@ -347,7 +393,47 @@ func createOnCallClient(providerConfig ProviderConfig) (*onCallAPI.Client, error
 		// prefer OncallAccessToken if it was set, otherwise use Grafana auth (service account) token
 		authToken = providerConfig.Auth.ValueString()
 	}
-	return onCallAPI.NewWithGrafanaURL(providerConfig.OncallURL.ValueString(), authToken, providerConfig.URL.ValueString())
+	client, err := onCallAPI.NewWithGrafanaURL(providerConfig.OncallURL.ValueString(), authToken, providerConfig.URL.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	if err := wrapOnCallTransport(providerConfig, client); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// wrapOnCallTransport applies limitTransport and wrapTransport to the OnCall client. It can't be given an HTTP client: the transport
+// is wrapped in the unexported retryablehttp.Client it creates, found by its type. As its retries are around the guard, they stop
+// on the requests rejected in read-only mode.
+func wrapOnCallTransport(providerConfig ProviderConfig, client *onCallAPI.Client) error {
+	if !wrapsTransport(providerConfig) {
+		return nil
+	}
+	fields := reflect.ValueOf(client).Elem()
+	for i := range fields.NumField() {
+		field := fields.Field(i)
+		if field.Type() != reflect.TypeFor[*retryablehttp.Client]() || field.IsNil() {
+			continue
+		}
+		retryClient := reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().Interface().(*retryablehttp.Client)
+		if retryClient.HTTPClient == nil {
+			retryClient.HTTPClient = &http.Client{}
+		}
+		retryClient.HTTPClient.Transport = wrapTransport(providerConfig, limitTransport(providerConfig, retryClient.HTTPClient.Transport))
+		checkRetry := retryClient.CheckRetry
+		if checkRetry == nil {
+			checkRetry = retryablehttp.DefaultRetryPolicy
+		}
+		retryClient.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+			if errors.Is(err, common.ErrReadOnly) {
+				return false, err
+			}
+			return checkRetry(ctx, resp, err)
+		}
+		return nil
+	}
+	return errors.New("the HTTP client of the OnCall client wasn't found, the request limits, read_only and audit_log_path can't be applied to it")
 }
 
 func createCloudProviderClient(client *common.Client, providerConfig ProviderConfig) error {
@@ -491,7 +577,7 @@ func createK6Client(client *common.Client, providerConfig ProviderConfig) error
 	}
 	k6Cfg.UserAgent = providerConfig.UserAgent.ValueString()
 
-	k6Cfg.HTTPClient = getRetryClient(providerConfig)
+	k6Cfg.HTTPClient = stackTokenClient(getRetryClient(providerConfig), providerConfig.k6Token)
 
 	httpHeaders, err := getHTTPHeadersMap(providerConfig)
 	if err != nil {
@@ -547,6 +633,35 @@ func getHTTPHeadersMap(providerConfig ProviderConfig) (map[string]string, error)
 	return headers, nil
 }
 
+func getDefaultLabels(providerConfig ProviderConfig) (common.DefaultLabels, error) {
+	if len(providerConfig.DefaultLabels.Elements()) == 0 {
+		return nil, nil
+	}
+	labels := common.DefaultLabels{}
+	for k, v := range providerConfig.DefaultLabels.Elements() {
+		if vString, ok := v.(types.String); ok {
+			labels[k] = vString.ValueString()
+		} else {
+			return nil, fmt.Errorf("invalid default label value for %s: %v", k, v)
+		}
+	}
+
+	return labels, nil
+}
+
+func getDatasourceUIDMap(providerConfig ProviderConfig) (common.DatasourceUIDMap, error) {
+	uids := map[string]string{}
+	for k, v := range providerConfig.DatasourceUIDMap.Elements() {
+		if vString, ok := v.(types.String); ok {
+			uids[k] = vString.ValueString()
+		} else {
+			return nil, fmt.Errorf("invalid datasource UID for %s: %v", k, v)
+		}
+	}
+
+	return common.NewDatasourceUIDMap(uids)
+}
+
 func createAssertsClientIfConfigured(client *common.Client, providerConfig ProviderConfig) error {
 	if !providerConfig.Auth.IsNull() && !providerConfig.URL.IsNull() {
 		return createAssertsClient(client, providerConfig)
@@ -789,5 +904,71 @@ func getRetryClient(providerConfig ProviderConfig) *http.Client {
 		retryClient.RetryWaitMin = time.Second * time.Duration(wait)
 		retryClient.RetryWaitMax = time.Second * time.Duration(wait)
 	}
-	return retryClient.StandardClient()
+	// The limits apply to each attempt, the guard wraps the retries: the rejected requests aren't retried
+	retryClient.HTTPClient.Transport = limitTransport(providerConfig, retryClient.HTTPClient.Transport)
+	httpClient := retryClient.StandardClient()
+	httpClient.Transport = wrapTransport(providerConfig, httpClient.Transport)
+	return httpClient
+}
+
+// wrapsTransport returns whether limitTransport or wrapTransport change the transports, i.e. the request limits, the read-only mode or the audit log are enabled.
+func wrapsTransport(providerConfig ProviderConfig) bool {
+	return providerConfig.ReadOnly.ValueBool() || providerConfig.AuditLogPath.ValueString() != "" || providerConfig.requestLimiter != nil
+}
+
+// limitTransport wraps the transport of a client with the request limits, when they are set. It's applied within the retries of the client,
+// while wrapTransport is applied around them. It must be applied to every client, so that the coverage is the same for all resources.
+func limitTransport(providerConfig ProviderConfig, base http.RoundTripper) http.RoundTripper {
+	if providerConfig.requestLimiter == nil {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &requestLimiterRoundTripper{base: base, limiter: providerConfig.requestLimiter}
+}
+
+// wrapTransport wraps the transport of a client with the read-only mode and the audit log, when they are enabled.
+// It must be applied to every client, so that the coverage is the same for all resources.
+func wrapTransport(providerConfig ProviderConfig, base http.RoundTripper) http.RoundTripper {
+	if !providerConfig.ReadOnly.ValueBool() && providerConfig.AuditLogPath.ValueString() == "" {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if providerConfig.ReadOnly.ValueBool() {
+		base = &readOnlyRoundTripper{base: base}
+	}
+	if path := providerConfig.AuditLogPath.ValueString(); path != "" {
+		// The rejected requests are recorded too
+		base = &auditLogRoundTripper{
+			base:    base,
+			path:    path,
+			stackID: providerConfig.StackID.ValueInt64(),
+			orgID:   providerConfig.OrgID.ValueInt64(),
+		}
+	}
+	return base
+}
+
+// readOnlyRoundTripper only sends the GET and HEAD requests, the other ones fail with a common.ReadOnlyError.
+type readOnlyRoundTripper struct {
+	base http.RoundTripper
+}
+
+func (rt *readOnlyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isReadRequest(req) {
+		return rt.base.RoundTrip(req)
+	}
+
+	// A RoundTripper must close the body, even on errors
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return nil, &common.ReadOnlyError{
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		ResourceType: common.ResourceTypeFromContext(req.Context()),
+	}
 }
@@ -1,14 +1,20 @@
 package provider
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 
+	onCallAPI "github.com/grafana/amixr-api-go-client"
 	goapi "github.com/grafana/grafana-openapi-client-go/client"
+	"github.com/grafana/grafana-openapi-client-go/client/folders"
+	"github.com/grafana/grafana-openapi-client-go/models"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -235,6 +241,95 @@ func TestCreateClients(t *testing.T) {
 				assert.Equal(t, "http://localhost:3000", c.OnCallClient.GrafanaURL().String())
 			},
 		},
+		{
+			name: "Stack slug without Cloud access policy token",
+			config: ProviderConfig{
+				StackSlug: types.StringValue("mystack"),
+			},
+			expected: func(c *common.Client, err error) {
+				assert.EqualError(t, err, "stack_slug requires cloud_access_policy_token to be set")
+			},
+		},
+		{
+			name: "Stack slug with URL and auth",
+			config: ProviderConfig{
+				URL:                    types.StringValue("https://mystack.grafana.net"),
+				Auth:                   types.StringValue("myapikey"),
+				StackSlug:              types.StringValue("mystack"),
+				CloudAccessPolicyToken: types.StringValue("mytoken"),
+			},
+			expected: func(c *common.Client, err error) {
+				assert.EqualError(t, err, "stack_slug can't be used with url and auth, the URL of the stack and a temporary service account token are used instead")
+			},
+		},
+		{
+			name: "Stack slug in read-only mode",
+			config: ProviderConfig{
+				StackSlug:              types.StringValue("mystack"),
+				CloudAccessPolicyToken: types.StringValue("mytoken"),
+				ReadOnly:               types.BoolValue(true),
+			},
+			expected: func(c *common.Client, err error) {
+				assert.EqualError(t, err, "stack_slug can't be used with read_only, a service account is created in the stack. Set url and auth to a token with read permissions instead")
+			},
+		},
+		{
+			name: "Read-only mode",
+			config: ProviderConfig{
+				URL:      types.StringValue("http://localhost:3000"),
+				Auth:     types.StringValue("admin:admin"),
+				ReadOnly: types.BoolValue(true),
+			},
+			expected: func(c *common.Client, err error) {
+				assert.Nil(t, err)
+				assert.True(t, c.ReadOnly)
+				assert.NotNil(t, c.GrafanaAPIConfig.Client)
+				assert.IsType(t, &readOnlyRoundTripper{}, c.GrafanaAPIConfig.Client.Transport)
+				assert.IsType(t, &readOnlyRoundTripper{}, c.GrafanaHTTPClient.Transport)
+			},
+		},
+		{
+			name: "Default labels",
+			config: ProviderConfig{
+				URL:  types.StringValue("http://localhost:3000"),
+				Auth: types.StringValue("admin:admin"),
+				DefaultLabels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"managed-by": types.StringValue("terraform"),
+				}),
+			},
+			expected: func(c *common.Client, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, common.DefaultLabels{"managed-by": "terraform"}, c.DefaultLabels)
+			},
+		},
+		{
+			name: "Datasource UID map",
+			config: ProviderConfig{
+				URL:  types.StringValue("http://localhost:3000"),
+				Auth: types.StringValue("admin:admin"),
+				DatasourceUIDMap: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"prom-staging": types.StringValue("prom-prod"),
+				}),
+			},
+			expected: func(c *common.Client, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, common.DatasourceUIDMap{"prom-staging": "prom-prod"}, c.DatasourceUIDMap)
+			},
+		},
+		{
+			name: "Datasource UID map with a duplicate UID",
+			config: ProviderConfig{
+				URL:  types.StringValue("http://localhost:3000"),
+				Auth: types.StringValue("admin:admin"),
+				DatasourceUIDMap: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"prom-staging": types.StringValue("prom-prod"),
+					"prom-dev":     types.StringValue("prom-prod"),
+				}),
+			},
+			expected: func(c *common.Client, err error) {
+				assert.EqualError(t, err, `datasource_uid_map maps both "prom-dev" and "prom-staging" to "prom-prod", the references can't be reverted on read`)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -244,3 +339,124 @@ func TestCreateClients(t *testing.T) {
 		})
 	}
 }
+
+func TestReadOnlyRoundTripper(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	c, err := CreateClients(ProviderConfig{
+		URL:      types.StringValue(server.URL),
+		Auth:     types.StringValue("admin:admin"),
+		ReadOnly: types.BoolValue(true),
+	})
+	require.NoError(t, err)
+
+	// Reads are sent
+	_, err = c.GrafanaAPI.Folders.GetFolders(nil)
+	require.NoError(t, err)
+	_, err = c.GrafanaAPI.WithOrgID(2).Folders.GetFolders(nil)
+	require.NoError(t, err)
+
+	// Writes are rejected before reaching the server, and aren't retried
+	_, err = c.GrafanaAPI.Folders.CreateFolder(&models.CreateFolderCommand{Title: "test"})
+	require.ErrorIs(t, err, common.ErrReadOnly)
+	require.ErrorContains(t, err, "POST /api/folders: the provider is in read-only mode")
+	_, err = c.GrafanaAPI.WithOrgID(2).Folders.DeleteFolder(folders.NewDeleteFolderParams().WithFolderUID("test"))
+	require.ErrorIs(t, err, common.ErrReadOnly)
+	require.ErrorContains(t, err, "DELETE /api/folders/test: the provider is in read-only mode")
+
+	// The error names the resource sending the request
+	retryClient := getRetryClient(ProviderConfig{ReadOnly: types.BoolValue(true), Retries: types.Int64Value(3)})
+	ctx := common.ContextWithResourceType(context.Background(), "grafana_folder")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/folders", strings.NewReader("{}"))
+	require.NoError(t, err)
+	resp, err := retryClient.Do(req)
+	require.ErrorIs(t, err, common.ErrReadOnly)
+	require.Nil(t, resp)
+	var readOnlyErr *common.ReadOnlyError
+	require.ErrorAs(t, err, &readOnlyErr)
+	assert.Equal(t, "POST /api/folders (grafana_folder): the provider is in read-only mode (read_only = true or GRAFANA_READ_ONLY), write requests are rejected", readOnlyErr.Error())
+
+	require.Equal(t, []string{http.MethodGet, http.MethodGet}, methods)
+}
+
+func TestReadOnlyAlertingResources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/provisioning/policies" {
+			t.Errorf("unexpected %s %s request", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"receiver": "default"}`))
+	}))
+	defer server.Close()
+
+	c, err := CreateClients(ProviderConfig{
+		URL:      types.StringValue(server.URL),
+		Auth:     types.StringValue("admin:admin"),
+		ReadOnly: types.BoolValue(true),
+	})
+	require.NoError(t, err)
+
+	// The rejected writes are reported as errors rather than retried or asserted to be API responses
+	resources := ResourcesMap()
+	for name, values := range map[string]map[string]any{
+		"grafana_mute_timing":         {"name": "test"},
+		"grafana_notification_policy": {"contact_point": "test", "group_by": []any{"..."}},
+		"grafana_notification_policy_route": {
+			"contact_point": "test",
+			"matcher":       []any{map[string]any{"label": "team", "match": "=", "value": "test"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := resources[name].Schema
+			d := r.TestResourceData()
+			for k, v := range values {
+				require.NoError(t, d.Set(k, v))
+			}
+			diags := r.CreateContext(context.Background(), d, c)
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags[0].Summary, common.ErrReadOnly.Error())
+		})
+	}
+}
+
+func TestReadOnlyResourceClients(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"slug":"test","regionSlug":"us","hmInstancePromId":2,"hlInstanceId":3}`))
+	}))
+	defer server.Close()
+
+	c, err := CreateClients(ProviderConfig{
+		CloudAPIURL:            types.StringValue(server.URL),
+		CloudAccessPolicyToken: types.StringValue("cloud-token"),
+		OncallURL:              types.StringValue(server.URL),
+		OncallAccessToken:      types.StringValue("oncall-token"),
+		ReadOnly:               types.BoolValue(true),
+	})
+	require.NoError(t, err)
+
+	// The OnCall client has its own HTTP client, its transport is wrapped too
+	_, err = c.OnCallClient.EscalationChains.DeleteEscalationChain("test", &onCallAPI.DeleteEscalationChainOptions{})
+	require.ErrorContains(t, err, common.ErrReadOnly.Error())
+
+	// The SM client of the installation resource sends its requests with the HTTP client of the provider
+	installation := legacySDKResources()["grafana_synthetic_monitoring_installation"]
+	d := installation.TestResourceData()
+	require.NoError(t, d.Set("stack_id", "test"))
+	require.NoError(t, d.Set("metrics_publisher_key", "publisher-token"))
+	require.NoError(t, d.Set("stack_sm_api_url", server.URL))
+	diags := installation.CreateContext(context.Background(), d, c)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, common.ErrReadOnly.Error())
+
+	// Only the stack was read
+	require.Equal(t, []string{http.MethodGet}, methods)
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+// The `dashboard_lint` block is declared in both the SDKv2 and the Plugin Framework providers, the descriptions must be identical.
+const dashboardLintDescription = "Lints the dashboard models of `grafana_dashboard`, `grafana_dashboard_bundle` and of the `grafana_apps_dashboard_dashboard_*` resources at plan time. " +
+	"Each rule can be set to `error` (the plan fails), `warning` or `off`. Rules which are not set default to `warning`. " +
+	"The rules are not run by `terraform validate`, which does not configure the provider."
+
+func dashboardLintRuleDescription(rule common.DashboardLintRule) string {
+	return fmt.Sprintf("%s One of `off`, `warning` or `error`.", rule.Description)
+}
+
+func dashboardLintBlock() schema.Block {
+	attributes := map[string]schema.Attribute{}
+	for _, rule := range common.DashboardLintRules {
+		attributes[rule.Name] = schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: dashboardLintRuleDescription(rule),
+			Validators:          []validator.String{stringvalidator.OneOf(common.DashboardLintSeverities...)},
+		}
+	}
+	return schema.ListNestedBlock{
+		MarkdownDescription: dashboardLintDescription,
+		NestedObject:        schema.NestedBlockObject{Attributes: attributes},
+		Validators:          []validator.List{listvalidator.SizeAtMost(1)},
+	}
+}
+
+func legacyDashboardLintSchema() *sdkschema.Schema {
+	rules := map[string]*sdkschema.Schema{}
+	for _, rule := range common.DashboardLintRules {
+		rules[rule.Name] = &sdkschema.Schema{
+			Type:         sdkschema.TypeString,
+			Optional:     true,
+			Description:  dashboardLintRuleDescription(rule),
+			ValidateFunc: validation.StringInSlice(common.DashboardLintSeverities, false),
+		}
+	}
+	return &sdkschema.Schema{
+		Type:        sdkschema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: dashboardLintDescription,
+		Elem:        &sdkschema.Resource{Schema: rules},
+	}
+}
+
+func dashboardLintObjectType() types.ObjectType {
+	attrTypes := map[string]attr.Type{}
+	for _, rule := range common.DashboardLintRules {
+		attrTypes[rule.Name] = types.StringType
+	}
+	return types.ObjectType{AttrTypes: attrTypes}
+}
+
+// legacyDashboardLintValue converts the `dashboard_lint` block of the SDKv2 provider to its Plugin Framework value.
+func legacyDashboardLintValue(d *sdkschema.ResourceData) types.List {
+	blocks, ok := d.Get("dashboard_lint").([]any)
+	if !ok || len(blocks) == 0 {
+		return types.ListNull(dashboardLintObjectType())
+	}
+
+	block, _ := blocks[0].(map[string]any) // nil when all the rules are unset
+	values := map[string]attr.Value{}
+	for _, rule := range common.DashboardLintRules {
+		values[rule.Name] = types.StringNull()
+		if severity, _ := block[rule.Name].(string); severity != "" {
+			values[rule.Name] = types.StringValue(severity)
+		}
+	}
+	return types.ListValueMust(dashboardLintObjectType(), []attr.Value{
+		types.ObjectValueMust(dashboardLintObjectType().AttrTypes, values),
+	})
+}
+
+// dashboardLintConfig returns the severity of each rule, or nil when the `dashboard_lint` block is not set.
+func dashboardLintConfig(value types.List) common.DashboardLintConfig {
+	if value.IsNull() || value.IsUnknown() || len(value.Elements()) == 0 {
+		return nil
+	}
+
+	block, ok := value.Elements()[0].(types.Object)
+	if !ok {
+		return nil
+	}
+	config := common.DashboardLintConfig{}
+	for _, rule := range common.DashboardLintRules {
+		config[rule.Name] = common.DashboardLintWarning
+		if severity, ok := block.Attributes()[rule.Name].(types.String); ok && !severity.IsNull() && !severity.IsUnknown() {
+			config[rule.Name] = common.DashboardLintSeverity(severity.ValueString())
+		}
+	}
+	return config
+}
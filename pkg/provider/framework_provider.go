@@ -10,7 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,15 +29,26 @@ type ProviderConfig struct {
 	RetryStatusCodes types.Set    `tfsdk:"retry_status_codes"`
 	RetryWait        types.Int64  `tfsdk:"retry_wait"`
 
-	OrgID   types.Int64 `tfsdk:"org_id"`
-	StackID types.Int64 `tfsdk:"stack_id"`
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Int64 `tfsdk:"requests_per_second"`
+
+	OrgID                      types.Int64  `tfsdk:"org_id"`
+	StackID                    types.Int64  `tfsdk:"stack_id"`
+	StackSlug                  types.String `tfsdk:"stack_slug"`
+	StackSMMetricsPublisherKey types.String `tfsdk:"stack_sm_metrics_publisher_key"`
+	StackK6GrafanaUser         types.String `tfsdk:"stack_k6_grafana_user"`
 
 	TLSKey             types.String `tfsdk:"tls_key"`
 	TLSCert            types.String `tfsdk:"tls_cert"`
 	CACert             types.String `tfsdk:"ca_cert"`
 	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 
-	StoreDashboardSha256 types.Bool `tfsdk:"store_dashboard_sha256"`
+	StoreDashboardSha256 types.Bool   `tfsdk:"store_dashboard_sha256"`
+	DashboardLint        types.List   `tfsdk:"dashboard_lint"`
+	DefaultLabels        types.Map    `tfsdk:"default_labels"`
+	DatasourceUIDMap     types.Map    `tfsdk:"datasource_uid_map"`
+	ReadOnly             types.Bool   `tfsdk:"read_only"`
+	AuditLogPath         types.String `tfsdk:"audit_log_path"`
 
 	CloudAccessPolicyToken types.String `tfsdk:"cloud_access_policy_token"`
 	CloudAPIURL            types.String `tfsdk:"cloud_api_url"`
@@ -61,13 +74,35 @@ type ProviderConfig struct {
 	K6URL         types.String `tfsdk:"k6_url"`
 	K6AccessToken types.String `tfsdk:"k6_access_token"`
 
+	Profile      types.String `tfsdk:"profile"`
+	ProfilesFile types.String `tfsdk:"profiles_file"`
+
 	UserAgent types.String `tfsdk:"-"`
 	Version   types.String `tfsdk:"-"`
+
+	// requestLimiter is shared by the clients created by CreateClients, see getRequestLimiter
+	requestLimiter *requestLimiter
+	// smToken and k6Token create the SM and k6 tokens of `stack_slug` on first use, see stackTokenClient
+	smToken stackTokenFunc
+	k6Token stackTokenFunc
 }
 
+// SetDefaults sets the attributes which are not set in the provider block. The precedence order is:
+//  1. The attributes of the provider block
+//  2. The attributes of the profile selected with `profile` or GRAFANA_PROFILE
+//  3. The environment variables, e.g. GRAFANA_URL
+//  4. The default values
 func (c *ProviderConfig) SetDefaults() error {
 	var err error
 
+	c.Profile = envDefaultFuncString(c.Profile, "GRAFANA_PROFILE")
+	c.ProfilesFile = envDefaultFuncString(c.ProfilesFile, "GRAFANA_PROFILES_FILE")
+	if !c.Profile.IsNull() {
+		if err := c.applyProfile(); err != nil {
+			return err
+		}
+	}
+
 	c.URL = envDefaultFuncString(c.URL, "GRAFANA_URL")
 	c.Auth = envDefaultFuncString(c.Auth, "GRAFANA_AUTH")
 	c.TLSKey = envDefaultFuncString(c.TLSKey, "GRAFANA_TLS_KEY")
@@ -76,9 +111,18 @@ func (c *ProviderConfig) SetDefaults() error {
 	c.CloudAccessPolicyToken = envDefaultFuncString(c.CloudAccessPolicyToken, "GRAFANA_CLOUD_ACCESS_POLICY_TOKEN")
 	c.CloudAPIURL = envDefaultFuncString(c.CloudAPIURL, "GRAFANA_CLOUD_API_URL", "https://grafana.com")
 	c.SMAccessToken = envDefaultFuncString(c.SMAccessToken, "GRAFANA_SM_ACCESS_TOKEN")
-	c.SMURL = envDefaultFuncString(c.SMURL, "GRAFANA_SM_URL", "https://synthetic-monitoring-api.grafana.net")
+	c.StackSlug = envDefaultFuncString(c.StackSlug, "GRAFANA_STACK_SLUG")
+	c.StackSMMetricsPublisherKey = envDefaultFuncString(c.StackSMMetricsPublisherKey, "GRAFANA_STACK_SM_METRICS_PUBLISHER_KEY")
+	c.StackK6GrafanaUser = envDefaultFuncString(c.StackK6GrafanaUser, "GRAFANA_STACK_K6_GRAFANA_USER")
+	// With stack_slug, the SM and OnCall URLs default to the ones of the stack. See configureStackAuth.
+	var smURLDefault, oncallURLDefault []string
+	if c.StackSlug.IsNull() {
+		smURLDefault = []string{"https://synthetic-monitoring-api.grafana.net"}
+		oncallURLDefault = []string{"https://oncall-prod-us-central-0.grafana.net/oncall"}
+	}
+	c.SMURL = envDefaultFuncString(c.SMURL, "GRAFANA_SM_URL", smURLDefault...)
 	c.OncallAccessToken = envDefaultFuncString(c.OncallAccessToken, "GRAFANA_ONCALL_ACCESS_TOKEN")
-	c.OncallURL = envDefaultFuncString(c.OncallURL, "GRAFANA_ONCALL_URL", "https://oncall-prod-us-central-0.grafana.net/oncall")
+	c.OncallURL = envDefaultFuncString(c.OncallURL, "GRAFANA_ONCALL_URL", oncallURLDefault...)
 	c.CloudProviderAccessToken = envDefaultFuncString(c.CloudProviderAccessToken, "GRAFANA_CLOUD_PROVIDER_ACCESS_TOKEN")
 	c.CloudProviderURL = envDefaultFuncString(c.CloudProviderURL, "GRAFANA_CLOUD_PROVIDER_URL")
 	c.ConnectionsAPIAccessToken = envDefaultFuncString(c.ConnectionsAPIAccessToken, "GRAFANA_CONNECTIONS_API_ACCESS_TOKEN")
@@ -106,12 +150,22 @@ func (c *ProviderConfig) SetDefaults() error {
 	if c.StoreDashboardSha256, err = envDefaultFuncBool(c.StoreDashboardSha256, "GRAFANA_STORE_DASHBOARD_SHA256", false); err != nil {
 		return fmt.Errorf("failed to parse GRAFANA_STORE_DASHBOARD_SHA256: %w", err)
 	}
+	if c.ReadOnly, err = envDefaultFuncBool(c.ReadOnly, "GRAFANA_READ_ONLY", false); err != nil {
+		return fmt.Errorf("failed to parse GRAFANA_READ_ONLY: %w", err)
+	}
+	c.AuditLogPath = envDefaultFuncString(c.AuditLogPath, "GRAFANA_AUDIT_LOG_PATH")
 	if c.Retries, err = envDefaultFuncInt64(c.Retries, "GRAFANA_RETRIES", 3); err != nil {
 		return fmt.Errorf("failed to parse GRAFANA_RETRIES: %w", err)
 	}
 	if c.RetryWait, err = envDefaultFuncInt64(c.RetryWait, "GRAFANA_RETRY_WAIT", 0); err != nil {
 		return fmt.Errorf("failed to parse GRAFANA_RETRY_WAIT: %w", err)
 	}
+	if c.MaxConcurrentRequests, err = envDefaultFuncInt64(c.MaxConcurrentRequests, "GRAFANA_MAX_CONCURRENT_REQUESTS", 0); err != nil {
+		return fmt.Errorf("failed to parse GRAFANA_MAX_CONCURRENT_REQUESTS: %w", err)
+	}
+	if c.RequestsPerSecond, err = envDefaultFuncInt64(c.RequestsPerSecond, "GRAFANA_REQUESTS_PER_SECOND", 0); err != nil {
+		return fmt.Errorf("failed to parse GRAFANA_REQUESTS_PER_SECOND: %w", err)
+	}
 	if c.InsecureSkipVerify, err = envDefaultFuncBool(c.InsecureSkipVerify, "GRAFANA_INSECURE_SKIP_VERIFY", false); err != nil {
 		return fmt.Errorf("failed to parse GRAFANA_INSECURE_SKIP_VERIFY: %w", err)
 	}
@@ -128,6 +182,30 @@ func (c *ProviderConfig) SetDefaults() error {
 		c.HTTPHeaders = types.MapValueMust(types.StringType, headersValue)
 	}
 
+	if envValue := os.Getenv("GRAFANA_DEFAULT_LABELS"); c.DefaultLabels.IsNull() && envValue != "" {
+		labelsMap := make(map[string]string)
+		if err := json.Unmarshal([]byte(envValue), &labelsMap); err != nil {
+			return fmt.Errorf("failed to parse GRAFANA_DEFAULT_LABELS: %w", err)
+		}
+		labelsValue := map[string]attr.Value{}
+		for k, v := range labelsMap {
+			labelsValue[k] = types.StringValue(v)
+		}
+		c.DefaultLabels = types.MapValueMust(types.StringType, labelsValue)
+	}
+
+	if envValue := os.Getenv("GRAFANA_DATASOURCE_UID_MAP"); c.DatasourceUIDMap.IsNull() && envValue != "" {
+		uidMap := make(map[string]string)
+		if err := json.Unmarshal([]byte(envValue), &uidMap); err != nil {
+			return fmt.Errorf("failed to parse GRAFANA_DATASOURCE_UID_MAP: %w", err)
+		}
+		uidValue := map[string]attr.Value{}
+		for k, v := range uidMap {
+			uidValue[k] = types.StringValue(v)
+		}
+		c.DatasourceUIDMap = types.MapValueMust(types.StringType, uidValue)
+	}
+
 	if envValue := os.Getenv("GRAFANA_RETRY_STATUS_CODES"); c.RetryStatusCodes.IsNull() && envValue != "" {
 		retryStatusCodes := []attr.Value{}
 		for _, code := range strings.Split(envValue, ",") {
@@ -146,6 +224,8 @@ func (c *ProviderConfig) SetDefaults() error {
 	return nil
 }
 
+var _ provider.ProviderWithListResources = (*frameworkProvider)(nil)
+
 type frameworkProvider struct {
 	version string
 }
@@ -187,6 +267,14 @@ func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "The amount of time in seconds to wait between retries for Grafana API and Grafana Cloud API calls. May alternatively be set via the `GRAFANA_RETRY_WAIT` environment variable.",
 			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of concurrent requests sent to each API, e.g. to apply many dashboards in parallel without overloading Grafana. The APIs are told apart by their host: the Grafana API, the App Platform API and the plugin APIs of a stack share the same limit, the Grafana Cloud API and the Synthetic Monitoring API have their own. Each attempt of a request takes a slot until its response is received, the slot is released while waiting to retry. When neither `max_concurrent_requests` nor `requests_per_second` is set, the changes of folders and dashboards are serialized instead. Defaults to no limit. May alternatively be set via the `GRAFANA_MAX_CONCURRENT_REQUESTS` environment variable.",
+			},
+			"requests_per_second": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of requests per second sent to each API, see `max_concurrent_requests`. Defaults to no limit. May alternatively be set via the `GRAFANA_REQUESTS_PER_SECOND` environment variable.",
+			},
 			"org_id": schema.Int64Attribute{
 				Optional:            true,
 				MarkdownDescription: "The Grafana org ID, if you are using a self-hosted OSS or enterprise Grafana instance. May alternatively be set via the `GRAFANA_ORG_ID` environment variable.",
@@ -211,10 +299,49 @@ func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "Skip TLS certificate verification. May alternatively be set via the `GRAFANA_INSECURE_SKIP_VERIFY` environment variable.",
 			},
+			"stack_slug": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The slug of a Grafana Cloud stack to manage with the `cloud_access_policy_token`, instead of setting `url` and `auth`. The provider creates a temporary service account in the stack, with a token expiring after 4 hours, and deletes it when it exits. If the provider is killed, the service account is deleted by the next provider configured with the same stack once its token expired: the names of the service accounts start with `terraform-provider-stack-slug-`. The URL and ID of the stack, and its SM and OnCall URLs are used unless they are set. The SM and k6 tokens can be created too, see `stack_sm_metrics_publisher_key` and `stack_k6_grafana_user`. The access policy token needs the `stacks:read` and `stack-service-accounts:write` scopes. Can't be used with `read_only`. May alternatively be set via the `GRAFANA_STACK_SLUG` environment variable.",
+			},
+			"stack_sm_metrics_publisher_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: stackSMMetricsPublisherKeyDescription,
+			},
+			"stack_k6_grafana_user": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: stackK6GrafanaUserDescription,
+			},
 			"store_dashboard_sha256": schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: "Set to true if you want to save only the sha256sum instead of complete dashboard model JSON in the tfstate.",
 			},
+			"datasource_uid_map": schema.MapAttribute{
+				Optional:            true,
+				MarkdownDescription: "Maps the datasource UIDs of the configuration to the datasource UIDs of the Grafana instance, e.g. `{ \"prom-staging\" = \"prom-prod\" }`, so that the same dashboards and alert rules can be applied to instances whose datasources have different UIDs. The datasource references are rewritten when `grafana_dashboard`, `grafana_library_panel`, `grafana_rule_group`, the App Platform dashboards and the App Platform alert and recording rules are written, including the target datasource of the recording rules, and reverted when they are read, so that the state matches the configuration. Several UIDs can't be mapped to the same UID, and the configuration can't reference a UID which another UID is mapped to, e.g. `prom-prod`: it would be read as the other UID. May alternatively be set via the `GRAFANA_DATASOURCE_UID_MAP` environment variable in JSON format.",
+				ElementType:         types.StringType,
+			},
+			"default_labels": schema.MapAttribute{
+				Optional:            true,
+				MarkdownDescription: "Labels merged into the labels of the resources which have labels: `grafana_cloud_stack`, `grafana_synthetic_monitoring_check`, `grafana_slo` (`label` blocks), the rules of `grafana_rule_group`, the `remote_attributes` of `grafana_fleet_management_collector` and the `metadata.labels` of the App Platform resources. Dashboards get them as `key:value` tags in `config_json`. The labels set on a resource take precedence. The default labels aren't stored in the labels of the resources which don't set them, so they don't show in the diffs, like the `default_tags` of the AWS provider. All the labels of a resource are in its computed `labels_all` attribute (`tags_all` for dashboards, `remote_attributes_all` for collectors), and changing `default_labels` updates the resources. May alternatively be set via the `GRAFANA_DEFAULT_LABELS` environment variable in JSON format.",
+				ElementType:         types.StringType,
+			},
+			"audit_log_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path of a file to which a JSON line is appended for every request which could modify a resource, e.g. to know which Terraform run changed which resource. Each record has the time, the HTTP method, host and path, the response status or error, the stack and org IDs, the request body with the values of the sensitive keys redacted and, when they're known, the Terraform resource type and resource ID. Terraform doesn't send the resource addresses to the providers: the type and ID tell the resources apart, except on create where the ID isn't known yet. The OnCall client doesn't send the context of the resources: its records have no resource type or ID. May alternatively be set via the `GRAFANA_AUDIT_LOG_PATH` environment variable.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of a profile of the `profiles_file` to configure the provider with, e.g. to share the URLs and credentials of a stack between Terraform configurations. The attributes of the provider block override the values of the profile, which override the environment variables. May alternatively be set via the `GRAFANA_PROFILE` environment variable.",
+			},
+			"profiles_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The YAML file defining the profiles, as a `profiles` map of profile names to provider attributes, e.g. `url`, `auth` or `sm_url`. Defaults to `~/.config/grafana/terraform.yaml` (or `$XDG_CONFIG_HOME/grafana/terraform.yaml`). May alternatively be set via the `GRAFANA_PROFILES_FILE` environment variable.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set to true to reject every request which could modify a resource, e.g. to safely run `terraform plan` with production credentials. Only GET and HEAD requests are sent, the other requests fail with an error. May alternatively be set via the `GRAFANA_READ_ONLY` environment variable.",
+			},
 			"cloud_access_policy_token": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
@@ -288,6 +415,9 @@ func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				MarkdownDescription: "The k6 Cloud API token. May alternatively be set via the `GRAFANA_K6_ACCESS_TOKEN` environment variable.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"dashboard_lint": dashboardLintBlock(),
+		},
 	}
 }
 
@@ -314,6 +444,8 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 
 	resp.ResourceData = clients
 	resp.DataSourceData = clients
+	resp.EphemeralResourceData = clients
+	resp.ListResourceData = clients
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -326,10 +458,22 @@ func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resou
 	return pluginFrameworkResources()
 }
 
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return pluginFrameworkEphemeralResources()
+}
+
+// ListResources defines the list resources implemented in the provider, used by `terraform query`.
+func (p *frameworkProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return pluginFrameworkListResources()
+}
+
 // Functions defines the functions implemented in the provider.
 func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		functions.NewK6BundleFunction,
+		functions.NewJsonnetFunction,
+		functions.NewPrometheusRulesToRuleGroupsFunction,
 	}
 }
 
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+var (
+	_ resource.ResourceWithConfigure        = (*frameworkResourceWithContext)(nil)
+	_ resource.ResourceWithConfigValidators = (*frameworkResourceWithContext)(nil)
+	_ resource.ResourceWithImportState      = (*frameworkResourceWithContext)(nil)
+	_ resource.ResourceWithModifyPlan       = (*frameworkResourceWithContext)(nil)
+	_ resource.ResourceWithMoveState        = (*frameworkResourceWithContext)(nil)
+	_ resource.ResourceWithUpgradeState     = (*frameworkResourceWithContext)(nil)
+	_ resource.ResourceWithValidateConfig   = (*frameworkResourceWithContext)(nil)
+)
+
+// frameworkResourceWithContext is withResourceContext for the Plugin Framework resources: the CRUD functions get
+// a context carrying the resource type, the resource ID and the default labels of the client it's configured with. The optional interfaces
+// of the wrapped resource are forwarded, and behave like the framework does when the wrapped resource doesn't implement them.
+type frameworkResourceWithContext struct {
+	resource.Resource
+	name   string
+	client *common.Client
+}
+
+func withFrameworkResourceContext(name string, r resource.Resource) resource.Resource {
+	return &frameworkResourceWithContext{Resource: r, name: name}
+}
+
+func (r *frameworkResourceWithContext) resourceContext(ctx context.Context, id string) context.Context {
+	ctx = common.ContextWithResourceType(ctx, r.name)
+	ctx = common.ContextWithResourceID(ctx, id)
+	if r.client != nil {
+		ctx = common.ContextWithDefaultLabels(ctx, r.client.DefaultLabels)
+	}
+	return ctx
+}
+
+// stateID returns the `id` attribute of the state, or an empty string when there's no state or no such attribute.
+func stateID(ctx context.Context, state tfsdk.State) string {
+	var id types.String
+	if state.Raw.IsNull() || state.GetAttribute(ctx, path.Root("id"), &id).HasError() {
+		return ""
+	}
+	return id.ValueString()
+}
+
+func (r *frameworkResourceWithContext) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.Resource.Create(r.resourceContext(ctx, ""), req, resp)
+}
+
+func (r *frameworkResourceWithContext) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.Resource.Read(r.resourceContext(ctx, stateID(ctx, req.State)), req, resp)
+}
+
+func (r *frameworkResourceWithContext) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.Resource.Update(r.resourceContext(ctx, stateID(ctx, req.State)), req, resp)
+}
+
+func (r *frameworkResourceWithContext) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.Resource.Delete(r.resourceContext(ctx, stateID(ctx, req.State)), req, resp)
+}
+
+func (r *frameworkResourceWithContext) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(*common.Client); ok {
+		r.client = client
+	}
+	if c, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		c.Configure(ctx, req, resp)
+	}
+}
+
+func (r *frameworkResourceWithContext) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if c, ok := r.Resource.(resource.ResourceWithConfigValidators); ok {
+		return c.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+func (r *frameworkResourceWithContext) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	i, ok := r.Resource.(resource.ResourceWithImportState)
+	if !ok {
+		// Same error as the framework for the resources without import
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+		return
+	}
+	i.ImportState(r.resourceContext(ctx, req.ID), req, resp)
+}
+
+func (r *frameworkResourceWithContext) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if m, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		m.ModifyPlan(r.resourceContext(ctx, stateID(ctx, req.State)), req, resp)
+	}
+}
+
+func (r *frameworkResourceWithContext) MoveState(ctx context.Context) []resource.StateMover {
+	if m, ok := r.Resource.(resource.ResourceWithMoveState); ok {
+		return m.MoveState(ctx)
+	}
+	return nil
+}
+
+func (r *frameworkResourceWithContext) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if u, ok := r.Resource.(resource.ResourceWithUpgradeState); ok {
+		return u.UpgradeState(ctx)
+	}
+	return nil
+}
+
+func (r *frameworkResourceWithContext) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if v, ok := r.Resource.(resource.ResourceWithValidateConfig); ok {
+		v.ValidateConfig(ctx, req, resp)
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+// contextRecordingResource records the resource type, the resource ID and the default labels of the context of its CRUD functions.
+type contextRecordingResource struct {
+	resource.Resource
+	resourceTypes []string
+	resourceIDs   []string
+	defaultLabels common.DefaultLabels
+}
+
+func (r *contextRecordingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{Attributes: map[string]schema.Attribute{"id": schema.StringAttribute{Computed: true}}}
+}
+
+func (r *contextRecordingResource) Create(ctx context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) {
+	r.resourceTypes = append(r.resourceTypes, common.ResourceTypeFromContext(ctx))
+	r.resourceIDs = append(r.resourceIDs, common.ResourceIDFromContext(ctx))
+	r.defaultLabels = common.DefaultLabelsFromContext(ctx)
+}
+
+func (r *contextRecordingResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	r.resourceTypes = append(r.resourceTypes, common.ResourceTypeFromContext(ctx))
+	r.resourceIDs = append(r.resourceIDs, common.ResourceIDFromContext(ctx))
+}
+
+func TestFrameworkResourceContext(t *testing.T) {
+	inner := &contextRecordingResource{}
+	r := withFrameworkResourceContext("grafana_test", inner)
+
+	// The ID comes from the state, there's none on create
+	resp := &resource.SchemaResponse{}
+	inner.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	state := tfsdk.State{Schema: resp.Schema, Raw: tftypes.NewValue(
+		tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}},
+		map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "1:test")},
+	)}
+	r.Create(context.Background(), resource.CreateRequest{}, &resource.CreateResponse{})
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, &resource.DeleteResponse{})
+	assert.Equal(t, []string{"grafana_test", "grafana_test"}, inner.resourceTypes)
+	assert.Equal(t, []string{"", "1:test"}, inner.resourceIDs)
+	assert.Nil(t, inner.defaultLabels)
+
+	// The default labels come from the client the resource is configured with
+	defaultLabels := common.DefaultLabels{"team": "a"}
+	r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{ProviderData: &common.Client{DefaultLabels: defaultLabels}}, &resource.ConfigureResponse{})
+	r.Create(context.Background(), resource.CreateRequest{}, &resource.CreateResponse{})
+	assert.Equal(t, defaultLabels, inner.defaultLabels)
+
+	// The optional interfaces which the resource doesn't implement behave like in the framework
+	importResp := &resource.ImportStateResponse{}
+	r.(resource.ResourceWithImportState).ImportState(context.Background(), resource.ImportStateRequest{ID: "test"}, importResp)
+	assert.True(t, importResp.Diagnostics.HasError())
+	assert.Equal(t, "Resource Import Not Implemented", importResp.Diagnostics[0].Summary())
+	assert.Nil(t, r.(resource.ResourceWithUpgradeState).UpgradeState(context.Background()))
+}
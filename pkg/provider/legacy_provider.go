@@ -72,6 +72,16 @@ func Provider(version string) *schema.Provider {
 				Optional:    true,
 				Description: "The amount of time in seconds to wait between retries for Grafana API and Grafana Cloud API calls. May alternatively be set via the `GRAFANA_RETRY_WAIT` environment variable.",
 			},
+			"max_concurrent_requests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum number of concurrent requests sent to each API, e.g. to apply many dashboards in parallel without overloading Grafana. The APIs are told apart by their host: the Grafana API, the App Platform API and the plugin APIs of a stack share the same limit, the Grafana Cloud API and the Synthetic Monitoring API have their own. Each attempt of a request takes a slot until its response is received, the slot is released while waiting to retry. When neither `max_concurrent_requests` nor `requests_per_second` is set, the changes of folders and dashboards are serialized instead. Defaults to no limit. May alternatively be set via the `GRAFANA_MAX_CONCURRENT_REQUESTS` environment variable.",
+			},
+			"requests_per_second": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum number of requests per second sent to each API, see `max_concurrent_requests`. Defaults to no limit. May alternatively be set via the `GRAFANA_REQUESTS_PER_SECOND` environment variable.",
+			},
 			"org_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -128,11 +138,60 @@ func Provider(version string) *schema.Provider {
 				Description:  "Synthetic monitoring backend address. May alternatively be set via the `GRAFANA_SM_URL` environment variable. The correct value for each service region is cited in the [Synthetic Monitoring documentation](https://grafana.com/docs/grafana-cloud/testing/synthetic-monitoring/set-up/set-up-private-probes/#probe-api-server-url). Note the `sm_url` value is optional, but it must correspond with the value specified as the `region_slug` in the `grafana_cloud_stack` resource. Also note that when a Terraform configuration contains multiple provider instances managing SM resources associated with the same Grafana stack, specifying an explicit `sm_url` set to the same value for each provider ensures all providers interact with the same SM API.",
 				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
+			"stack_slug": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The slug of a Grafana Cloud stack to manage with the `cloud_access_policy_token`, instead of setting `url` and `auth`. The provider creates a temporary service account in the stack, with a token expiring after 4 hours, and deletes it when it exits. If the provider is killed, the service account is deleted by the next provider configured with the same stack once its token expired: the names of the service accounts start with `terraform-provider-stack-slug-`. The URL and ID of the stack, and its SM and OnCall URLs are used unless they are set. The SM and k6 tokens can be created too, see `stack_sm_metrics_publisher_key` and `stack_k6_grafana_user`. The access policy token needs the `stacks:read` and `stack-service-accounts:write` scopes. Can't be used with `read_only`. May alternatively be set via the `GRAFANA_STACK_SLUG` environment variable.",
+			},
+			"stack_sm_metrics_publisher_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: stackSMMetricsPublisherKeyDescription,
+			},
+			"stack_k6_grafana_user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: stackK6GrafanaUserDescription,
+			},
 			"store_dashboard_sha256": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "Set to true if you want to save only the sha256sum instead of complete dashboard model JSON in the tfstate.",
 			},
+			"dashboard_lint": legacyDashboardLintSchema(),
+			"datasource_uid_map": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Maps the datasource UIDs of the configuration to the datasource UIDs of the Grafana instance, e.g. `{ \"prom-staging\" = \"prom-prod\" }`, so that the same dashboards and alert rules can be applied to instances whose datasources have different UIDs. The datasource references are rewritten when `grafana_dashboard`, `grafana_library_panel`, `grafana_rule_group`, the App Platform dashboards and the App Platform alert and recording rules are written, including the target datasource of the recording rules, and reverted when they are read, so that the state matches the configuration. Several UIDs can't be mapped to the same UID, and the configuration can't reference a UID which another UID is mapped to, e.g. `prom-prod`: it would be read as the other UID. May alternatively be set via the `GRAFANA_DATASOURCE_UID_MAP` environment variable in JSON format.",
+			},
+			"default_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels merged into the labels of the resources which have labels: `grafana_cloud_stack`, `grafana_synthetic_monitoring_check`, `grafana_slo` (`label` blocks), the rules of `grafana_rule_group`, the `remote_attributes` of `grafana_fleet_management_collector` and the `metadata.labels` of the App Platform resources. Dashboards get them as `key:value` tags in `config_json`. The labels set on a resource take precedence. The default labels aren't stored in the labels of the resources which don't set them, so they don't show in the diffs, like the `default_tags` of the AWS provider. All the labels of a resource are in its computed `labels_all` attribute (`tags_all` for dashboards, `remote_attributes_all` for collectors), and changing `default_labels` updates the resources. May alternatively be set via the `GRAFANA_DEFAULT_LABELS` environment variable in JSON format.",
+			},
+			"audit_log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a file to which a JSON line is appended for every request which could modify a resource, e.g. to know which Terraform run changed which resource. Each record has the time, the HTTP method, host and path, the response status or error, the stack and org IDs, the request body with the values of the sensitive keys redacted and, when they're known, the Terraform resource type and resource ID. Terraform doesn't send the resource addresses to the providers: the type and ID tell the resources apart, except on create where the ID isn't known yet. The OnCall client doesn't send the context of the resources: its records have no resource type or ID. May alternatively be set via the `GRAFANA_AUDIT_LOG_PATH` environment variable.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of a profile of the `profiles_file` to configure the provider with, e.g. to share the URLs and credentials of a stack between Terraform configurations. The attributes of the provider block override the values of the profile, which override the environment variables. May alternatively be set via the `GRAFANA_PROFILE` environment variable.",
+			},
+			"profiles_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The YAML file defining the profiles, as a `profiles` map of profile names to provider attributes, e.g. `url`, `auth` or `sm_url`. Defaults to `~/.config/grafana/terraform.yaml` (or `$XDG_CONFIG_HOME/grafana/terraform.yaml`). May alternatively be set via the `GRAFANA_PROFILES_FILE` environment variable.",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to true to reject every request which could modify a resource, e.g. to safely run `terraform plan` with production credentials. Only GET and HEAD requests are sent, the other requests fail with an error. May alternatively be set via the `GRAFANA_READ_ONLY` environment variable.",
+			},
 
 			"oncall_access_token": {
 				Type:        schema.TypeString,
@@ -243,6 +302,7 @@ func Provider(version string) *schema.Provider {
 		}
 	}
 
+	withClientValidators(p)
 	p.ConfigureContextFunc = configure(version, p)
 
 	return p
@@ -260,6 +320,24 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			headers = types.MapValueMust(types.StringType, headersValue)
 		}
 
+		defaultLabels := types.MapNull(types.StringType)
+		if v, ok := d.GetOk("default_labels"); ok {
+			labelsValue := map[string]attr.Value{}
+			for k, v := range v.(map[string]any) {
+				labelsValue[k] = types.StringValue(v.(string))
+			}
+			defaultLabels = types.MapValueMust(types.StringType, labelsValue)
+		}
+
+		datasourceUIDMap := types.MapNull(types.StringType)
+		if v, ok := d.GetOk("datasource_uid_map"); ok {
+			uidValue := map[string]attr.Value{}
+			for k, v := range v.(map[string]any) {
+				uidValue[k] = types.StringValue(v.(string))
+			}
+			datasourceUIDMap = types.MapValueMust(types.StringType, uidValue)
+		}
+
 		statusCodes := types.SetNull(types.StringType)
 		if v, ok := d.GetOk("retry_status_codes"); ok {
 			statusCodesValue := []attr.Value{}
@@ -295,10 +373,22 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			K6URL:                      stringValueOrNull(d, "k6_url"),
 			K6AccessToken:              stringValueOrNull(d, "k6_access_token"),
 			StoreDashboardSha256:       boolValueOrNull(d, "store_dashboard_sha256"),
+			DashboardLint:              legacyDashboardLintValue(d),
+			ReadOnly:                   boolValueOrNull(d, "read_only"),
+			StackSlug:                  stringValueOrNull(d, "stack_slug"),
+			StackSMMetricsPublisherKey: stringValueOrNull(d, "stack_sm_metrics_publisher_key"),
+			StackK6GrafanaUser:         stringValueOrNull(d, "stack_k6_grafana_user"),
+			Profile:                    stringValueOrNull(d, "profile"),
+			ProfilesFile:               stringValueOrNull(d, "profiles_file"),
+			AuditLogPath:               stringValueOrNull(d, "audit_log_path"),
 			HTTPHeaders:                headers,
+			DefaultLabels:              defaultLabels,
+			DatasourceUIDMap:           datasourceUIDMap,
 			Retries:                    int64ValueOrNull(d, "retries"),
 			RetryStatusCodes:           statusCodes,
 			RetryWait:                  types.Int64Value(int64(d.Get("retry_wait").(int))),
+			MaxConcurrentRequests:      int64ValueOrNull(d, "max_concurrent_requests"),
+			RequestsPerSecond:          int64ValueOrNull(d, "requests_per_second"),
 			UserAgent:                  types.StringValue(p.UserAgent("terraform-provider-grafana", version)),
 			Version:                    types.StringValue(version),
 		}
@@ -0,0 +1,402 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	fwpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/cloud"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/grafana"
+)
+
+var (
+	_ list.ListResourceWithConfigure      = (*listResource)(nil)
+	_ list.ListResourceWithRawV5Schemas   = (*listResource)(nil)
+	_ list.ListResourceWithValidateConfig = (*listResource)(nil)
+)
+
+// listedResource reads the objects found by the lister of a list resource, with the SDKv2 or Plugin Framework resource of their type.
+type listedResource interface {
+	// stateType returns the type of the state of the resource, which tells the filters that apply to it.
+	stateType(ctx context.Context) tftypes.Object
+	// read imports and reads the object with the given ID, like `terraform import` does. The state is null if the object doesn't exist.
+	read(ctx context.Context, client *common.Client, id string) (state, identity tftypes.Value, diags fwdiag.Diagnostics)
+	// rawV5Schemas sets the schemas of the resource when it's not served by the framework provider.
+	rawV5Schemas(ctx context.Context, resp *list.RawV5SchemaResponse)
+}
+
+// listResource is the list resource (`terraform query`) of a resource with a lister. The IDs returned by the lister are read
+// with the resource to apply the filters, and to return their identity and, if requested, their state. The listers which support
+// the filters apply them first, see listerData.
+type listResource struct {
+	resource *common.Resource
+	listed   listedResource
+	client   *common.Client
+}
+
+type listResourceConfig struct {
+	Name   types.String `tfsdk:"name"`
+	Folder types.String `tfsdk:"folder"`
+	Tag    types.String `tfsdk:"tag"`
+	Org    types.String `tfsdk:"org"`
+}
+
+func newListResource(r *common.Resource) list.ListResource {
+	var listed listedResource
+	if r.Schema != nil {
+		listed = &sdkListedResource{resource: withResourceContext(r.Name, withResourceIdentity(r.IDType, r.Schema))}
+	} else {
+		_, importable := r.PluginFrameworkSchema.(resource.ResourceWithImportState)
+		listed = &frameworkListedResource{newResource: newFrameworkResource(r), importable: importable}
+	}
+	return &listResource{resource: r, listed: listed}
+}
+
+func (r *listResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.resource.Name
+}
+
+// ListResourceConfigSchema only has the filters which apply to the resource: Terraform rejects the others.
+func (r *listResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	attributes := map[string]listschema.Attribute{
+		"name": listschema.StringAttribute{
+			Optional:    true,
+			Description: "Only list the objects whose name matches this glob pattern, e.g. `prod-*`. The name is the ID of the objects which have no name or title.",
+		},
+	}
+	stateType := r.listed.stateType(ctx)
+	if folderAttribute(stateType) != "" {
+		attributes["folder"] = listschema.StringAttribute{
+			Optional:    true,
+			Description: "Only list the objects in the folder with this UID.",
+		}
+	}
+	if tagsAttribute(stateType) != "" {
+		attributes["tag"] = listschema.StringAttribute{
+			Optional:    true,
+			Description: "Only list the objects with this tag.",
+		}
+	}
+	if usesCloudListerData(r.resource) {
+		attributes["org"] = listschema.StringAttribute{
+			Optional:    true,
+			Description: "The slug of the Grafana Cloud organization. Required to list the objects which belong to an organization, e.g. access policies and organization members.",
+		}
+	}
+	resp.Schema = listschema.Schema{
+		Description: fmt.Sprintf("Lists the existing `%s` objects.", r.resource.Name),
+		Attributes:  attributes,
+	}
+}
+
+func (r *listResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(*common.Client); ok {
+		r.client = client
+	}
+}
+
+func (r *listResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	r.listed.rawV5Schemas(ctx, resp)
+}
+
+func (r *listResource) ValidateListResourceConfig(ctx context.Context, req list.ValidateConfigRequest, resp *list.ValidateConfigResponse) {
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, fwpath.Root("name"), &name)...)
+	if name.IsNull() || name.IsUnknown() {
+		return
+	}
+	if _, err := path.Match(name.ValueString(), ""); err != nil {
+		resp.Diagnostics.AddAttributeError(fwpath.Root("name"), "Invalid name pattern", fmt.Sprintf("%q is not a valid glob pattern: %v", name.ValueString(), err))
+	}
+}
+
+func (r *listResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	config, diags := r.config(ctx, req.Config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if r.client == nil {
+		diags.AddError("Unconfigured provider", fmt.Sprintf("The provider must be configured to list the %s objects.", r.resource.Name))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	ids, err := r.resource.ListIDsFunc(ctx, r.client, listerData(r.resource, config))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to list the %s objects", r.resource.Name), err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stateType := r.listed.stateType(ctx)
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, id := range ids {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+
+			state, identity, diags := r.listed.read(ctx, r.client, id)
+			if diags.HasError() {
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+			if state.IsNull() {
+				continue // Deleted since it was listed
+			}
+			var attributes map[string]tftypes.Value
+			if err := state.As(&attributes); err != nil {
+				diags.AddError(fmt.Sprintf("Failed to read %s %q", r.resource.Name, id), err.Error())
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			displayName := r.displayName(id, attributes)
+			if !config.matches(stateType, displayName, attributes) {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = displayName
+			result.Diagnostics.Append(diags...)
+			result.Identity.Raw = identity
+			if req.IncludeResource {
+				result.Resource.Raw = state
+			}
+			count++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func (r *listResource) config(ctx context.Context, config tfsdk.Config) (listResourceConfig, fwdiag.Diagnostics) {
+	var cfg listResourceConfig
+	var diags fwdiag.Diagnostics
+	if config.Schema == nil {
+		return cfg, diags
+	}
+	for name, value := range map[string]*types.String{"name": &cfg.Name, "folder": &cfg.Folder, "tag": &cfg.Tag, "org": &cfg.Org} {
+		if _, ok := config.Schema.GetAttributes()[name]; ok {
+			diags.Append(config.GetAttribute(ctx, fwpath.Root(name), value)...)
+		}
+	}
+	return cfg, diags
+}
+
+// displayName returns the name of the object: its preferred resource name field (see common.Resource.PreferredResourceNameField),
+// its name or its title, or its ID.
+func (r *listResource) displayName(id string, attributes map[string]tftypes.Value) string {
+	for _, name := range []string{r.resource.PreferredResourceNameField, "name", "title"} {
+		if name == "" {
+			continue
+		}
+		if value := stringValue(attributes[name]); value != "" {
+			return value
+		}
+	}
+	return id
+}
+
+func (c listResourceConfig) matches(stateType tftypes.Object, displayName string, attributes map[string]tftypes.Value) bool {
+	if pattern := c.Name.ValueString(); pattern != "" {
+		if matched, _ := path.Match(pattern, displayName); !matched {
+			return false
+		}
+	}
+	if folder := c.Folder.ValueString(); folder != "" && stringValue(attributes[folderAttribute(stateType)]) != folder {
+		return false
+	}
+	if tag := c.Tag.ValueString(); tag != "" {
+		var tags []tftypes.Value
+		if err := attributes[tagsAttribute(stateType)].As(&tags); err != nil {
+			return false
+		}
+		for _, t := range tags {
+			if stringValue(t) == tag {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// folderAttribute returns the attribute with the UID of the folder of the objects, or an empty string if they aren't in folders.
+func folderAttribute(stateType tftypes.Object) string {
+	for _, name := range []string{"folder", "folder_uid"} {
+		if t, ok := stateType.AttributeTypes[name]; ok && t.Is(tftypes.String) {
+			return name
+		}
+	}
+	return ""
+}
+
+// tagsAttribute returns the attribute with the tags of the objects, or an empty string if they have no tags.
+// `tags_all` is preferred to `tags`, since it also has the tags added by the provider (see common.DefaultLabels).
+func tagsAttribute(stateType tftypes.Object) string {
+	for _, name := range []string{"tags_all", "tags"} {
+		if t, ok := stateType.AttributeTypes[name]; ok && (t.Equal(tftypes.List{ElementType: tftypes.String}) || t.Equal(tftypes.Set{ElementType: tftypes.String})) {
+			return name
+		}
+	}
+	return ""
+}
+
+func stringValue(v tftypes.Value) string {
+	var s string
+	if !v.IsKnown() || v.IsNull() || !v.Type().Is(tftypes.String) || v.As(&s) != nil {
+		return ""
+	}
+	return s
+}
+
+// usesCloudListerData returns whether the lister of the resource takes a cloud.ListerData, like in cmd/generate.
+func usesCloudListerData(r *common.Resource) bool {
+	return r.Category == common.CategoryCloud || r.Category == common.CategoryFrontendO11y
+}
+
+// listerData returns the data given to the lister of the resource (see common.ResourceListIDsFunc). The Grafana resources
+// are listed in the organization of the provider, the filters are given to their listers which support them.
+func listerData(r *common.Resource, config listResourceConfig) any {
+	if usesCloudListerData(r) {
+		return cloud.NewListerData(config.Org.ValueString())
+	}
+	return grafana.NewListerData(true, true).WithFilter(grafana.ListerFilter{
+		Name:   config.Name.ValueString(),
+		Folder: config.Folder.ValueString(),
+		Tag:    config.Tag.ValueString(),
+	})
+}
+
+// sdkListedResource reads the listed objects with an SDKv2 resource.
+type sdkListedResource struct {
+	resource *schema.Resource
+}
+
+func (r *sdkListedResource) stateType(ctx context.Context) tftypes.Object {
+	return r.resource.ProtoSchema(ctx)().ValueType().(tftypes.Object)
+}
+
+func (r *sdkListedResource) read(ctx context.Context, client *common.Client, id string) (tftypes.Value, tftypes.Value, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+	failed := func(err error) (tftypes.Value, tftypes.Value, fwdiag.Diagnostics) {
+		diags.AddError(fmt.Sprintf("Failed to read %q", id), err.Error())
+		return tftypes.Value{}, tftypes.Value{}, diags
+	}
+
+	d := r.resource.Data(nil)
+	d.SetId(id)
+	if r.resource.Importer != nil && r.resource.Importer.StateContext != nil {
+		imported, err := r.resource.Importer.StateContext(ctx, d, client)
+		if err != nil {
+			return failed(err)
+		}
+		if len(imported) == 0 {
+			return failed(fmt.Errorf("the importer returned no object"))
+		}
+		d = imported[0]
+	}
+
+	for _, diagnostic := range r.resource.ReadContext(ctx, d, client) {
+		if diagnostic.Severity == diag.Error {
+			diags.AddError(diagnostic.Summary, diagnostic.Detail)
+		} else {
+			diags.AddWarning(diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+	if diags.HasError() {
+		return tftypes.Value{}, tftypes.Value{}, diags
+	}
+	if d.Id() == "" {
+		return tftypes.NewValue(r.stateType(ctx), nil), tftypes.Value{}, diags
+	}
+
+	state, err := d.TfTypeResourceState()
+	if err != nil {
+		return failed(err)
+	}
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		return failed(err)
+	}
+	return *state, *identity, diags
+}
+
+func (r *sdkListedResource) rawV5Schemas(ctx context.Context, resp *list.RawV5SchemaResponse) {
+	resp.ProtoV5Schema = r.resource.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = r.resource.ProtoIdentitySchema(ctx)()
+}
+
+// frameworkListedResource reads the listed objects with a Plugin Framework resource.
+type frameworkListedResource struct {
+	newResource func() resource.Resource
+	// importable is whether the resource implements ImportState: the wrapped resources always do, see frameworkResourceWithContext
+	importable bool
+}
+
+func (r *frameworkListedResource) schemas(ctx context.Context, res resource.Resource) (tfsdk.State, *tfsdk.ResourceIdentity) {
+	schemaResp := resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	identitySchemaResp := resource.IdentitySchemaResponse{}
+	if i, ok := res.(resource.ResourceWithIdentity); ok {
+		i.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+	}
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchemaResp.IdentitySchema,
+		Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+	return state, identity
+}
+
+func (r *frameworkListedResource) stateType(ctx context.Context) tftypes.Object {
+	state, _ := r.schemas(ctx, r.newResource())
+	return state.Raw.Type().(tftypes.Object)
+}
+
+func (r *frameworkListedResource) read(ctx context.Context, client *common.Client, id string) (tftypes.Value, tftypes.Value, fwdiag.Diagnostics) {
+	res := r.newResource()
+	configureResp := resource.ConfigureResponse{}
+	if c, ok := res.(resource.ResourceWithConfigure); ok {
+		c.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &configureResp)
+	}
+	if configureResp.Diagnostics.HasError() {
+		return tftypes.Value{}, tftypes.Value{}, configureResp.Diagnostics
+	}
+
+	state, identity := r.schemas(ctx, res)
+	importResp := resource.ImportStateResponse{State: state, Identity: identity}
+	if r.importable {
+		res.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: id}, &importResp)
+	} else {
+		// Like for the SDKv2 resources without importer, the object is read from its ID
+		importResp.Diagnostics.Append(importResp.State.SetAttribute(ctx, fwpath.Root("id"), id)...)
+	}
+	if importResp.Diagnostics.HasError() {
+		return tftypes.Value{}, tftypes.Value{}, importResp.Diagnostics
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+	res.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: importResp.Identity}, &readResp)
+	return readResp.State.Raw, readResp.Identity.Raw, readResp.Diagnostics
+}
+
+func (r *frameworkListedResource) rawV5Schemas(context.Context, *list.RawV5SchemaResponse) {
+	// The framework provider serves the resource, its schemas are used
+}
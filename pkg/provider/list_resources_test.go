@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/grafana"
+)
+
+type testListedObject struct {
+	name, folder string
+	tags         []string
+}
+
+func testListResource(t *testing.T, objects map[string]testListedObject, listed []string) list.ListResource {
+	t.Helper()
+	r := common.NewLegacySDKResource(common.CategoryGrafanaOSS, "grafana_test", testIdentityResourceID, &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":     {Type: schema.TypeString, Required: true},
+			"folder":   {Type: schema.TypeString, Optional: true},
+			"tags_all": {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+		ReadContext: func(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+			object, ok := objects[d.Id()]
+			if !ok {
+				d.SetId("")
+				return nil
+			}
+			d.Set("name", object.name)
+			d.Set("folder", object.folder)
+			d.Set("tags_all", object.tags)
+			return nil
+		},
+		Importer: &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+	}).WithLister(func(_ context.Context, _ *common.Client, data any) ([]string, error) {
+		assert.IsType(t, &grafana.ListerData{}, data)
+		return listed, nil
+	})
+
+	lr := newListResource(r)
+	lr.(list.ListResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{ProviderData: &common.Client{}}, &resource.ConfigureResponse{})
+	return lr
+}
+
+func listConfig(t *testing.T, lr list.ListResource, values map[string]string) tfsdk.Config {
+	t.Helper()
+	schemaResp := &list.ListResourceSchemaResponse{}
+	lr.ListResourceConfigSchema(context.Background(), list.ListResourceSchemaRequest{}, schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name := range configType.AttributeTypes {
+		var value any
+		if v, ok := values[name]; ok {
+			value = v
+		}
+		attributes[name] = tftypes.NewValue(tftypes.String, value)
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, attributes)}
+}
+
+func TestListResource(t *testing.T) {
+	objects := map[string]testListedObject{
+		"1:a": {name: "prod-a", folder: "f1", tags: []string{"team-a"}},
+		"1:b": {name: "prod-b", folder: "f2", tags: []string{"team-a", "team-b"}},
+		"1:c": {name: "dev-c", folder: "f1"},
+	}
+	// 1:deleted is listed but deleted before it's read
+	lr := testListResource(t, objects, []string{"1:a", "1:b", "1:deleted", "1:c"})
+
+	// The schema only has the filters which apply to the resource
+	schemaResp := &list.ListResourceSchemaResponse{}
+	lr.ListResourceConfigSchema(context.Background(), list.ListResourceSchemaRequest{}, schemaResp)
+	assert.Contains(t, schemaResp.Schema.Attributes, "name")
+	assert.Contains(t, schemaResp.Schema.Attributes, "folder")
+	assert.Contains(t, schemaResp.Schema.Attributes, "tag")
+	assert.NotContains(t, schemaResp.Schema.Attributes, "org")
+
+	// The SDKv2 resource schemas are given to the framework
+	rawSchemasResp := &list.RawV5SchemaResponse{}
+	lr.(list.ListResourceWithRawV5Schemas).RawV5Schemas(context.Background(), list.RawV5SchemaRequest{}, rawSchemasResp)
+	require.NotNil(t, rawSchemasResp.ProtoV5Schema)
+	require.NotNil(t, rawSchemasResp.ProtoV5IdentitySchema)
+	assert.Len(t, rawSchemasResp.ProtoV5IdentitySchema.IdentityAttributes, 2)
+
+	resourceSchema := fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id":       fwschema.StringAttribute{Computed: true},
+			"name":     fwschema.StringAttribute{Required: true},
+			"folder":   fwschema.StringAttribute{Optional: true},
+			"tags_all": fwschema.ListAttribute{Computed: true, ElementType: types.StringType},
+		},
+	}
+	listResults := func(values map[string]string, limit int64) []list.ListResult {
+		stream := &list.ListResultsStream{}
+		lr.List(context.Background(), list.ListRequest{
+			Config:                 listConfig(t, lr, values),
+			IncludeResource:        true,
+			Limit:                  limit,
+			ResourceSchema:         resourceSchema,
+			ResourceIdentitySchema: testIdentityResourceID.IdentitySchema(),
+		}, stream)
+		var results []list.ListResult
+		for result := range stream.Results {
+			require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+			results = append(results, result)
+		}
+		return results
+	}
+	displayNames := func(results []list.ListResult) []string {
+		var names []string
+		for _, result := range results {
+			names = append(names, result.DisplayName)
+		}
+		return names
+	}
+
+	results := listResults(nil, 0)
+	assert.Equal(t, []string{"prod-a", "prod-b", "dev-c"}, displayNames(results))
+
+	// Each result has the identity of the object and its state
+	var orgID types.Int64
+	var uid, folder types.String
+	results[0].Identity.GetAttribute(context.Background(), path.Root("org_id"), &orgID)
+	results[0].Identity.GetAttribute(context.Background(), path.Root("uid"), &uid)
+	results[0].Resource.GetAttribute(context.Background(), path.Root("folder"), &folder)
+	assert.Equal(t, int64(1), orgID.ValueInt64())
+	assert.Equal(t, "a", uid.ValueString())
+	assert.Equal(t, "f1", folder.ValueString())
+
+	assert.Equal(t, []string{"prod-a", "prod-b"}, displayNames(listResults(map[string]string{"name": "prod-*"}, 0)))
+	assert.Equal(t, []string{"prod-a", "dev-c"}, displayNames(listResults(map[string]string{"folder": "f1"}, 0)))
+	assert.Equal(t, []string{"prod-b"}, displayNames(listResults(map[string]string{"tag": "team-b"}, 0)))
+	assert.Equal(t, []string{"prod-a"}, displayNames(listResults(map[string]string{"name": "prod-*", "folder": "f1", "tag": "team-a"}, 0)))
+	assert.Equal(t, []string{"prod-a", "prod-b"}, displayNames(listResults(nil, 2)))
+
+	// Invalid name patterns are rejected
+	validateResp := &list.ValidateConfigResponse{}
+	lr.(list.ListResourceWithValidateConfig).ValidateListResourceConfig(context.Background(), list.ValidateConfigRequest{Config: listConfig(t, lr, map[string]string{"name": "prod-["})}, validateResp)
+	assert.True(t, validateResp.Diagnostics.HasError())
+}
+
+// testFrameworkListedResource is a Plugin Framework resource without import, which reads the objects from their ID.
+type testFrameworkListedResource struct {
+	resource.ResourceWithConfigure
+	names map[string]string
+}
+
+func (r *testFrameworkListedResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+}
+
+func (r *testFrameworkListedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = testFrameworkListedResourceSchema
+}
+
+func (r *testFrameworkListedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), r.names[id.ValueString()])...)
+}
+
+var testFrameworkListedResourceSchema = fwschema.Schema{
+	Attributes: map[string]fwschema.Attribute{
+		"id":   fwschema.StringAttribute{Computed: true},
+		"name": fwschema.StringAttribute{Computed: true},
+	},
+}
+
+func TestListResourceWithoutImport(t *testing.T) {
+	r := common.NewResource(common.CategoryGrafanaOSS, "grafana_test", testIdentityResourceID, &testFrameworkListedResource{names: map[string]string{"1:a": "prod-a"}}).
+		WithLister(func(context.Context, *common.Client, any) ([]string, error) {
+			return []string{"1:a"}, nil
+		})
+	lr := newListResource(r)
+	lr.(list.ListResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{ProviderData: &common.Client{}}, &resource.ConfigureResponse{})
+
+	// The objects are read from their ID, like the SDKv2 resources without importer
+	stream := &list.ListResultsStream{}
+	lr.List(context.Background(), list.ListRequest{
+		Config:                 listConfig(t, lr, nil),
+		IncludeResource:        true,
+		ResourceSchema:         testFrameworkListedResourceSchema,
+		ResourceIdentitySchema: testIdentityResourceID.IdentitySchema(),
+	}, stream)
+	var results []list.ListResult
+	for result := range stream.Results {
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+		results = append(results, result)
+	}
+	require.Len(t, results, 1)
+	assert.Equal(t, "prod-a", results[0].DisplayName)
+
+	var uid types.String
+	results[0].Identity.GetAttribute(context.Background(), path.Root("uid"), &uid)
+	assert.Equal(t, "a", uid.ValueString())
+}
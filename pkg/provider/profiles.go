@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.yaml.in/yaml/v3"
+)
+
+// profilesFile is the content of the profiles file, e.g.:
+//
+//	profiles:
+//	  prod-eu:
+//	    url: https://prod-eu.grafana.net
+//	    auth: glsa_xxx
+//	    sm_url: https://synthetic-monitoring-api-eu-west.grafana.net
+type profilesFile struct {
+	Profiles map[string]map[string]any `yaml:"profiles"`
+}
+
+// defaultProfilesFile returns the path of the profiles file when `profiles_file` isn't set: $XDG_CONFIG_HOME/grafana/terraform.yaml,
+// defaulting to ~/.config/grafana/terraform.yaml.
+func defaultProfilesFile() (string, error) {
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		configDir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configDir, "grafana", "terraform.yaml"), nil
+}
+
+// applyProfile sets the attributes of the provider configuration which are null to the values of the profile.
+// The profiles can set the string, number and boolean attributes, by their name in the provider schema.
+func (c *ProviderConfig) applyProfile() error {
+	path := c.ProfilesFile.ValueString()
+	if path == "" {
+		var err error
+		if path, err = defaultProfilesFile(); err != nil {
+			return fmt.Errorf("failed to find the profiles file: %w", err)
+		}
+	}
+
+	content, err := os.ReadFile(path) //nolint:gosec // path is set by the provider configuration
+	if err != nil {
+		return fmt.Errorf("failed to read the profiles file: %w", err)
+	}
+	var file profilesFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return fmt.Errorf("failed to parse the profiles file %s: %w", path, err)
+	}
+
+	name := c.Profile.ValueString()
+	profile, ok := file.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(file.Profiles))
+		for n := range file.Profiles {
+			names = append(names, n)
+		}
+		slices.Sort(names)
+		return fmt.Errorf("profile %q not found in %s, the profiles are: %s", name, path, strings.Join(names, ", "))
+	}
+
+	fields := map[string]reflect.Value{}
+	config := reflect.ValueOf(c).Elem()
+	for i := range config.NumField() {
+		tag := config.Type().Field(i).Tag.Get("tfsdk")
+		if tag != "" && tag != "-" && tag != "profile" && tag != "profiles_file" {
+			fields[tag] = config.Field(i)
+		}
+	}
+
+	keys := make([]string, 0, len(profile))
+	for key := range profile {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		value := profile[key]
+		field, ok := fields[key]
+		if !ok {
+			return fmt.Errorf("profile %q: %q is not a provider attribute", name, key)
+		}
+
+		switch current := field.Interface().(type) {
+		case types.String:
+			v, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("profile %q: %q must be a string", name, key)
+			}
+			if current.IsNull() {
+				field.Set(reflect.ValueOf(types.StringValue(v)))
+			}
+		case types.Int64:
+			v, ok := value.(int)
+			if !ok {
+				return fmt.Errorf("profile %q: %q must be an integer", name, key)
+			}
+			if current.IsNull() {
+				field.Set(reflect.ValueOf(types.Int64Value(int64(v))))
+			}
+		case types.Bool:
+			v, ok := value.(bool)
+			if !ok {
+				return fmt.Errorf("profile %q: %q must be a boolean", name, key)
+			}
+			if current.IsNull() {
+				field.Set(reflect.ValueOf(types.BoolValue(v)))
+			}
+		default:
+			return fmt.Errorf("profile %q: %q can't be set in a profile, set it in the provider block", name, key)
+		}
+	}
+
+	return nil
+}
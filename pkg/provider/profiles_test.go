@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProfiles = `
+profiles:
+  prod-eu:
+    url: https://prod-eu.grafana.net
+    auth: profile-token
+    sm_url: https://synthetic-monitoring-api-eu-west.grafana.net
+    stack_id: 123
+    read_only: true
+  dev:
+    url: http://localhost:3000
+`
+
+func TestProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "terraform.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testProfiles), 0600))
+	t.Setenv("GRAFANA_PROFILES_FILE", path)
+	t.Setenv("GRAFANA_AUTH", "env-token")
+	t.Setenv("GRAFANA_ORG_ID", "2")
+	t.Setenv("GRAFANA_ONCALL_URL", "")
+
+	cfg := ProviderConfig{
+		Profile: types.StringValue("prod-eu"),
+		URL:     types.StringValue("https://explicit.grafana.net"),
+	}
+	require.NoError(t, cfg.SetDefaults())
+
+	// The attributes of the provider block override the profile, which overrides the environment variables
+	assert.Equal(t, "https://explicit.grafana.net", cfg.URL.ValueString())
+	assert.Equal(t, "profile-token", cfg.Auth.ValueString())
+	assert.Equal(t, "https://synthetic-monitoring-api-eu-west.grafana.net", cfg.SMURL.ValueString())
+	assert.Equal(t, int64(123), cfg.StackID.ValueInt64())
+	assert.True(t, cfg.ReadOnly.ValueBool())
+	assert.Equal(t, int64(2), cfg.OrgID.ValueInt64())
+	assert.Equal(t, "https://oncall-prod-us-central-0.grafana.net/oncall", cfg.OncallURL.ValueString())
+}
+
+func TestProfileFromEnv(t *testing.T) {
+	configDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(configDir, "grafana"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "grafana", "terraform.yaml"), []byte(testProfiles), 0600))
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+	t.Setenv("GRAFANA_PROFILE", "dev")
+	t.Setenv("GRAFANA_AUTH", "")
+
+	cfg := ProviderConfig{}
+	require.NoError(t, cfg.SetDefaults())
+	assert.Equal(t, "http://localhost:3000", cfg.URL.ValueString())
+	assert.True(t, cfg.Auth.IsNull())
+}
+
+func TestProfileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		profiles string
+		profile  string
+		expected string
+	}{
+		{
+			name:     "missing profile",
+			profiles: testProfiles,
+			profile:  "staging",
+			expected: `the profiles are: dev, prod-eu`,
+		},
+		{
+			name:     "unknown attribute",
+			profiles: "profiles: {dev: {uri: http://localhost:3000}}",
+			profile:  "dev",
+			expected: `profile "dev": "uri" is not a provider attribute`,
+		},
+		{
+			name:     "invalid type",
+			profiles: "profiles: {dev: {stack_id: abc}}",
+			profile:  "dev",
+			expected: `profile "dev": "stack_id" must be an integer`,
+		},
+		{
+			name:     "unsupported attribute",
+			profiles: "profiles: {dev: {http_headers: {X-Header: value}}}",
+			profile:  "dev",
+			expected: `profile "dev": "http_headers" can't be set in a profile, set it in the provider block`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "terraform.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.profiles), 0600))
+
+			cfg := ProviderConfig{
+				Profile:      types.StringValue(tc.profile),
+				ProfilesFile: types.StringValue(path),
+			}
+			require.ErrorContains(t, cfg.SetDefaults(), tc.expected)
+		})
+	}
+}
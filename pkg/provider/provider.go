@@ -6,24 +6,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
-	"github.com/hashicorp/terraform-plugin-mux/tf6to5server"
 )
 
 func MakeProviderServer(ctx context.Context, version string) (tfprotov5.ProviderServer, error) {
 	// While we still have the SDK2 provider, we have to use the provider v5 protocol
 	// See https://developer.hashicorp.com/terraform/plugin/mux/translating-protocol-version-6-to-5
-	downgradedFrameworkProvider, err := tf6to5server.DowngradeServer(
-		context.Background(),
-		providerserver.NewProtocol6(FrameworkProvider(version)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
+	// The framework provider is served with the v5 protocol rather than downgraded from v6 with tf6to5server,
+	// which drops the `include_resource` and `limit` of the list resource requests.
 	providers := []func() tfprotov5.ProviderServer{
-		func() tfprotov5.ProviderServer {
-			return downgradedFrameworkProvider
-		},
+		providerserver.NewProtocol5(FrameworkProvider(version)),
 		Provider(version).GRPCProvider,
 	}
 	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// requestLimiter limits the concurrency and the rate of the requests sent to each API, see the `max_concurrent_requests`
+// and `requests_per_second` provider attributes. The APIs are told apart by their host: e.g. the Grafana API, the App Platform API
+// and the plugin APIs of a stack share the same limits, while the Grafana Cloud API and the SM API have their own.
+// A single requestLimiter is shared by all the clients of the providers configured with the same limits.
+type requestLimiter struct {
+	maxConcurrentRequests int
+	requestsPerSecond     int
+
+	mutex sync.Mutex
+	hosts map[string]*hostRequestLimiter
+}
+
+// hostRequestLimiter holds the limits of the requests sent to a host.
+type hostRequestLimiter struct {
+	slots chan struct{} // nil when max_concurrent_requests isn't set
+	rate  *rate.Limiter // nil when requests_per_second isn't set
+}
+
+type requestLimiterKey struct {
+	maxConcurrentRequests int64
+	requestsPerSecond     int64
+}
+
+var (
+	// requestLimiters are the limiters of the provider process. The SDKv2 and the Plugin Framework providers are both configured,
+	// they must share the limiter of their configuration for the limits to apply to all their requests.
+	requestLimiters      = map[requestLimiterKey]*requestLimiter{}
+	requestLimitersMutex sync.Mutex
+)
+
+// getRequestLimiter returns the limiter of the provider configuration, or nil when no limit is set.
+func getRequestLimiter(providerConfig ProviderConfig) (*requestLimiter, error) {
+	maxConcurrentRequests := providerConfig.MaxConcurrentRequests.ValueInt64()
+	if maxConcurrentRequests < 0 {
+		return nil, fmt.Errorf("max_concurrent_requests must be positive, got %d", maxConcurrentRequests)
+	}
+	requestsPerSecond := providerConfig.RequestsPerSecond.ValueInt64()
+	if requestsPerSecond < 0 {
+		return nil, fmt.Errorf("requests_per_second must be positive, got %d", requestsPerSecond)
+	}
+	if maxConcurrentRequests == 0 && requestsPerSecond == 0 {
+		return nil, nil
+	}
+
+	key := requestLimiterKey{maxConcurrentRequests: maxConcurrentRequests, requestsPerSecond: requestsPerSecond}
+	requestLimitersMutex.Lock()
+	defer requestLimitersMutex.Unlock()
+
+	limiter, ok := requestLimiters[key]
+	if !ok {
+		limiter = &requestLimiter{
+			maxConcurrentRequests: int(maxConcurrentRequests),
+			requestsPerSecond:     int(requestsPerSecond),
+			hosts:                 map[string]*hostRequestLimiter{},
+		}
+		requestLimiters[key] = limiter
+	}
+	return limiter, nil
+}
+
+func (l *requestLimiter) forHost(host string) *hostRequestLimiter {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	limiter, ok := l.hosts[host]
+	if !ok {
+		limiter = &hostRequestLimiter{}
+		if l.maxConcurrentRequests > 0 {
+			limiter.slots = make(chan struct{}, l.maxConcurrentRequests)
+		}
+		if l.requestsPerSecond > 0 {
+			limiter.rate = rate.NewLimiter(rate.Limit(l.requestsPerSecond), 1)
+		}
+		l.hosts[host] = limiter
+	}
+	return limiter
+}
+
+// requestLimiterRoundTripper waits for the limits of the host of the requests before sending them.
+// A request takes a slot of max_concurrent_requests until its response headers are received. It's applied within the retries
+// (see limitTransport), so that each attempt waits for the limits and the slot is released during the backoff.
+type requestLimiterRoundTripper struct {
+	base    http.RoundTripper
+	limiter *requestLimiter
+}
+
+func (rt *requestLimiterRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	limiter := rt.limiter.forHost(req.URL.Host)
+	ctx := req.Context()
+
+	if limiter.slots != nil {
+		select {
+		case limiter.slots <- struct{}{}:
+			defer func() { <-limiter.slots }()
+		case <-ctx.Done():
+			closeRequestBody(req)
+			return nil, ctx.Err()
+		}
+	}
+	if limiter.rate != nil {
+		if err := limiter.rate.Wait(ctx); err != nil {
+			closeRequestBody(req)
+			return nil, err
+		}
+	}
+
+	return rt.base.RoundTrip(req)
+}
+
+// closeRequestBody closes the body of a request which isn't sent, a RoundTripper must close it even on errors.
+func closeRequestBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
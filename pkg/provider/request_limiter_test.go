@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/grafana/grafana-openapi-client-go/models"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeDashboardServer returns a server answering every request after the latency, and the maximum number of concurrent requests it received.
+func newFakeDashboardServer(t testing.TB, latency time.Duration) (*httptest.Server, *atomic.Int64) {
+	var inFlight, maxInFlight atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(latency)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","uid":"test","version":1}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &maxInFlight
+}
+
+// postDashboards posts the dashboards from the given number of workers, like Terraform applying resources in parallel.
+// The lock, when it's set, is held during each request, like the former dashboard mutex of the provider.
+func postDashboards(t testing.TB, c *common.Client, dashboards, workers int, lock sync.Locker) {
+	var wg sync.WaitGroup
+	queue := make(chan int)
+	for range workers {
+		wg.Go(func() {
+			for range queue {
+				if lock != nil {
+					lock.Lock()
+				}
+				_, err := c.GrafanaAPI.Dashboards.PostDashboard(&models.SaveDashboardCommand{Dashboard: map[string]any{"title": "test"}})
+				if lock != nil {
+					lock.Unlock()
+				}
+				assert.NoError(t, err)
+			}
+		})
+	}
+	for i := range dashboards {
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+}
+
+func TestRequestLimiterMaxConcurrentRequests(t *testing.T) {
+	server, maxInFlight := newFakeDashboardServer(t, 20*time.Millisecond)
+
+	c, err := CreateClients(ProviderConfig{
+		URL:                   types.StringValue(server.URL),
+		Auth:                  types.StringValue("admin:admin"),
+		MaxConcurrentRequests: types.Int64Value(3),
+	})
+	require.NoError(t, err)
+
+	postDashboards(t, c, 20, 10, nil)
+	assert.Equal(t, int64(3), maxInFlight.Load())
+}
+
+func TestRequestLimiterRequestsPerSecond(t *testing.T) {
+	server, _ := newFakeDashboardServer(t, 0)
+
+	c, err := CreateClients(ProviderConfig{
+		URL:               types.StringValue(server.URL),
+		Auth:              types.StringValue("admin:admin"),
+		RequestsPerSecond: types.Int64Value(20),
+	})
+	require.NoError(t, err)
+
+	// The first request is sent right away, the next ones every 50ms
+	start := time.Now()
+	postDashboards(t, c, 5, 5, nil)
+	assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+}
+
+func TestRequestLimiterSharedByProviders(t *testing.T) {
+	server, maxInFlight := newFakeDashboardServer(t, 20*time.Millisecond)
+
+	// The SDKv2 and the Plugin Framework providers create their own clients with the same configuration
+	cfg := ProviderConfig{
+		URL:                   types.StringValue(server.URL),
+		Auth:                  types.StringValue("admin:admin"),
+		MaxConcurrentRequests: types.Int64Value(2),
+	}
+	sdkClients, err := CreateClients(cfg)
+	require.NoError(t, err)
+	frameworkClients, err := CreateClients(cfg)
+	require.NoError(t, err)
+	assert.True(t, sdkClients.RequestsLimited)
+
+	var wg sync.WaitGroup
+	for _, c := range []*common.Client{sdkClients, frameworkClients} {
+		wg.Go(func() { postDashboards(t, c, 10, 5, nil) })
+	}
+	wg.Wait()
+	assert.Equal(t, int64(2), maxInFlight.Load())
+}
+
+func TestRequestLimiterRetries(t *testing.T) {
+	var failed atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first request fails and is retried after a second
+		if failed.CompareAndSwap(false, true) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","uid":"test","version":1}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := CreateClients(ProviderConfig{
+		URL:                   types.StringValue(server.URL),
+		Auth:                  types.StringValue("admin:admin"),
+		Retries:               types.Int64Value(1),
+		RetryWait:             types.Int64Value(1),
+		RetryStatusCodes:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("503")}),
+		MaxConcurrentRequests: types.Int64Value(1),
+	})
+	require.NoError(t, err)
+
+	retried := make(chan struct{})
+	go func() {
+		defer close(retried)
+		_, err := c.GrafanaAPI.Dashboards.PostDashboard(&models.SaveDashboardCommand{Dashboard: map[string]any{"title": "retried"}})
+		assert.NoError(t, err)
+	}()
+	require.Eventually(t, failed.Load, time.Second, time.Millisecond)
+
+	// The slot of the retried request is released during the backoff
+	start := time.Now()
+	_, err = c.GrafanaAPI.Dashboards.PostDashboard(&models.SaveDashboardCommand{Dashboard: map[string]any{"title": "test"}})
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	<-retried
+}
+
+func TestRequestLimiterPerHost(t *testing.T) {
+	limiter, err := getRequestLimiter(ProviderConfig{MaxConcurrentRequests: types.Int64Value(1)})
+	require.NoError(t, err)
+
+	assert.Same(t, limiter.forHost("grafana.example.com"), limiter.forHost("grafana.example.com"))
+	assert.NotSame(t, limiter.forHost("grafana.example.com"), limiter.forHost("grafana.com"))
+}
+
+func TestRequestLimiterInvalidConfig(t *testing.T) {
+	limiter, err := getRequestLimiter(ProviderConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, limiter)
+	c, err := CreateClients(ProviderConfig{})
+	require.NoError(t, err)
+	assert.False(t, c.RequestsLimited)
+
+	_, err = CreateClients(ProviderConfig{MaxConcurrentRequests: types.Int64Value(-1)})
+	require.EqualError(t, err, "max_concurrent_requests must be positive, got -1")
+	_, err = CreateClients(ProviderConfig{RequestsPerSecond: types.Int64Value(-1)})
+	require.EqualError(t, err, "requests_per_second must be positive, got -1")
+}
+
+// BenchmarkRequestLimiter applies 100 dashboards with 10 workers (the default parallelism of Terraform)
+// to a fake server answering in 5ms, with the former dashboard mutex and with the request limiter.
+func BenchmarkRequestLimiter(b *testing.B) {
+	server, _ := newFakeDashboardServer(b, 5*time.Millisecond)
+
+	for _, bc := range []struct {
+		name                  string
+		maxConcurrentRequests int64
+		mutex                 bool
+	}{
+		{name: "dashboard mutex", mutex: true},
+		{name: "max_concurrent_requests=5", maxConcurrentRequests: 5},
+		{name: "max_concurrent_requests=10", maxConcurrentRequests: 10},
+		{name: "no limit"},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			cfg := ProviderConfig{
+				URL:  types.StringValue(server.URL),
+				Auth: types.StringValue("admin:admin"),
+			}
+			if bc.maxConcurrentRequests > 0 {
+				cfg.MaxConcurrentRequests = types.Int64Value(bc.maxConcurrentRequests)
+			}
+			c, err := CreateClients(cfg)
+			require.NoError(b, err)
+
+			var lock sync.Locker
+			if bc.mutex {
+				lock = &sync.Mutex{}
+			}
+			for b.Loop() {
+				postDashboards(b, c, 100, 10, lock)
+			}
+		})
+	}
+}
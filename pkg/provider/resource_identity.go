@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+// withResourceIdentity returns a copy of the resource with the identity generated from its ID (see common.ResourceID.SDKIdentity).
+// The identity is set from the ID after each create, read and update. Resources imported from an identity get the ID joined
+// from it before their importer runs, so the importers work the same with both kinds of imports.
+func withResourceIdentity(idType *common.ResourceID, r *schema.Resource) *schema.Resource {
+	if !idType.HasIdentity() || r.Identity != nil {
+		return r
+	}
+
+	setIdentity := func(d *schema.ResourceData, diags diag.Diagnostics) diag.Diagnostics {
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		values, err := idType.IdentityValues(d.Id())
+		if err != nil {
+			return append(diags, diag.Errorf("failed to set the identity of the resource: %v", err)...)
+		}
+		identity, err := d.Identity()
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		for name, value := range values {
+			if i, ok := value.(int64); ok {
+				value = int(i)
+			}
+			if err := identity.Set(name, value); err != nil {
+				return append(diags, diag.Errorf("failed to set the identity of the resource: %v", err)...)
+			}
+		}
+		return diags
+	}
+
+	wrapped := *r
+	wrapped.Identity = idType.SDKIdentity()
+	if r.CreateContext != nil {
+		wrapped.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return setIdentity(d, r.CreateContext(ctx, d, meta))
+		}
+	}
+	if r.ReadContext != nil {
+		wrapped.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return setIdentity(d, r.ReadContext(ctx, d, meta))
+		}
+	}
+	if r.UpdateContext != nil {
+		wrapped.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return setIdentity(d, r.UpdateContext(ctx, d, meta))
+		}
+	}
+	if r.Importer != nil {
+		importer := r.Importer
+		wrapped.Importer = &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				if d.Id() == "" {
+					identity, err := d.Identity()
+					if err != nil {
+						return nil, err
+					}
+					values := map[string]any{}
+					for _, f := range idType.Fields() {
+						if value, ok := identity.GetOk(f.IdentityAttribute()); ok {
+							values[f.IdentityAttribute()] = value
+						}
+					}
+					id, err := idType.FromIdentityValues(values)
+					if err != nil {
+						return nil, fmt.Errorf("failed to import the resource from its identity: %w", err)
+					}
+					d.SetId(id)
+				}
+
+				if importer.StateContext == nil {
+					return []*schema.ResourceData{d}, nil
+				}
+				return importer.StateContext(ctx, d, meta)
+			},
+		}
+	}
+	return &wrapped
+}
+
+var _ resource.ResourceWithIdentity = (*frameworkResourceWithIdentity)(nil)
+
+// frameworkResourceWithIdentity is withResourceIdentity for the Plugin Framework resources. The identity is set from the
+// `id` attribute of the state, or from the state attributes named like the identity attributes when the `id` attribute
+// isn't in the format of the resource ID.
+type frameworkResourceWithIdentity struct {
+	*frameworkResourceWithContext
+	idType *common.ResourceID
+}
+
+func withFrameworkResourceIdentity(idType *common.ResourceID, r resource.Resource) resource.Resource {
+	withContext, ok := r.(*frameworkResourceWithContext)
+	if !ok || !idType.HasIdentity() {
+		return r
+	}
+	return &frameworkResourceWithIdentity{frameworkResourceWithContext: withContext, idType: idType}
+}
+
+func (r *frameworkResourceWithIdentity) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = r.idType.IdentitySchema()
+}
+
+func (r *frameworkResourceWithIdentity) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.frameworkResourceWithContext.Create(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		r.setIdentity(ctx, resp.State.Raw, resp.Identity, &resp.Diagnostics)
+	}
+}
+
+func (r *frameworkResourceWithIdentity) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.frameworkResourceWithContext.Read(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		r.setIdentity(ctx, resp.State.Raw, resp.Identity, &resp.Diagnostics)
+	}
+}
+
+func (r *frameworkResourceWithIdentity) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.frameworkResourceWithContext.Update(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		r.setIdentity(ctx, resp.State.Raw, resp.Identity, &resp.Diagnostics)
+	}
+}
+
+func (r *frameworkResourceWithIdentity) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil {
+		var attributes map[string]tftypes.Value
+		if err := req.Identity.Raw.As(&attributes); err != nil {
+			resp.Diagnostics.AddError("Failed to read the resource identity", err.Error())
+			return
+		}
+		values := map[string]any{}
+		for _, f := range r.idType.Fields() {
+			if value, ok := identityValue(f, attributes[f.IdentityAttribute()]); ok {
+				values[f.IdentityAttribute()] = value
+			}
+		}
+		id, err := r.idType.FromIdentityValues(values)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to import the resource from its identity", err.Error())
+			return
+		}
+		req.ID = id
+	}
+	r.frameworkResourceWithContext.ImportState(ctx, req, resp)
+}
+
+// setIdentity sets the identity from the state, unless the resource was removed from the state.
+func (r *frameworkResourceWithIdentity) setIdentity(ctx context.Context, state tftypes.Value, identity *tfsdk.ResourceIdentity, diags *fwdiag.Diagnostics) {
+	if identity == nil || state.IsNull() {
+		return
+	}
+	var attributes map[string]tftypes.Value
+	if err := state.As(&attributes); err != nil {
+		diags.AddError("Failed to set the identity of the resource", err.Error())
+		return
+	}
+
+	values, err := r.stateIdentityValues(attributes)
+	if err != nil {
+		diags.AddError("Failed to set the identity of the resource", err.Error())
+		return
+	}
+	for name, value := range values {
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+func (r *frameworkResourceWithIdentity) stateIdentityValues(attributes map[string]tftypes.Value) (map[string]any, error) {
+	id, hasID := identityValue(common.StringIDField("id"), attributes["id"])
+	if hasID {
+		if values, err := r.idType.IdentityValues(id.(string)); err == nil {
+			return values, nil
+		}
+	}
+
+	// Fall back to the attributes named like the identity attributes
+	values := map[string]any{}
+	for _, f := range r.idType.Fields() {
+		value, ok := identityValue(f, attributes[f.IdentityAttribute()])
+		if !ok {
+			if f.Optional {
+				continue
+			}
+			if hasID {
+				return nil, fmt.Errorf("the ID %q is not in the format of the resource ID and the %q attribute isn't set", id, f.IdentityAttribute())
+			}
+			return nil, fmt.Errorf("the %q attribute isn't set", f.IdentityAttribute())
+		}
+		values[f.IdentityAttribute()] = value
+	}
+	return values, nil
+}
+
+// identityValue converts a string or number value to the type of the ID field: int64 or string.
+func identityValue(f common.ResourceIDField, v tftypes.Value) (any, bool) {
+	if !v.IsKnown() || v.IsNull() {
+		return nil, false
+	}
+
+	var s string
+	switch {
+	case v.Type().Is(tftypes.String):
+		if err := v.As(&s); err != nil || s == "" {
+			return nil, false
+		}
+	case v.Type().Is(tftypes.Number):
+		var n big.Float
+		if err := v.As(&n); err != nil {
+			return nil, false
+		}
+		i, accuracy := n.Int64()
+		if accuracy != big.Exact {
+			return nil, false
+		}
+		s = strconv.FormatInt(i, 10)
+	default:
+		return nil, false
+	}
+
+	if f.Type == common.ResourceIDFieldTypeInt {
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		return i, true
+	}
+	return s, true
+}
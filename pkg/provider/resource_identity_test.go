@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+)
+
+var testIdentityResourceID = common.NewResourceID(common.OptionalIntIDField("orgID"), common.StringIDField("uid"))
+
+func TestResourceIdentity(t *testing.T) {
+	r := withResourceIdentity(testIdentityResourceID, &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"title": {Type: schema.TypeString, Optional: true, ForceNew: true},
+		},
+		CreateContext: func(context.Context, *schema.ResourceData, any) diag.Diagnostics { return nil },
+		ReadContext:   func(context.Context, *schema.ResourceData, any) diag.Diagnostics { return nil },
+		DeleteContext: func(context.Context, *schema.ResourceData, any) diag.Diagnostics { return nil },
+		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+	})
+	p := &schema.Provider{ResourcesMap: map[string]*schema.Resource{"grafana_test": r}}
+	require.NoError(t, p.InternalValidate())
+
+	// The ID is joined from the identity, and the importer is called with it
+	states, err := p.ImportStateWithIdentity(context.Background(), &terraform.InstanceInfo{Type: "grafana_test"}, "", map[string]string{"org_id": "1", "uid": "abc"})
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.Equal(t, "1:abc", states[0].ID)
+
+	// The identity is set from the ID after a read
+	state, diags := r.RefreshWithoutUpgrade(context.Background(), &terraform.InstanceState{ID: "2:def", Attributes: map[string]string{"id": "2:def"}}, nil)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, map[string]string{"org_id": "2", "uid": "def"}, state.Identity)
+
+	// The optional fields are left out of the identity when they are not in the ID
+	state, diags = r.RefreshWithoutUpgrade(context.Background(), &terraform.InstanceState{ID: "def", Attributes: map[string]string{"id": "def"}}, nil)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "def", state.Identity["uid"])
+	assert.Empty(t, state.Identity["org_id"])
+}
+
+// stateSettingResource sets the state given to it in its Read and ImportState functions.
+type stateSettingResource struct {
+	resource.Resource
+	state    any
+	importID string
+}
+
+func (r *stateSettingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id":     fwschema.StringAttribute{Computed: true},
+			"org_id": fwschema.StringAttribute{Optional: true},
+			"uid":    fwschema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+func (r *stateSettingResource) Read(ctx context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(resp.State.Set(ctx, r.state)...)
+}
+
+func (r *stateSettingResource) ImportState(_ context.Context, req resource.ImportStateRequest, _ *resource.ImportStateResponse) {
+	r.importID = req.ID
+}
+
+type testIdentityModel struct {
+	ID    types.String `tfsdk:"id"`
+	OrgID types.String `tfsdk:"org_id"`
+	UID   types.String `tfsdk:"uid"`
+}
+
+func TestFrameworkResourceIdentity(t *testing.T) {
+	inner := &stateSettingResource{}
+	r := withFrameworkResourceIdentity(testIdentityResourceID, withFrameworkResourceContext("grafana_test", inner)).(resource.ResourceWithIdentity)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	identitySchemaResp := &resource.IdentitySchemaResponse{}
+	r.IdentitySchema(context.Background(), resource.IdentitySchemaRequest{}, identitySchemaResp)
+	identitySchema := identitySchemaResp.IdentitySchema
+	assert.True(t, identitySchema.Attributes["uid"].IsRequiredForImport())
+	assert.True(t, identitySchema.Attributes["org_id"].IsOptionalForImport())
+
+	read := func(state testIdentityModel) (*tfsdk.ResourceIdentity, *resource.ReadResponse) {
+		inner.state = state
+		identity := &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: tftypes.NewValue(identitySchema.Type().TerraformType(context.Background()), nil)}
+		resp := &resource.ReadResponse{
+			State:    tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)},
+			Identity: identity,
+		}
+		r.Read(context.Background(), resource.ReadRequest{}, resp)
+		return identity, resp
+	}
+
+	// The identity is set from the ID
+	identity, resp := read(testIdentityModel{ID: types.StringValue("1:abc"), OrgID: types.StringValue("1"), UID: types.StringValue("abc")})
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	var orgID types.Int64
+	var uid types.String
+	identity.GetAttribute(context.Background(), path.Root("org_id"), &orgID)
+	identity.GetAttribute(context.Background(), path.Root("uid"), &uid)
+	assert.Equal(t, int64(1), orgID.ValueInt64())
+	assert.Equal(t, "abc", uid.ValueString())
+
+	// Or from the attributes named like the identity attributes, when the ID is in another format
+	identity, resp = read(testIdentityModel{ID: types.StringValue("a:b:c"), OrgID: types.StringValue("2"), UID: types.StringValue("def")})
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	identity.GetAttribute(context.Background(), path.Root("org_id"), &orgID)
+	identity.GetAttribute(context.Background(), path.Root("uid"), &uid)
+	assert.Equal(t, int64(2), orgID.ValueInt64())
+	assert.Equal(t, "def", uid.ValueString())
+
+	_, resp = read(testIdentityModel{ID: types.StringValue("a:b:c")})
+	require.True(t, resp.Diagnostics.HasError())
+
+	// The ID is joined from the identity when importing from it
+	importIdentity := &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: tftypes.NewValue(identitySchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"org_id": tftypes.NewValue(tftypes.Number, 3),
+		"uid":    tftypes.NewValue(tftypes.String, "ghi"),
+	})}
+	importResp := &resource.ImportStateResponse{}
+	r.(resource.ResourceWithImportState).ImportState(context.Background(), resource.ImportStateRequest{Identity: importIdentity}, importResp)
+	require.False(t, importResp.Diagnostics.HasError(), importResp.Diagnostics)
+	assert.Equal(t, "3:ghi", inner.importID)
+}
@@ -1,7 +1,9 @@
 package provider
 
 import (
+	"context"
 	"reflect"
+	"slices"
 
 	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/agento11y"
@@ -22,7 +24,10 @@ import (
 	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/slo"
 	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/syntheticmonitoring"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -74,6 +79,27 @@ func pluginFrameworkDataSources() []func() datasource.DataSource {
 	return dataSources
 }
 
+func EphemeralResources() []*common.EphemeralResource {
+	var ephemeralResources []*common.EphemeralResource
+	ephemeralResources = append(ephemeralResources, cloud.EphemeralResources...)
+	ephemeralResources = append(ephemeralResources, grafana.EphemeralResources...)
+	return ephemeralResources
+}
+
+func pluginFrameworkEphemeralResources() []func() ephemeral.EphemeralResource {
+	var ephemeralResources []func() ephemeral.EphemeralResource
+	for _, e := range EphemeralResources() {
+		// Same as for resources, each factory call returns a fresh copy of the template.
+		tmpl := reflect.ValueOf(e.PluginFrameworkSchema)
+		ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+			newPtr := reflect.New(tmpl.Elem().Type())
+			newPtr.Elem().Set(tmpl.Elem())
+			return newPtr.Interface().(ephemeral.EphemeralResource)
+		})
+	}
+	return ephemeralResources
+}
+
 func Resources() []*common.Resource {
 	var resources []*common.Resource
 	resources = append(resources, cloud.Resources...)
@@ -136,11 +162,70 @@ func legacySDKResources() map[string]*schema.Resource {
 		if schema == nil {
 			continue
 		}
-		result[r.Name] = schema
+		result[r.Name] = withResourceContext(r.Name, withResourceIdentity(r.IDType, schema))
 	}
 	return result
 }
 
+// withResourceContext returns a copy of the resource which adds its type (see common.ContextWithResourceType)
+// and the default labels (see common.ContextWithDefaultLabels) to the context of its functions.
+func withResourceContext(name string, r *schema.Resource) *schema.Resource {
+	resourceContext := func(ctx context.Context, id string, meta any) context.Context {
+		ctx = common.ContextWithResourceType(ctx, name)
+		ctx = common.ContextWithResourceID(ctx, id)
+		if client, ok := meta.(*common.Client); ok {
+			ctx = common.ContextWithDefaultLabels(ctx, client.DefaultLabels)
+		}
+		return ctx
+	}
+
+	wrapped := *r
+	if r.CreateContext != nil {
+		wrapped.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return r.CreateContext(resourceContext(ctx, d.Id(), meta), d, meta)
+		}
+	}
+	if r.ReadContext != nil {
+		wrapped.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return r.ReadContext(resourceContext(ctx, d.Id(), meta), d, meta)
+		}
+	}
+	if r.UpdateContext != nil {
+		wrapped.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return r.UpdateContext(resourceContext(ctx, d.Id(), meta), d, meta)
+		}
+	}
+	if r.DeleteContext != nil {
+		wrapped.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return r.DeleteContext(resourceContext(ctx, d.Id(), meta), d, meta)
+		}
+	}
+	if r.CustomizeDiff != nil {
+		wrapped.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			return r.CustomizeDiff(resourceContext(ctx, d.Id(), meta), d, meta)
+		}
+	}
+	return &wrapped
+}
+
+// withClientValidators adds the client validators of the SDKv2 resources (see common.ResourceClientValidatorFunc)
+// to their validation, with the client of the provider once it's configured: they are skipped by `terraform validate`, which doesn't configure it.
+func withClientValidators(p *schema.Provider) {
+	for _, r := range Resources() {
+		sdkResource, ok := p.ResourcesMap[r.Name]
+		if !ok || r.ClientValidator == nil {
+			continue
+		}
+		validator := r.ClientValidator
+		sdkResource.ValidateRawResourceConfigFuncs = append(slices.Clip(sdkResource.ValidateRawResourceConfigFuncs),
+			func(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+				client, _ := p.Meta().(*common.Client)
+				resp.Diagnostics = append(resp.Diagnostics, validator(ctx, req, client)...)
+			},
+		)
+	}
+}
+
 func pluginFrameworkResources() []func() resource.Resource {
 	var resources []func() resource.Resource
 
@@ -148,20 +233,38 @@ func pluginFrameworkResources() []func() resource.Resource {
 		if r.PluginFrameworkSchema == nil {
 			continue
 		}
-		// Capture a reflect.Value of the template so each factory call returns a
-		// fresh copy (preserving initialized fields like resourceType while resetting
-		// client/config to nil so Configure runs correctly for each new provider).
-		tmpl := reflect.ValueOf(r.PluginFrameworkSchema)
-		resources = append(resources, func() resource.Resource {
-			newPtr := reflect.New(tmpl.Elem().Type())
-			newPtr.Elem().Set(tmpl.Elem())
-			return newPtr.Interface().(resource.Resource)
-		})
+		resources = append(resources, newFrameworkResource(r))
 	}
 
 	for _, r := range AppPlatformResources() {
-		resources = append(resources, func() resource.Resource { return r.Resource })
+		resources = append(resources, func() resource.Resource { return withFrameworkResourceContext(r.Name, r.Resource) })
 	}
 
 	return resources
 }
+
+// newFrameworkResource returns the factory of a Plugin Framework resource.
+func newFrameworkResource(r *common.Resource) func() resource.Resource {
+	// Capture a reflect.Value of the template so each factory call returns a
+	// fresh copy (preserving initialized fields like resourceType while resetting
+	// client/config to nil so Configure runs correctly for each new provider).
+	tmpl := reflect.ValueOf(r.PluginFrameworkSchema)
+	return func() resource.Resource {
+		newPtr := reflect.New(tmpl.Elem().Type())
+		newPtr.Elem().Set(tmpl.Elem())
+		return withFrameworkResourceIdentity(r.IDType, withFrameworkResourceContext(r.Name, newPtr.Interface().(resource.Resource)))
+	}
+}
+
+// pluginFrameworkListResources returns the list resources of the resources with a lister (see newListResource).
+// The resources without an identity can't be listed, since the list results are identified by it.
+func pluginFrameworkListResources() []func() list.ListResource {
+	var listResources []func() list.ListResource
+	for _, r := range Resources() {
+		if r.ListIDsFunc == nil || !r.IDType.HasIdentity() {
+			continue
+		}
+		listResources = append(listResources, func() list.ListResource { return newListResource(r) })
+	}
+	return listResources
+}
@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grafana/grafana-com-public-clients/go/gcom"
+	"github.com/grafana/grafana-openapi-client-go/client/service_accounts"
+	SMAPI "github.com/grafana/synthetic-monitoring-api-go-client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/grafana/terraform-provider-grafana/v4/internal/common"
+	"github.com/grafana/terraform-provider-grafana/v4/internal/resources/cloud"
+)
+
+const (
+	// stackServiceAccountTokenTTL is the lifetime of the tokens created for `stack_slug`. It must outlive the Terraform operations,
+	// the service accounts are deleted when the provider exits, see DeleteTemporaryStackCredentials.
+	stackServiceAccountTokenTTL = 4 * time.Hour
+	// stackServiceAccountPrefix is followed by the creation time in the names of the service accounts created for `stack_slug`.
+	// The service accounts left behind by killed providers are deleted once their token expired, see deleteExpiredStackServiceAccounts.
+	stackServiceAccountPrefix = "terraform-provider-stack-slug-"
+	// stackTokenPlaceholder is the SM or k6 token given to the clients, until stackTokenRoundTripper replaces it with the created token.
+	stackTokenPlaceholder = "stack-slug-token"
+	defaultK6URL          = "https://api.k6.io"
+)
+
+// The attributes are declared in both the SDKv2 and the Plugin Framework providers, the descriptions must be identical.
+const (
+	stackSMMetricsPublisherKeyDescription = "With `stack_slug` and unless `sm_access_token` is set, a Cloud access policy token with the `metrics:write`, `logs:write` and `traces:write` scopes, " +
+		"which the results of the checks are published with. Synthetic Monitoring is installed on the stack with it, like with `grafana_synthetic_monitoring_installation`, " +
+		"the first time a Synthetic Monitoring resource sends a request. The SM token is deleted when the provider exits. Don't set it to the `cloud_access_policy_token`: " +
+		"the publisher key is kept by Synthetic Monitoring. May alternatively be set via the `GRAFANA_STACK_SM_METRICS_PUBLISHER_KEY` environment variable."
+	stackK6GrafanaUserDescription = "With `stack_slug` and unless `k6_access_token` is set, the Grafana user which installs the k6 App, like with `grafana_k6_installation`, " +
+		"the first time a k6 resource sends a request. The token of the k6 App of the stack is used, it isn't deleted when the provider exits. " +
+		"May alternatively be set via the `GRAFANA_STACK_K6_GRAFANA_USER` environment variable."
+)
+
+// stackAuth is the authentication to a stack, shared by the providers configured with the same `stack_slug`.
+type stackAuth struct {
+	serviceAccount *cloud.TemporaryStackServiceAccount
+	oncallURL      string
+
+	// The SM and k6 tokens are created on first use, when the provider doesn't set them
+	smClient *SMAPI.Client
+	smToken  string
+	k6Token  string
+}
+
+type stackAuthKey struct {
+	cloudAPIURL string
+	token       string
+	stackSlug   string
+}
+
+var (
+	// stackAuths are the authentications to the stacks of the provider process.
+	// The SDKv2 and the Plugin Framework providers are both configured, they share the service account of a stack.
+	stackAuths      = map[stackAuthKey]*stackAuth{}
+	stackAuthsMutex sync.Mutex
+)
+
+// configureStackAuth authenticates to the stack of `stack_slug` with a temporary service account created with the Cloud access policy token.
+// The URLs of the stack are used for the Grafana, SM and OnCall clients, unless they are set in the provider configuration.
+func configureStackAuth(ctx context.Context, providerConfig *ProviderConfig) error {
+	stackSlug := providerConfig.StackSlug.ValueString()
+	if providerConfig.CloudAccessPolicyToken.IsNull() {
+		return fmt.Errorf("stack_slug requires cloud_access_policy_token to be set")
+	}
+	if !providerConfig.URL.IsNull() || !providerConfig.Auth.IsNull() {
+		return fmt.Errorf("stack_slug can't be used with url and auth, the URL of the stack and a temporary service account token are used instead")
+	}
+	if providerConfig.ReadOnly.ValueBool() {
+		return fmt.Errorf("stack_slug can't be used with read_only, a service account is created in the stack. Set url and auth to a token with read permissions instead")
+	}
+
+	auth, err := getStackAuth(ctx, *providerConfig)
+	if err != nil {
+		return fmt.Errorf("failed to authenticate to the stack %q: %w", stackSlug, err)
+	}
+	sa := auth.serviceAccount
+
+	providerConfig.URL = types.StringValue(sa.Stack.Url)
+	providerConfig.Auth = types.StringValue(sa.Token)
+	if providerConfig.StackID.ValueInt64() == 0 {
+		providerConfig.StackID = types.Int64Value(int64(sa.Stack.Id))
+	}
+	if providerConfig.SMURL.IsNull() && sa.Stack.RegionSyntheticMonitoringApiUrl != "" {
+		providerConfig.SMURL = types.StringValue(sa.Stack.RegionSyntheticMonitoringApiUrl)
+	}
+	if providerConfig.OncallURL.IsNull() && auth.oncallURL != "" {
+		providerConfig.OncallURL = types.StringValue(auth.oncallURL)
+	}
+	// The SM and k6 tokens are created by the first request of their resources: the other Terraform commands don't install the apps,
+	// and failing to install them only fails these resources
+	config := *providerConfig
+	if providerConfig.SMAccessToken.IsNull() && !providerConfig.StackSMMetricsPublisherKey.IsNull() && sa.Stack.RegionSyntheticMonitoringApiUrl != "" {
+		providerConfig.SMAccessToken = types.StringValue(stackTokenPlaceholder)
+		providerConfig.smToken = func(ctx context.Context) (string, error) {
+			token, err := getStackSMToken(ctx, config, auth)
+			if err != nil {
+				return "", fmt.Errorf("failed to create a Synthetic Monitoring token for the stack %q: %w", stackSlug, err)
+			}
+			return token, nil
+		}
+	}
+	if providerConfig.K6AccessToken.IsNull() && !providerConfig.StackK6GrafanaUser.IsNull() {
+		providerConfig.K6AccessToken = types.StringValue(stackTokenPlaceholder)
+		providerConfig.k6Token = func(ctx context.Context) (string, error) {
+			token, err := getStackK6Token(ctx, config, auth)
+			if err != nil {
+				return "", fmt.Errorf("failed to get a k6 token for the stack %q: %w", stackSlug, err)
+			}
+			return token, nil
+		}
+	}
+
+	return nil
+}
+
+// getStackAuth returns the authentication to the stack, creating its temporary service account on first use.
+func getStackAuth(ctx context.Context, providerConfig ProviderConfig) (*stackAuth, error) {
+	key := stackAuthKey{
+		cloudAPIURL: providerConfig.CloudAPIURL.ValueString(),
+		token:       providerConfig.CloudAccessPolicyToken.ValueString(),
+		stackSlug:   providerConfig.StackSlug.ValueString(),
+	}
+
+	stackAuthsMutex.Lock()
+	defer stackAuthsMutex.Unlock()
+
+	if auth, ok := stackAuths[key]; ok {
+		return auth, nil
+	}
+
+	// The requests are recorded in the audit log, like the ones of the resources
+	cloudClient := &common.Client{}
+	if err := createCloudClient(cloudClient, providerConfig); err != nil {
+		return nil, err
+	}
+
+	sa, err := cloud.CreateTemporaryStackServiceAccount(ctx, cloudClient.GrafanaCloudAPI, key.stackSlug, stackServiceAccountPrefix, stackServiceAccountTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	auth := &stackAuth{serviceAccount: sa}
+
+	if err := deleteExpiredStackServiceAccounts(providerConfig, sa); err != nil {
+		log.Printf("[WARN] failed to delete the expired temporary service accounts of the stack %q: %v", key.stackSlug, err)
+	}
+
+	var connections *gcom.StackConnectionsV1
+	if err := common.RetryRequest(ctx, "get stack connections", func() (*http.Response, error) {
+		c, httpResp, execErr := cloudClient.GrafanaCloudAPI.StacksAPI.GetStackConnectionsV1(ctx, strconv.FormatInt(int64(sa.Stack.Id), 10)).Execute()
+		connections = c
+		return httpResp, execErr
+	}); err != nil {
+		return nil, errors.Join(err, sa.Delete(ctx))
+	}
+	if services, ok := connections.GetServicesOk(); ok {
+		if oncallURL, ok := services.GetOncallApiUrlOk(); ok {
+			auth.oncallURL = *oncallURL
+		}
+	}
+
+	stackAuths[key] = auth
+	return auth, nil
+}
+
+// getStackSMToken returns the SM access token of the stack, installing Synthetic Monitoring with the publisher key on first use.
+// The installation is idempotent, it returns a new token for the stacks where SM is already installed.
+func getStackSMToken(ctx context.Context, providerConfig ProviderConfig, auth *stackAuth) (string, error) {
+	stackAuthsMutex.Lock()
+	defer stackAuthsMutex.Unlock()
+
+	if auth.smClient != nil {
+		return auth.smToken, nil
+	}
+
+	stack := auth.serviceAccount.Stack
+	smURL := stack.RegionSyntheticMonitoringApiUrl
+	installClient := SMAPI.NewClient(smURL, "", getRetryClient(providerConfig))
+	installClient.SetCustomClientID("terraform")
+	resp, err := installClient.Install(ctx, int64(stack.Id), int64(stack.HmInstancePromId), int64(stack.HlInstanceId), providerConfig.StackSMMetricsPublisherKey.ValueString())
+	if err != nil {
+		return "", err
+	}
+
+	auth.smClient = SMAPI.NewClient(smURL, resp.AccessToken, getRetryClient(providerConfig))
+	auth.smClient.SetCustomClientID("terraform")
+	auth.smToken = resp.AccessToken
+	return auth.smToken, nil
+}
+
+// getStackK6Token returns the k6 token of the stack, installing the k6 App with the temporary service account and the Grafana user on first use.
+// The token of an existing installation is returned, it isn't deleted when the provider exits.
+func getStackK6Token(ctx context.Context, providerConfig ProviderConfig, auth *stackAuth) (string, error) {
+	stackAuthsMutex.Lock()
+	defer stackAuthsMutex.Unlock()
+
+	if auth.k6Token != "" {
+		return auth.k6Token, nil
+	}
+
+	k6URL := providerConfig.K6URL.ValueString()
+	if k6URL == "" {
+		k6URL = defaultK6URL
+	}
+	sa := auth.serviceAccount
+	token, _, err := cloud.InstallK6App(ctx, getRetryClient(providerConfig), providerConfig.UserAgent.ValueString(), k6URL, strconv.FormatInt(int64(sa.Stack.Id), 10), sa.Token, providerConfig.StackK6GrafanaUser.ValueString())
+	if err != nil {
+		return "", err
+	}
+	auth.k6Token = token
+	return token, nil
+}
+
+// stackTokenFunc returns an SM or k6 token of `stack_slug`, creating it on first use.
+type stackTokenFunc func(ctx context.Context) (string, error)
+
+// stackTokenClient returns a client which authenticates its requests with the token, see stackTokenRoundTripper.
+// The client is returned unchanged when the token isn't created by the provider.
+func stackTokenClient(client *http.Client, token stackTokenFunc) *http.Client {
+	if token == nil {
+		return client
+	}
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &http.Client{
+		Transport: &stackTokenRoundTripper{base: base, token: token},
+		Timeout:   client.Timeout,
+	}
+}
+
+// stackTokenRoundTripper replaces stackTokenPlaceholder with the token in the Authorization header, whatever its scheme.
+// The token is created by the first request which has the placeholder, its errors are the errors of the request.
+type stackTokenRoundTripper struct {
+	base  http.RoundTripper
+	token stackTokenFunc
+}
+
+func (rt *stackTokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	authorization := req.Header.Get("Authorization")
+	if !strings.Contains(authorization, stackTokenPlaceholder) {
+		return rt.base.RoundTrip(req)
+	}
+	token, err := rt.token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", strings.Replace(authorization, stackTokenPlaceholder, token, 1))
+	return rt.base.RoundTrip(req)
+}
+
+// deleteExpiredStackServiceAccounts deletes the service accounts created for `stack_slug` whose token expired.
+// They were left behind by providers which were killed before deleting them.
+func deleteExpiredStackServiceAccounts(providerConfig ProviderConfig, sa *cloud.TemporaryStackServiceAccount) error {
+	providerConfig.URL = types.StringValue(sa.Stack.Url)
+	providerConfig.Auth = types.StringValue(sa.Token)
+	grafanaClient := &common.Client{}
+	if err := createGrafanaAPIClient(grafanaClient, providerConfig); err != nil {
+		return err
+	}
+
+	query := stackServiceAccountPrefix
+	resp, err := grafanaClient.GrafanaAPI.ServiceAccounts.SearchOrgServiceAccountsWithPaging(service_accounts.NewSearchOrgServiceAccountsWithPagingParams().WithQuery(&query))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, serviceAccount := range resp.Payload.ServiceAccounts {
+		if !isExpiredStackServiceAccount(serviceAccount.Name, time.Now()) {
+			continue
+		}
+		if _, err := grafanaClient.GrafanaAPI.ServiceAccounts.DeleteServiceAccount(serviceAccount.ID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete the service account %q: %w", serviceAccount.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// isExpiredStackServiceAccount returns whether the service account was created for `stack_slug` and its token expired.
+func isExpiredStackServiceAccount(name string, now time.Time) bool {
+	createdAt, ok := strings.CutPrefix(name, stackServiceAccountPrefix)
+	if !ok {
+		return false
+	}
+	createdAtNano, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return false
+	}
+	return now.Sub(time.Unix(0, createdAtNano)) > stackServiceAccountTokenTTL
+}
+
+// DeleteTemporaryStackCredentials deletes the service accounts and the SM tokens created for `stack_slug`. It's called when the provider exits.
+func DeleteTemporaryStackCredentials(ctx context.Context) error {
+	stackAuthsMutex.Lock()
+	defer stackAuthsMutex.Unlock()
+
+	var errs []error
+	for key, auth := range stackAuths {
+		if err := auth.serviceAccount.Delete(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete the temporary service account of the stack %q: %w", key.stackSlug, err))
+		}
+		if auth.smClient != nil {
+			if err := auth.smClient.DeleteToken(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete the temporary Synthetic Monitoring token of the stack %q: %w", key.stackSlug, err))
+			}
+		}
+		delete(stackAuths, key)
+	}
+	return errors.Join(errs...)
+}
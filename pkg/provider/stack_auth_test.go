@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsExpiredStackServiceAccount(t *testing.T) {
+	now := time.Now()
+	name := func(createdAt time.Time) string {
+		return stackServiceAccountPrefix + strconv.FormatInt(createdAt.UnixNano(), 10)
+	}
+
+	assert.True(t, isExpiredStackServiceAccount(name(now.Add(-stackServiceAccountTokenTTL-time.Minute)), now))
+	assert.False(t, isExpiredStackServiceAccount(name(now.Add(-time.Hour)), now))
+	assert.False(t, isExpiredStackServiceAccount("terraform-provider-123", now), "other service accounts are kept")
+	assert.False(t, isExpiredStackServiceAccount(stackServiceAccountPrefix+"admin", now), "other service accounts are kept")
+}
+
+func TestStackTokenClient(t *testing.T) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	created := 0
+	client := stackTokenClient(http.DefaultClient, func(context.Context) (string, error) {
+		created++
+		return "sm-token", nil
+	})
+	do := func(authorization string) error {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	// The token isn't created for the requests without the placeholder
+	require.NoError(t, do(""))
+	assert.Zero(t, created)
+
+	// The placeholder is replaced, whatever the scheme
+	require.NoError(t, do("Bearer "+stackTokenPlaceholder))
+	require.NoError(t, do("Token "+stackTokenPlaceholder))
+	assert.Equal(t, []string{"", "Bearer sm-token", "Token sm-token"}, authorizations)
+	assert.Equal(t, 2, created)
+
+	// The errors of the token are the errors of the requests
+	client = stackTokenClient(http.DefaultClient, func(context.Context) (string, error) {
+		return "", errors.New("failed to install")
+	})
+	require.ErrorContains(t, do("Bearer "+stackTokenPlaceholder), "failed to install")
+	assert.Len(t, authorizations, 3)
+
+	// The clients are unchanged when the provider doesn't create the token
+	assert.Same(t, http.DefaultClient, stackTokenClient(http.DefaultClient, nil))
+}
@@ -0,0 +1,312 @@
+// Command gennotifiers generates the notifiers of grafana_contact_point from Grafana's notifier metadata.
+//
+// The metadata is a snapshot of the response of Grafana's notifier API (GET /api/alert-notifiers),
+// checked in as notifiers.json. For each notifier of the snapshot, it generates the notifier definition,
+// its schema (secure options are sensitive) and the fieldMappers between the Terraform attributes and
+// the Grafana settings. The notifiers written by hand are listed in handWrittenNotifiers.
+// The settings which can't be generated (ex: subforms) are skipped, as are the notifiers with such a required setting.
+// The skipped notifiers and settings are reported, they can be added to handWrittenNotifiers and written by hand.
+//
+// To support new notifiers or settings, refresh the snapshot from a Grafana instance and run go generate ./internal/resources/grafana/...
+//
+//	curl -H "Authorization: Bearer $GRAFANA_AUTH" "$GRAFANA_URL/api/alert-notifiers" | jq . > tools/gennotifiers/notifiers.json
+//
+// Usage:
+//
+//	go run ./tools/gennotifiers [flags]
+//
+// Flags:
+//
+//	--snapshot FILE  Notifier metadata snapshot (default: "notifiers.json")
+//	--out FILE       Generated Go file (default: "resource_alerting_contact_point_notifiers_gen.go")
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// notifierPlugin is a notifier of Grafana's notifier metadata.
+type notifierPlugin struct {
+	Type        string           `json:"type"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Options     []notifierOption `json:"options"`
+}
+
+// notifierOption is a setting of a notifier.
+type notifierOption struct {
+	Element       string `json:"element"`
+	InputType     string `json:"inputType"`
+	Description   string `json:"description"`
+	PropertyName  string `json:"propertyName"`
+	SelectOptions []struct {
+		Value string `json:"value"`
+	} `json:"selectOptions"`
+	Required bool `json:"required"`
+	Secure   bool `json:"secure"`
+}
+
+// generatedNotifier is the data of the template for a notifier.
+type generatedNotifier struct {
+	GoName string
+	Field  string
+	Type   string
+	Desc   string
+	Fields []generatedField
+}
+
+// HasMappers returns whether some settings of the notifier need a fieldMapper.
+func (n generatedNotifier) HasMappers() bool {
+	for _, f := range n.Fields {
+		if f.Mapper != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// generatedField is the data of the template for a setting.
+type generatedField struct {
+	Name        string
+	Type        string
+	Required    bool
+	Sensitive   bool
+	Default     string
+	Validate    string
+	Mapper      string
+	Description string
+}
+
+func main() {
+	snapshot := flag.String("snapshot", "notifiers.json", "Notifier metadata snapshot")
+	out := flag.String("out", "resource_alerting_contact_point_notifiers_gen.go", "Generated Go file")
+	flag.Parse()
+
+	code, skipped, err := generate(*snapshot)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	for _, s := range skipped {
+		fmt.Fprintf(os.Stderr, "skipped: %s\n", s)
+	}
+	if err := os.WriteFile(*out, code, 0600); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate reads the snapshot and returns the formatted Go code of the notifiers,
+// and the notifiers and settings which were skipped because they can't be generated.
+func generate(snapshotPath string) ([]byte, []string, error) {
+	content, err := os.ReadFile(snapshotPath) //nolint:gosec // path is given by go:generate
+	if err != nil {
+		return nil, nil, err
+	}
+	var plugins []notifierPlugin
+	if err := json.Unmarshal(content, &plugins); err != nil {
+		return nil, nil, fmt.Errorf("parsing %s: %w", snapshotPath, err)
+	}
+
+	var notifiers []generatedNotifier
+	var skipped []string
+	usesValidation := false
+	for _, plugin := range plugins {
+		if handWrittenNotifiers[plugin.Type] {
+			continue
+		}
+		n, skippedOptions, err := generateNotifier(plugin)
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("notifier %q: %s", plugin.Type, err))
+			continue
+		}
+		for _, option := range skippedOptions {
+			skipped = append(skipped, fmt.Sprintf("notifier %q: %s", plugin.Type, option))
+		}
+		for _, f := range n.Fields {
+			usesValidation = usesValidation || f.Validate != ""
+		}
+		notifiers = append(notifiers, n)
+	}
+
+	var buf bytes.Buffer
+	if err := codeTemplate.Execute(&buf, map[string]any{
+		"Notifiers":      notifiers,
+		"UsesValidation": usesValidation,
+	}); err != nil {
+		return nil, nil, err
+	}
+	code, err := format.Source(buf.Bytes())
+	return code, skipped, err
+}
+
+// generateNotifier returns the notifier and the options which were skipped because their element can't be generated.
+// An error is returned if the notifier can't be generated, when one of its required options can't be.
+func generateNotifier(plugin notifierPlugin) (generatedNotifier, []string, error) {
+	override := notifierOverrides[plugin.Type]
+	n := generatedNotifier{
+		GoName: override.goName,
+		Field:  override.field,
+		Type:   plugin.Type,
+		Desc:   override.desc,
+	}
+	if n.GoName == "" {
+		n.GoName = lowerCamelCase(plugin.Type)
+	}
+	if n.Field == "" {
+		n.Field = strings.ToLower(plugin.Type)
+	}
+	if n.Desc == "" {
+		n.Desc = fmt.Sprintf("A contact point that sends notifications to %s.", plugin.Name)
+	}
+
+	var skipped []string
+	for _, option := range plugin.Options {
+		fieldOverride := override.fields[option.PropertyName]
+		f := generatedField{
+			Name:        fieldOverride.name,
+			Type:        "TypeString",
+			Required:    option.Required,
+			Sensitive:   option.Secure,
+			Description: option.Description,
+		}
+		if f.Name == "" {
+			f.Name = snakeCase(option.PropertyName)
+		}
+		if fieldOverride.defaultValue != nil {
+			f.Default = fmt.Sprintf("%#v", fieldOverride.defaultValue)
+		}
+		mapperKey := ""
+		if f.Name != option.PropertyName {
+			mapperKey = option.PropertyName
+			f.Mapper = fmt.Sprintf("newKeyMapper(%q)", mapperKey)
+		}
+
+		switch {
+		case option.Element == "checkbox":
+			f.Type = "TypeBool"
+		case option.Element == "input" && option.InputType == "number":
+			// Grafana sends the numbers of the form inputs as strings.
+			f.Type = "TypeInt"
+			f.Mapper = fmt.Sprintf("newFieldMapper(%q, valueAsInt, valueAsString)", mapperKey)
+		case option.Element == "input" || option.Element == "textarea":
+		case option.Element == "select":
+			values := make([]string, 0, len(option.SelectOptions))
+			for _, o := range option.SelectOptions {
+				values = append(values, fmt.Sprintf("%q", o.Value))
+			}
+			f.Validate = fmt.Sprintf("validation.StringInSlice([]string{%s}, %t)", strings.Join(values, ", "), fieldOverride.ignoreCase)
+		default:
+			unsupported := fmt.Sprintf("option %q: the element %q can't be generated", option.PropertyName, option.Element)
+			if option.Required {
+				return n, nil, fmt.Errorf("%s, add the notifier to handWrittenNotifiers and write it by hand", unsupported)
+			}
+			skipped = append(skipped, unsupported)
+			continue
+		}
+
+		n.Fields = append(n.Fields, f)
+	}
+	return n, skipped, nil
+}
+
+// lowerCamelCase converts a notifier type to a Go identifier, e.g. prometheus-alertmanager to prometheusAlertmanager.
+func lowerCamelCase(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+	for i, w := range words {
+		w = strings.ToLower(w)
+		if i > 0 {
+			w = strings.ToUpper(w[:1]) + w[1:]
+		}
+		words[i] = w
+	}
+	return strings.Join(words, "")
+}
+
+// snakeCase converts a Grafana property name to a Terraform attribute name, e.g. basicAuthUser to basic_auth_user.
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+var codeTemplate = template.Must(template.New("notifiers").Parse(`// Code generated by tools/gennotifiers from tools/gennotifiers/notifiers.json. DO NOT EDIT.
+
+package grafana
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+{{- if .UsesValidation }}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+{{- end }}
+)
+
+// generatedNotifiers are the notifiers generated from Grafana's notifier metadata.
+var generatedNotifiers = []notifier{
+{{- range .Notifiers }}
+	{{ .GoName }}Notifier{},
+{{- end }}
+}
+{{ range .Notifiers }}
+type {{ .GoName }}Notifier struct{}
+
+var _ notifier = (*{{ .GoName }}Notifier)(nil)
+
+func (n {{ .GoName }}Notifier) meta() notifierMeta {
+	return notifierMeta{
+		field:   {{ printf "%q" .Field }},
+		typeStr: {{ printf "%q" .Type }},
+		desc:    {{ printf "%q" .Desc }},
+{{- if .HasMappers }}
+		fieldMapper: map[string]fieldMapper{
+{{- range .Fields }}{{ if .Mapper }}
+			{{ printf "%q" .Name }}: {{ .Mapper }},
+{{- end }}{{ end }}
+		},
+{{- else }}
+		fieldMapper: map[string]fieldMapper{},
+{{- end }}
+	}
+}
+
+func (n {{ .GoName }}Notifier) schema() *schema.Resource {
+	r := commonNotifierResource()
+{{- range .Fields }}
+	r.Schema[{{ printf "%q" .Name }}] = &schema.Schema{
+		Type:        schema.{{ .Type }},
+{{- if .Required }}
+		Required:    true,
+{{- else }}
+		Optional:    true,
+{{- end }}
+{{- if .Sensitive }}
+		Sensitive:   true,
+{{- end }}
+{{- if .Default }}
+		Default:     {{ .Default }},
+{{- end }}
+{{- if .Validate }}
+		ValidateFunc: {{ .Validate }},
+{{- end }}
+		Description: {{ printf "%q" .Description }},
+	}
+{{- end }}
+	return r
+}
+{{ end }}`))
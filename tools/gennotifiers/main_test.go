@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGeneratedFile verifies that the checked-in notifiers match the snapshot.
+func TestGeneratedFile(t *testing.T) {
+	generated, skipped, err := generate("notifiers.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range skipped {
+		t.Logf("skipped: %s", s)
+	}
+
+	existing, err := os.ReadFile(filepath.Join("..", "..", "internal", "resources", "grafana", "resource_alerting_contact_point_notifiers_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(generated, existing) {
+		t.Fatal("The generated notifiers are out of date with notifiers.json\nRegenerate with: go generate ./internal/resources/grafana/...")
+	}
+}
+
+func TestGenerateUnsupportedElement(t *testing.T) {
+	snapshot := filepath.Join(t.TempDir(), "notifiers.json")
+	if err := os.WriteFile(snapshot, []byte(`[
+		{"type": "mqtt", "name": "MQTT", "options": [
+			{"element": "input", "inputType": "text", "propertyName": "brokerUrl", "required": true},
+			{"element": "subform", "propertyName": "tlsConfig"}
+		]},
+		{"type": "custom", "name": "Custom", "options": [
+			{"element": "subform", "propertyName": "endpoint", "required": true}
+		]}
+	]`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	generated, skipped, err := generate(snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSkipped := []string{
+		`notifier "mqtt": option "tlsConfig": the element "subform" can't be generated`,
+		`notifier "custom": option "endpoint": the element "subform" can't be generated, add the notifier to handWrittenNotifiers and write it by hand`,
+	}
+	if strings.Join(skipped, "\n") != strings.Join(expectedSkipped, "\n") {
+		t.Errorf("Expected the skipped notifiers and options to be:\n%s\ngot:\n%s", strings.Join(expectedSkipped, "\n"), strings.Join(skipped, "\n"))
+	}
+	for _, expected := range []string{`type mqttNotifier struct{}`, `r.Schema["broker_url"]`} {
+		if !bytes.Contains(generated, []byte(expected)) {
+			t.Errorf("Expected the generated code to contain %q", expected)
+		}
+	}
+	for _, unexpected := range []string{`tls_config`, `customNotifier`} {
+		if bytes.Contains(generated, []byte(unexpected)) {
+			t.Errorf("Expected the generated code not to contain %q", unexpected)
+		}
+	}
+}
+
+func TestNames(t *testing.T) {
+	for input, expected := range map[string]string{
+		"prometheus-alertmanager": "prometheusAlertmanager",
+		"LINE":                    "line",
+		"googlechat":              "googlechat",
+	} {
+		if got := lowerCamelCase(input); got != expected {
+			t.Errorf("lowerCamelCase(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+	for input, expected := range map[string]string{
+		"basicAuthUser":     "basic_auth_user",
+		"message_thread_id": "message_thread_id",
+		"url":               "url",
+	} {
+		if got := snakeCase(input); got != expected {
+			t.Errorf("snakeCase(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+}
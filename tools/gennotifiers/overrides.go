@@ -0,0 +1,94 @@
+package main
+
+// handWrittenNotifiers are implemented in resource_alerting_contact_point_notifiers.go, since their settings
+// can't be generated from the metadata: nested blocks, lists, maps or custom conversions of the values.
+var handWrittenNotifiers = map[string]bool{
+	"email":     true,
+	"jira":      true,
+	"kafka":     true,
+	"oncall":    true,
+	"opsgenie":  true,
+	"pagerduty": true,
+	"slack":     true,
+	"sns":       true,
+	"webhook":   true,
+	"wecom":     true,
+}
+
+// notifierOverride customizes the generated notifier where the Terraform schema differs from the metadata.
+// The zero value keeps the defaults derived from the metadata.
+type notifierOverride struct {
+	goName string                   // Prefix of the Go type, defaults to the camel-cased type.
+	field  string                   // Name of the block in grafana_contact_point, defaults to the lower-cased type.
+	desc   string                   // Description of the block, defaults to one built from the notifier name.
+	fields map[string]fieldOverride // Keyed by the property name of the option.
+}
+
+type fieldOverride struct {
+	name         string // Terraform attribute name, defaults to the snake-cased property name.
+	defaultValue any
+	ignoreCase   bool // Whether the values of a select are validated without case sensitivity.
+}
+
+// notifierOverrides keep the schema of the notifiers compatible with the ones written before they were generated.
+var notifierOverrides = map[string]notifierOverride{
+	"prometheus-alertmanager": {
+		goName: "alertmanager",
+		field:  "alertmanager",
+		desc:   "A contact point that sends notifications to other Alertmanager instances.",
+	},
+	"dingding": {
+		goName: "dingDing",
+		fields: map[string]fieldOverride{
+			"msgType": {name: "message_type"},
+		},
+	},
+	"discord": {
+		desc: "A contact point that sends notifications as Discord messages",
+		fields: map[string]fieldOverride{
+			"message":              {defaultValue: ""},
+			"avatar_url":           {defaultValue: ""},
+			"use_discord_username": {defaultValue: false},
+		},
+	},
+	"googlechat": {
+		goName: "googleChat",
+		fields: map[string]fieldOverride{
+			"hide_version_info": {defaultValue: false},
+		},
+	},
+	"LINE": {
+		desc: "A contact point that sends notifications to LINE.me.",
+	},
+	"sensugo": {
+		desc: "A contact point that sends notifications to SensuGo.",
+		fields: map[string]fieldOverride{
+			"apikey": {name: "api_key"},
+		},
+	},
+	"teams": {
+		fields: map[string]fieldOverride{
+			"sectiontitle": {name: "section_title"},
+		},
+	},
+	"telegram": {
+		fields: map[string]fieldOverride{
+			"bottoken":   {name: "token"},
+			"chatid":     {name: "chat_id"},
+			"parse_mode": {ignoreCase: true},
+		},
+	},
+	"threema": {
+		desc: "A contact point that sends notifications to Threema.",
+	},
+	"victorops": {
+		goName: "victorOps",
+		desc:   "A contact point that sends notifications to VictorOps (now known as Splunk OnCall).",
+	},
+	"webex": {
+		desc: "A contact point that sends notifications to Cisco Webex.",
+		fields: map[string]fieldOverride{
+			"bot_token": {name: "token"},
+		},
+	},
+}
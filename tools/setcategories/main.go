@@ -41,6 +41,17 @@ func setResourceCategories(docsPath string) error {
 		}
 	}
 
+	for _, e := range provider.EphemeralResources() {
+		if e.Category == "" {
+			return fmt.Errorf("ephemeral resource %s does not have a category", e.Name)
+		}
+		name := strings.TrimPrefix(e.Name, "grafana_")
+		ephemeralResourceFileName := filepath.Join(docsPath, "ephemeral-resources", name+".md")
+		if err := setCategory(ephemeralResourceFileName, string(e.Category)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 